@@ -0,0 +1,257 @@
+// Package calendar writes iCalendar (RFC 5545) files, so appointments can be
+// added to calendar apps.
+package calendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ProdID identifies the application that created a calendar.
+const ProdID = "-//TerminSystem//Termine//DE"
+
+// Event status values.
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar is a VCALENDAR object.
+type Calendar struct {
+	// Method is the iTIP method, e.g. "REQUEST" or "CANCEL". Empty for
+	// plain files.
+	Method string
+	// Name is shown by calendar apps subscribing to the calendar.
+	Name string
+	// TimeZone is the zone event times are written in, UTC if nil.
+	TimeZone *time.Location
+	Events   []Event
+}
+
+// Event is a VEVENT.
+type Event struct {
+	UID      string
+	Sequence int
+	// Stamp is when the event was last changed.
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+	// Organizer and Attendee are email addresses, left out if empty.
+	Organizer    string
+	Attendee     string
+	AttendeeName string
+}
+
+// Encode returns the calendar as an iCalendar file.
+func (c Calendar) Encode() []byte {
+	w := &writer{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", ProdID)
+	w.line("CALSCALE", "GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		w.text("X-WR-CALNAME", c.Name)
+	}
+
+	zone := c.TimeZone
+	if zone == nil || zone == time.UTC {
+		zone = nil
+	} else if len(c.Events) > 0 {
+		// Without events nothing refers to the zone.
+		c.writeTimeZone(w)
+	}
+
+	for _, event := range c.Events {
+		w.line("BEGIN", "VEVENT")
+		w.text("UID", event.UID)
+		w.line("SEQUENCE", fmt.Sprint(event.Sequence))
+		w.line("DTSTAMP", event.Stamp.UTC().Format(utcFormat))
+		w.time("DTSTART", event.Start, zone)
+		w.time("DTEND", event.End, zone)
+		w.text("SUMMARY", event.Summary)
+		if event.Description != "" {
+			w.text("DESCRIPTION", event.Description)
+		}
+		if event.Location != "" {
+			w.text("LOCATION", event.Location)
+		}
+		if event.Status != "" {
+			w.line("STATUS", event.Status)
+		}
+		if event.Organizer != "" {
+			w.line("ORGANIZER", "mailto:"+event.Organizer)
+		}
+		if event.Attendee != "" {
+			name := ""
+			if event.AttendeeName != "" {
+				name = ";CN=" + quoteParam(event.AttendeeName)
+			}
+			w.line("ATTENDEE"+name+";ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED", "mailto:"+event.Attendee)
+		}
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+	return w.buf.Bytes()
+}
+
+const (
+	utcFormat   = "20060102T150405Z"
+	localFormat = "20060102T150405"
+)
+
+// writeTimeZone writes the VTIMEZONE of the calendar's zone with the offset
+// changes during the years its events take place in, so the same events
+// always encode the same. The calendar must have at least one event.
+func (c Calendar) writeTimeZone(w *writer) {
+	from, to := c.Events[0].Start.In(c.TimeZone).Year(), c.Events[0].End.In(c.TimeZone).Year()
+	for _, event := range c.Events[1:] {
+		if year := event.Start.In(c.TimeZone).Year(); year < from {
+			from = year
+		}
+		if year := event.End.In(c.TimeZone).Year(); year > to {
+			to = year
+		}
+	}
+
+	w.line("BEGIN", "VTIMEZONE")
+	w.line("TZID", c.TimeZone.String())
+
+	start := time.Date(from, time.January, 1, 0, 0, 0, 0, c.TimeZone)
+	end := time.Date(to+1, time.January, 1, 0, 0, 0, 0, c.TimeZone)
+	transitions := Transitions(c.TimeZone, start, end)
+	if len(transitions) == 0 {
+		// The offset in effect all the time.
+		name, offset := start.Zone()
+		transitions = []Transition{{At: time.Date(1970, time.January, 1, 0, 0, 0, 0, time.FixedZone(name, offset)), OffsetFrom: offset, OffsetTo: offset, Name: name}}
+	}
+
+	for _, transition := range transitions {
+		component := "STANDARD"
+		if transition.DST {
+			component = "DAYLIGHT"
+		}
+		w.line("BEGIN", component)
+		// The onset is given in the wall clock time before the change.
+		w.line("DTSTART", transition.At.In(time.FixedZone("", transition.OffsetFrom)).Format(localFormat))
+		w.line("TZOFFSETFROM", formatOffset(transition.OffsetFrom))
+		w.line("TZOFFSETTO", formatOffset(transition.OffsetTo))
+		if transition.Name != "" {
+			w.text("TZNAME", transition.Name)
+		}
+		w.line("END", component)
+	}
+
+	w.line("END", "VTIMEZONE")
+}
+
+// Transition is a change of the UTC offset of a time zone.
+type Transition struct {
+	At         time.Time
+	OffsetFrom int
+	OffsetTo   int
+	Name       string
+	DST        bool
+}
+
+// Transitions returns the offset changes of loc in [from, to).
+func Transitions(loc *time.Location, from, to time.Time) []Transition {
+	var result []Transition
+	_, offset := from.In(loc).Zone()
+	// Time zones do not change more than once a day, so checking daily and
+	// narrowing down each change finds all of them.
+	for day := from; day.Before(to); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.In(loc).Zone(); nextOffset == offset {
+			continue
+		}
+
+		before, after := day, next
+		for after.Sub(before) > time.Second {
+			middle := before.Add(after.Sub(before) / 2)
+			if _, o := middle.In(loc).Zone(); o == offset {
+				before = middle
+			} else {
+				after = middle
+			}
+		}
+
+		name, newOffset := after.In(loc).Zone()
+		result = append(result, Transition{
+			At:         after.Truncate(time.Second),
+			OffsetFrom: offset,
+			OffsetTo:   newOffset,
+			Name:       name,
+			DST:        after.In(loc).IsDST(),
+		})
+		offset = newOffset
+	}
+	return result
+}
+
+func formatOffset(seconds int) string {
+	sign := '+'
+	if seconds < 0 {
+		sign = '-'
+		seconds = -seconds
+	}
+	return fmt.Sprintf("%c%02d%02d", sign, seconds/3600, seconds/60%60)
+}
+
+// writer writes content lines, folding them after 75 octets.
+type writer struct {
+	buf bytes.Buffer
+}
+
+func (w *writer) line(name, value string) {
+	line := name + ":" + value
+	// Continuation lines start with a space, which counts towards their 75.
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.buf.WriteString(line[:cut] + "\r\n ")
+		line = line[cut:]
+		limit = 74
+	}
+	w.buf.WriteString(line + "\r\n")
+}
+
+// text writes a property with a TEXT value.
+func (w *writer) text(name, value string) {
+	w.line(name, escapeText(value))
+}
+
+// time writes a date-time property, in zone if it is not nil and in UTC
+// otherwise.
+func (w *writer) time(name string, t time.Time, zone *time.Location) {
+	if zone == nil {
+		w.line(name, t.UTC().Format(utcFormat))
+		return
+	}
+	w.line(name+";TZID="+zone.String(), t.In(zone).Format(localFormat))
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// quoteParam quotes a parameter value, which cannot contain double quotes.
+func quoteParam(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "'") + `"`
+}
@@ -0,0 +1,98 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// unfold joins folded content lines again.
+func unfold(ics string) string {
+	return strings.ReplaceAll(ics, "\r\n ", "")
+}
+
+func TestEncode(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	start := time.Date(2030, time.May, 6, 10, 0, 0, 0, berlin)
+	ics := string(Calendar{
+		Method:   "REQUEST",
+		TimeZone: berlin,
+		Events: []Event{{
+			UID:          "termin-1@example.com",
+			Stamp:        time.Date(2030, time.May, 1, 8, 0, 0, 0, time.UTC),
+			Start:        start,
+			End:          start.Add(time.Hour),
+			Summary:      "Termin: Trauringe",
+			Description:  "Ringe; Größe 54, Gravur\nzweite Zeile " + strings.Repeat("lang ", 20),
+			Location:     "Juwelier, Hauptstraße 1",
+			Status:       StatusConfirmed,
+			Organizer:    "shop@example.com",
+			Attendee:     "kunde@example.com",
+			AttendeeName: "Max Mustermann",
+		}},
+	}.Encode())
+
+	assert.True(t, strings.HasPrefix(ics, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(ics, "END:VCALENDAR\r\n"))
+	assert.Contains(t, ics, "METHOD:REQUEST\r\n")
+	assert.Contains(t, ics, "DTSTART;TZID=Europe/Berlin:20300506T100000\r\n")
+	assert.Contains(t, ics, "DTEND;TZID=Europe/Berlin:20300506T110000\r\n")
+	assert.Contains(t, ics, "DTSTAMP:20300501T080000Z\r\n")
+	assert.Contains(t, ics, "LOCATION:Juwelier\\, Hauptstraße 1\r\n")
+	assert.Contains(t, ics, `DESCRIPTION:Ringe\; Größe 54\, Gravur\nzweite Zeile`)
+	assert.Contains(t, ics, "ORGANIZER:mailto:shop@example.com\r\n")
+	assert.Contains(t, unfold(ics), `ATTENDEE;CN="Max Mustermann";ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED:mailto:kunde@example.com`)
+
+	// The time zone has both changes of the year.
+	assert.Contains(t, ics, "BEGIN:VTIMEZONE\r\nTZID:Europe/Berlin\r\n")
+	assert.Contains(t, ics, "BEGIN:DAYLIGHT\r\nDTSTART:20300331T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0200\r\nTZNAME:CEST\r\nEND:DAYLIGHT\r\n")
+	assert.Contains(t, ics, "BEGIN:STANDARD\r\nDTSTART:20301027T030000\r\nTZOFFSETFROM:+0200\r\nTZOFFSETTO:+0100\r\nTZNAME:CET\r\nEND:STANDARD\r\n")
+
+	// Long lines are folded after at most 75 octets, without splitting
+	// characters.
+	for _, line := range strings.Split(strings.TrimSuffix(ics, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+	assert.Contains(t, unfold(ics), `DESCRIPTION:Ringe\; Größe 54\, Gravur\nzweite Zeile `+strings.Repeat("lang ", 20)+"\r\n")
+}
+
+func TestEncodeUTC(t *testing.T) {
+	start := time.Date(2030, time.May, 6, 8, 0, 0, 0, time.UTC)
+	ics := string(Calendar{
+		Name:   "Termine",
+		Events: []Event{{UID: "termin-1@example.com", Start: start, End: start.Add(time.Hour), Summary: "Termin"}},
+	}.Encode())
+
+	assert.NotContains(t, ics, "VTIMEZONE")
+	assert.NotContains(t, ics, "METHOD")
+	assert.Contains(t, ics, "X-WR-CALNAME:Termine\r\n")
+	assert.Contains(t, ics, "DTSTART:20300506T080000Z\r\n")
+
+	// Zones without changes get a single standard time.
+	fixed := string(Calendar{TimeZone: time.FixedZone("GST", 4*3600), Events: []Event{{UID: "x", Start: start, End: start}}}.Encode())
+	assert.Contains(t, fixed, "BEGIN:STANDARD\r\nDTSTART:19700101T000000\r\nTZOFFSETFROM:+0400\r\nTZOFFSETTO:+0400\r\nTZNAME:GST\r\nEND:STANDARD\r\n")
+	assert.Contains(t, fixed, "DTSTART;TZID=GST:20300506T120000\r\n")
+
+	// An empty feed does not depend on the current date.
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	empty := string(Calendar{Name: "Termine", TimeZone: berlin}.Encode())
+	assert.NotContains(t, empty, "VTIMEZONE")
+}
+
+func TestTransitions(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+
+	transitions := Transitions(berlin, time.Date(2028, time.January, 1, 0, 0, 0, 0, berlin), time.Date(2029, time.January, 1, 0, 0, 0, 0, berlin))
+	if assert.Len(t, transitions, 2) {
+		assert.Equal(t, time.Date(2028, time.March, 26, 1, 0, 0, 0, time.UTC), transitions[0].At.UTC())
+		assert.True(t, transitions[0].DST)
+		assert.Equal(t, time.Date(2028, time.October, 29, 1, 0, 0, 0, time.UTC), transitions[1].At.UTC())
+		assert.False(t, transitions[1].DST)
+	}
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequireToken only lets requests through that carry the admin token as a
+// bearer token in their Authorization header.
+func RequireToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		given, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Nicht autorisiert"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireQueryToken only lets requests through that carry the token in their
+// "token" query parameter, for clients like calendar apps that cannot send
+// headers.
+func RequireQueryToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		given := c.Query("token")
+		if token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Nicht autorisiert"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// RequireBasicAuth only lets requests through that carry the username and
+// password via HTTP basic authentication, for clients like CalDAV apps that
+// only support that.
+func RequireBasicAuth(username, password string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, pass, ok := c.Request.BasicAuth()
+		if !ok || password == "" ||
+			subtle.ConstantTimeCompare([]byte(user), []byte(username)) != 1 ||
+			subtle.ConstantTimeCompare([]byte(pass), []byte(password)) != 1 {
+			c.Header("WWW-Authenticate", `Basic realm="Termine", charset="UTF-8"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Nicht autorisiert"})
+			return
+		}
+
+		c.Next()
+	}
+}
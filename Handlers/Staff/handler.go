@@ -0,0 +1,157 @@
+package staff
+
+import (
+	staff "TerminSystem/Repositories/Staff"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StaffHandler struct {
+	service *staff.StaffService
+}
+
+func NewStaffHandle(service *staff.StaffService) *StaffHandler {
+	return &StaffHandler{
+		service: service,
+	}
+}
+
+// ListStaff lists the staff members of the location in the ":id" path
+// parameter, or of the main shop without one.
+func (h *StaffHandler) ListStaff(c *gin.Context) {
+	Type := appointment.Type(c.Query("type"))
+	if Type != "" {
+		if err := appointment.TypeValidator(Type); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	var o staff.StaffOptions
+	if param := c.Param("id"); param != "" {
+		id, err := strconv.Atoi(param)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+			return
+		}
+		o.Location = id
+	}
+
+	members, err := h.service.ListStaff(c.Request.Context(), Type, o)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": members})
+}
+
+// StaffInput is the body of a staff member created or changed by the admin.
+type StaffInput struct {
+	Name  string             `json:"name" binding:"required"`
+	Types []appointment.Type `json:"types"`
+	// Location is the branch the staff member works at, 0 or missing for
+	// the main shop.
+	Location int `json:"location"`
+	// Shifts are the weekly working hours. Left out on update they stay as
+	// they are.
+	Shifts []staff.Shift `json:"shifts"`
+}
+
+// CreateStaff adds the staff member in the body.
+func (h *StaffHandler) CreateStaff(c *gin.Context) {
+	var input StaffInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member, err := h.service.CreateStaff(c.Request.Context(), input.Name, input.Types, input.Shifts, staff.StaffOptions{Location: input.Location})
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"data": member})
+}
+
+// UpdateStaff replaces the staff member in the ":id" path parameter with the
+// one in the body.
+func (h *StaffHandler) UpdateStaff(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	var input StaffInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member, err := h.service.UpdateStaff(c.Request.Context(), id, input.Name, input.Types, input.Shifts, staff.StaffOptions{Location: input.Location})
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": member})
+}
+
+// SetWorkingHours replaces the weekly shifts of the staff member in the ":id"
+// path parameter with the list of shifts in the body.
+func (h *StaffHandler) SetWorkingHours(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	var shifts []staff.Shift
+	if err := c.ShouldBindJSON(&shifts); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	member, err := h.service.SetWorkingHours(c.Request.Context(), id, shifts)
+	if err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": member})
+}
+
+// DeleteStaff removes the staff member in the ":id" path parameter.
+func (h *StaffHandler) DeleteStaff(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	if err := h.service.DeleteStaff(c.Request.Context(), id); err != nil {
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Gelöscht"})
+}
+
+// errorStatus maps the errors of the staff service to HTTP status codes.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, staff.ErrStaffNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, staff.ErrInvalidStaff), ent.IsValidationError(err), ent.IsConstraintError(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
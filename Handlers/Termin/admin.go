@@ -0,0 +1,213 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AppointmentEdit struct {
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+	Phone *string `json:"phone"`
+	Desc  *string `json:"desc"`
+	// Date is the new start as "2006-01-02 15:04" in the time zone of the
+	// appointment's location.
+	Date  *string `json:"date"`
+	Staff *int    `json:"staff"`
+}
+
+// ListAppointments lists the appointments for the admin. The "from" and "to"
+// query parameters limit the days they start on, "type" and "status" take
+// comma separated values and "location" 0 is the main shop. They are ordered
+// by "sort" ("-" in front for descending) and split into pages of "limit",
+// the next one being requested with the "cursor" returned.
+func (h *TerminHandler) ListAppointments(c *gin.Context) {
+	filter := termin.AppointmentFilter{Cursor: c.Query("cursor")}
+
+	if location := c.Query("location"); location != "" {
+		id, err := strconv.Atoi(location)
+		if err != nil || id < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "location muss eine Zahl sein"})
+			return
+		}
+		filter.Location = &id
+	}
+
+	// Days are read in the time zone of the location listed, if any.
+	service := h.service
+	if filter.Location != nil && *filter.Location != 0 {
+		var err error
+		if service, err = h.service.At(c.Request.Context(), *filter.Location); err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+	}
+	for param, bound := range map[string]*time.Time{"from": &filter.From, "to": &filter.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		day, err := time.ParseInLocation("2006-01-02", value, service.Location())
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": param + " muss ein Datum (JJJJ-MM-TT) sein"})
+			return
+		}
+		*bound = day
+	}
+	// The day "to" is included.
+	if !filter.To.IsZero() {
+		filter.To = filter.To.AddDate(0, 0, 1)
+	}
+
+	for _, Type := range splitList(c.Query("type")) {
+		if err := appointment.TypeValidator(appointment.Type(Type)); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		filter.Types = append(filter.Types, appointment.Type(Type))
+	}
+	for _, status := range splitList(c.Query("status")) {
+		if err := appointment.StatusValidator(appointment.Status(status)); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Ungültiger Status"})
+			return
+		}
+		filter.Statuses = append(filter.Statuses, appointment.Status(status))
+	}
+
+	filter.Sort, filter.Desc = strings.CutPrefix(c.Query("sort"), "-")
+	if limit := c.Query("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit muss eine Zahl sein"})
+			return
+		}
+		filter.Limit = n
+	}
+
+	page, err := h.service.ListAppointments(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	for _, a := range page.Appointments {
+		h.localizeAtLocation(a)
+	}
+	c.JSON(http.StatusOK, gin.H{"data": page.Appointments, "next_cursor": page.NextCursor})
+}
+
+// GetAppointment returns the appointment in the ":id" path parameter with its
+// staff member, location and reminders.
+func (h *TerminHandler) GetAppointment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	booked, err := h.service.GetAppointment(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": h.localizeAtLocation(booked)})
+}
+
+// UpdateAppointment changes the fields given in the body of the appointment
+// in the ":id" path parameter.
+func (h *TerminHandler) UpdateAppointment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	var edit AppointmentEdit
+	if err := c.ShouldBindJSON(&edit); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	changes := termin.AppointmentChanges{
+		Name:        edit.Name,
+		Email:       edit.Email,
+		Phone:       edit.Phone,
+		Description: edit.Desc,
+		Staff:       edit.Staff,
+	}
+	if edit.Date != nil {
+		booked, err := h.service.GetAppointment(c.Request.Context(), id)
+		if err != nil {
+			c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+			return
+		}
+		start, err := time.ParseInLocation("2006-01-02 15:04", *edit.Date, h.locationOf(booked))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		changes.Start = &start
+	}
+
+	updated, err := h.service.UpdateAppointment(c.Request.Context(), id, changes)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": h.localizeAtLocation(updated)})
+}
+
+// CancelAppointment cancels the appointment in the ":id" path parameter for
+// the shop, telling the customer the optional "reason" query parameter.
+func (h *TerminHandler) CancelAppointment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	cancelled, err := h.service.CancelAppointmentByID(c.Request.Context(), id, c.Query("reason"))
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": h.localizeAtLocation(cancelled)})
+}
+
+// locationOf returns the time zone of the appointment's location, which has
+// to be loaded with it.
+func (h *TerminHandler) locationOf(a *ent.Appointment) *time.Location {
+	if a.Edges.Location != nil {
+		if tz, err := time.LoadLocation(a.Edges.Location.Timezone); err == nil {
+			return tz
+		}
+	}
+	return h.service.Location()
+}
+
+// localizeAtLocation converts the times of the appointment into the time zone
+// of its location.
+func (h *TerminHandler) localizeAtLocation(a *ent.Appointment) *ent.Appointment {
+	return localize(a, h.locationOf(a))
+}
+
+// splitList splits a comma separated query parameter, dropping empty values.
+func splitList(param string) []string {
+	var values []string
+	for _, value := range strings.Split(param, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,292 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav"
+	"github.com/emersion/go-webdav/caldav"
+)
+
+// CalDAVMethods are the HTTP methods the CalDAV handler has to be routed for.
+var CalDAVMethods = []string{
+	http.MethodOptions, http.MethodHead, http.MethodGet, http.MethodPut, http.MethodDelete,
+	"PROPFIND", "REPORT",
+}
+
+// mainCalendar is the calendar name of the main shop, branches use their ID.
+const mainCalendar = "main"
+
+// CalDAV returns a CalDAV server under prefix that the shop owner's calendar
+// apps sync with. Every location is a calendar of appointments and blocked
+// times: events created in the app block their time for bookings, moving
+// the event of an appointment moves it and deleting it cancels it.
+func (h *TerminHandler) CalDAV(prefix string) http.Handler {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return &caldav.Handler{
+		Backend: &calDAVBackend{service: h.service, prefix: prefix},
+		Prefix:  prefix,
+	}
+}
+
+// calDAVBackend serves the calendars at
+// <prefix>/owner/calendars/<location>/<event>.
+type calDAVBackend struct {
+	service *termin.AppointmentService
+	prefix  string
+}
+
+func (b *calDAVBackend) CurrentUserPrincipal(ctx context.Context) (string, error) {
+	return b.prefix + "/owner/", nil
+}
+
+func (b *calDAVBackend) CalendarHomeSetPath(ctx context.Context) (string, error) {
+	return b.prefix + "/owner/calendars/", nil
+}
+
+func (b *calDAVBackend) CreateCalendar(ctx context.Context, calendar *caldav.Calendar) error {
+	return webdav.NewHTTPError(http.StatusForbidden, errors.New("caldav: calendars are the shop's locations"))
+}
+
+func (b *calDAVBackend) ListCalendars(ctx context.Context) ([]caldav.Calendar, error) {
+	calendars := []caldav.Calendar{b.calendar(b.service)}
+
+	branches, err := b.service.ListLocations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, branch := range branches {
+		scoped, err := b.service.At(ctx, branch.ID)
+		if err != nil {
+			return nil, davError(err)
+		}
+		calendars = append(calendars, b.calendar(scoped))
+	}
+	return calendars, nil
+}
+
+func (b *calDAVBackend) GetCalendar(ctx context.Context, calendarPath string) (*caldav.Calendar, error) {
+	scoped, err := b.scope(ctx, calendarPath)
+	if err != nil {
+		return nil, err
+	}
+	calendar := b.calendar(scoped)
+	return &calendar, nil
+}
+
+func (b *calDAVBackend) GetCalendarObject(ctx context.Context, objectPath string, req *caldav.CalendarCompRequest) (*caldav.CalendarObject, error) {
+	scoped, name, err := b.object(ctx, objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := scoped.CalendarObject(ctx, name)
+	if err != nil {
+		return nil, davError(err)
+	}
+	return b.calendarObject(scoped, object)
+}
+
+func (b *calDAVBackend) ListCalendarObjects(ctx context.Context, calendarPath string, req *caldav.CalendarCompRequest) ([]caldav.CalendarObject, error) {
+	scoped, err := b.scope(ctx, calendarPath)
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := scoped.CalendarObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]caldav.CalendarObject, 0, len(objects))
+	for _, object := range objects {
+		converted, err := b.calendarObject(scoped, &object)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *converted)
+	}
+	return result, nil
+}
+
+func (b *calDAVBackend) QueryCalendarObjects(ctx context.Context, calendarPath string, query *caldav.CalendarQuery) ([]caldav.CalendarObject, error) {
+	objects, err := b.ListCalendarObjects(ctx, calendarPath, &query.CompRequest)
+	if err != nil {
+		return nil, err
+	}
+	return caldav.Filter(query, objects)
+}
+
+func (b *calDAVBackend) PutCalendarObject(ctx context.Context, objectPath string, calendar *ical.Calendar, opts *caldav.PutCalendarObjectOptions) (*caldav.CalendarObject, error) {
+	scoped, name, err := b.object(ctx, objectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := calendarChange(calendar, scoped)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusBadRequest, err)
+	}
+
+	existing, err := scoped.CalendarObject(ctx, name)
+	var appErr *termin.AppointmentError
+	if errors.As(err, &appErr) && appErr.Code == termin.CalendarObjectNotFoundErrorCode {
+		existing = nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := checkPreconditions(existing, opts); err != nil {
+		return nil, err
+	}
+
+	object, err := scoped.PutCalendarObject(ctx, name, change)
+	if err != nil {
+		return nil, davError(err)
+	}
+	return b.calendarObject(scoped, object)
+}
+
+func (b *calDAVBackend) DeleteCalendarObject(ctx context.Context, objectPath string) error {
+	scoped, name, err := b.object(ctx, objectPath)
+	if err != nil {
+		return err
+	}
+	return davError(scoped.DeleteCalendarObject(ctx, name))
+}
+
+// calendar describes the calendar of the service's location.
+func (b *calDAVBackend) calendar(scoped *termin.AppointmentService) caldav.Calendar {
+	id, name := mainCalendar, "Termine"
+	if branch := scoped.Branch(); branch != nil {
+		id, name = strconv.Itoa(branch.ID), "Termine "+branch.Name
+	}
+
+	return caldav.Calendar{
+		Path:                  b.prefix + "/owner/calendars/" + id + "/",
+		Name:                  name,
+		Description:           "Termine und blockierte Zeiten",
+		SupportedComponentSet: []string{ical.CompEvent},
+	}
+}
+
+// scope returns the service working on the location of the calendar at the
+// path.
+func (b *calDAVBackend) scope(ctx context.Context, calendarPath string) (*termin.AppointmentService, error) {
+	dir, id := path.Split(path.Clean(calendarPath))
+	if home, _ := b.CalendarHomeSetPath(ctx); dir != home {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, fmt.Errorf("caldav: no calendar at %s", calendarPath))
+	}
+	if id == mainCalendar {
+		return b.service, nil
+	}
+
+	location, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, webdav.NewHTTPError(http.StatusNotFound, fmt.Errorf("caldav: no calendar at %s", calendarPath))
+	}
+	scoped, err := b.service.At(ctx, location)
+	if err != nil {
+		return nil, davError(err)
+	}
+	return scoped, nil
+}
+
+// object returns the service working on the location of the calendar the
+// event at the path is in along with the name of the event.
+func (b *calDAVBackend) object(ctx context.Context, objectPath string) (*termin.AppointmentService, string, error) {
+	dir, name := path.Split(objectPath)
+	scoped, err := b.scope(ctx, dir)
+	if err != nil {
+		return nil, "", err
+	}
+	return scoped, name, nil
+}
+
+// calendarObject converts the event in the calendar of the service's location
+// into a CalDAV resource.
+func (b *calDAVBackend) calendarObject(scoped *termin.AppointmentService, object *termin.CalendarObject) (*caldav.CalendarObject, error) {
+	data, err := ical.NewDecoder(bytes.NewReader(object.ICS)).Decode()
+	if err != nil {
+		return nil, err
+	}
+	// The data is encoded again when it is served.
+	var encoded bytes.Buffer
+	if err := ical.NewEncoder(&encoded).Encode(data); err != nil {
+		return nil, err
+	}
+
+	return &caldav.CalendarObject{
+		Path:          b.calendar(scoped).Path + object.Name,
+		ModTime:       object.LastModified,
+		ContentLength: int64(encoded.Len()),
+		ETag:          object.ETag,
+		Data:          data,
+	}, nil
+}
+
+// calendarChange reads the single event from a calendar file a calendar app
+// uploaded. Times without time zone are in the shop's.
+func calendarChange(calendar *ical.Calendar, scoped *termin.AppointmentService) (termin.CalendarChange, error) {
+	var change termin.CalendarChange
+
+	kind, uid, err := caldav.ValidateCalendarObject(calendar)
+	if err != nil {
+		return change, err
+	}
+	events := calendar.Events()
+	if kind != ical.CompEvent || len(events) != 1 {
+		return change, errors.New("caldav: only single events are supported")
+	}
+	event := events[0]
+	if event.Props.Get(ical.PropRecurrenceRule) != nil {
+		return change, errors.New("caldav: recurring events are not supported")
+	}
+
+	change.UID = uid
+	change.Summary, _ = event.Props.Text(ical.PropSummary)
+	if change.Start, err = event.DateTimeStart(scoped.Location()); err != nil {
+		return change, err
+	}
+	if change.End, err = event.DateTimeEnd(scoped.Location()); err != nil {
+		return change, err
+	}
+	return change, nil
+}
+
+// checkPreconditions applies the If-Match and If-None-Match headers of a PUT
+// to the event it replaces, nil if there is none.
+func checkPreconditions(existing *termin.CalendarObject, opts *caldav.PutCalendarObjectOptions) error {
+	var etag string
+	if existing != nil {
+		etag = existing.ETag
+	}
+
+	if opts.IfMatch.IsSet() {
+		if ok, err := opts.IfMatch.MatchETag(etag); err != nil || !ok {
+			return webdav.NewHTTPError(http.StatusPreconditionFailed, errors.New("caldav: event was changed in the meantime"))
+		}
+	}
+	if opts.IfNoneMatch.IsSet() {
+		if ok, err := opts.IfNoneMatch.MatchETag(etag); err != nil || ok {
+			return webdav.NewHTTPError(http.StatusPreconditionFailed, errors.New("caldav: event exists already"))
+		}
+	}
+	return nil
+}
+
+// davError gives service errors the status code they get in the JSON API.
+func davError(err error) error {
+	var appErr *termin.AppointmentError
+	if errors.As(err, &appErr) {
+		return webdav.NewHTTPError(errorStatus(err, http.StatusBadRequest), err)
+	}
+	return err
+}
@@ -0,0 +1,164 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/enttest"
+	"context"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-ical"
+	"github.com/emersion/go-webdav/caldav"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalDAV(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	now := time.Date(2030, time.May, 6, 8, 0, 0, 0, termin.DefaultConfig().Location)
+	service := termin.NewAppointmentService(client, termin.Config{ChangeCutoff: termin.Duration(0), Now: func() time.Time { return now }})
+	assert.NoError(t, service.SeedOpeningHours(ctx, termin.DefaultOpeningHours()))
+
+	var day time.Time
+	for _, date := range service.GetAvailableDates(ctx, 14)[1:] {
+		parsed, err := time.ParseInLocation("2006-01-02", date, service.Location())
+		assert.NoError(t, err)
+		if parsed.Weekday() != time.Saturday && parsed.Weekday() != time.Sunday {
+			day = parsed
+			break
+		}
+	}
+	if day.IsZero() {
+		t.Fatal("no weekday among available dates")
+	}
+
+	booked, err := service.BookAppointment(ctx, "Max Mustermann", "max@example.com", "123456789", "Größe 54", appointment.TypeTrauringe, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+
+	server := httptest.NewServer(NewTerminHandle(service).CalDAV("/caldav"))
+	defer server.Close()
+
+	dav, err := caldav.NewClient(server.Client(), server.URL)
+	assert.NoError(t, err)
+
+	// Discovery as calendar apps do it.
+	principal, err := dav.FindCurrentUserPrincipal(ctx)
+	assert.NoError(t, err)
+	home, err := dav.FindCalendarHomeSet(ctx, principal)
+	assert.NoError(t, err)
+	calendars, err := dav.FindCalendars(ctx, home)
+	assert.NoError(t, err)
+	if !assert.Len(t, calendars, 1) {
+		return
+	}
+	collection := calendars[0].Path
+	assert.Equal(t, "/caldav/owner/calendars/main/", collection)
+
+	query := &caldav.CalendarQuery{
+		CompRequest: caldav.CalendarCompRequest{Name: ical.CompCalendar, AllProps: true, AllComps: true},
+		CompFilter: caldav.CompFilter{
+			Name:  ical.CompCalendar,
+			Comps: []caldav.CompFilter{{Name: ical.CompEvent, Start: day, End: day.AddDate(0, 0, 1)}},
+		},
+	}
+	objects, err := dav.QueryCalendar(ctx, collection, query)
+	assert.NoError(t, err)
+	if !assert.Len(t, objects, 1) {
+		return
+	}
+	object := objects[0]
+	assert.Equal(t, collection+"termin-"+strconv.Itoa(booked.ID)+".ics", object.Path)
+	assert.NotEmpty(t, object.ETag)
+	event := object.Data.Events()[0]
+	summary, _ := event.Props.Text(ical.PropSummary)
+	assert.Equal(t, "Trauringe: Max Mustermann", summary)
+
+	// Blocking time in the calendar app hides the slots during it.
+	block := newEvent("zahnarzt@example.com", "Zahnarzt", day.Add(14*time.Hour), day.Add(16*time.Hour))
+	_, err = dav.PutCalendarObject(ctx, collection+"zahnarzt.ics", block)
+	assert.NoError(t, err)
+
+	slots, err := service.GetTimeSlotsByDate(ctx, day.Format("2006-01-02"), termin.SlotFilter{Type: appointment.TypeSonstiges})
+	assert.NoError(t, err)
+	times := slotClocks(slots)
+	assert.NotContains(t, times, "14:00")
+	assert.NotContains(t, times, "15:30")
+	assert.Contains(t, times, "16:00")
+
+	objects, err = dav.QueryCalendar(ctx, collection, query)
+	assert.NoError(t, err)
+	assert.Len(t, objects, 2)
+
+	// Moving the appointment's event moves the appointment.
+	moved := object.Data
+	start := moved.Events()[0].Props.Get(ical.PropDateTimeStart)
+	start.SetDateTime(day.Add(11 * time.Hour))
+	end := moved.Events()[0].Props.Get(ical.PropDateTimeEnd)
+	end.SetDateTime(day.Add(12 * time.Hour))
+	updated, err := dav.PutCalendarObject(ctx, object.Path, moved)
+	assert.NoError(t, err)
+	assert.NotEqual(t, object.ETag, updated.ETag)
+
+	reloaded, err := client.Appointment.Get(ctx, booked.ID)
+	assert.NoError(t, err)
+	assert.True(t, reloaded.StartTime.Equal(day.Add(11*time.Hour)), reloaded.StartTime)
+
+	// Into blocked time it cannot be moved.
+	start.SetDateTime(day.Add(14 * time.Hour))
+	end.SetDateTime(day.Add(15 * time.Hour))
+	_, err = dav.PutCalendarObject(ctx, object.Path, moved)
+	assert.Error(t, err)
+
+	fetched, err := dav.GetCalendarObject(ctx, object.Path)
+	assert.NoError(t, err)
+	fetchedStart, err := fetched.Data.Events()[0].DateTimeStart(service.Location())
+	assert.NoError(t, err)
+	assert.True(t, fetchedStart.Equal(day.Add(11*time.Hour)), fetchedStart)
+
+	// Deleting the blocked time frees it, deleting the appointment cancels it.
+	assert.NoError(t, dav.RemoveAll(ctx, collection+"zahnarzt.ics"))
+	assert.NoError(t, dav.RemoveAll(ctx, object.Path))
+
+	slots, err = service.GetTimeSlotsByDate(ctx, day.Format("2006-01-02"), termin.SlotFilter{Type: appointment.TypeSonstiges})
+	assert.NoError(t, err)
+	assert.Contains(t, slotClocks(slots), "14:00")
+
+	reloaded, err = client.Appointment.Get(ctx, booked.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCancelled, reloaded.Status)
+
+	_, err = dav.GetCalendarObject(ctx, object.Path)
+	assert.Error(t, err)
+	objects, err = dav.QueryCalendar(ctx, collection, query)
+	assert.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func newEvent(uid, summary string, start, end time.Time) *ical.Calendar {
+	event := ical.NewEvent()
+	event.Props.SetText(ical.PropUID, uid)
+	event.Props.SetText(ical.PropSummary, summary)
+	event.Props.SetDateTime(ical.PropDateTimeStamp, time.Now().UTC())
+	event.Props.SetDateTime(ical.PropDateTimeStart, start)
+	event.Props.SetDateTime(ical.PropDateTimeEnd, end)
+
+	calendar := ical.NewCalendar()
+	calendar.Props.SetText(ical.PropVersion, "2.0")
+	calendar.Props.SetText(ical.PropProductID, "-//Test//Test//DE")
+	calendar.Children = append(calendar.Children, event.Component)
+	return calendar
+}
+
+func slotClocks(slots []termin.TimeSlot) []string {
+	clocks := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		clocks = append(clocks, slot.Time[len("2006-01-02 "):])
+	}
+	return clocks
+}
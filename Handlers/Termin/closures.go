@@ -0,0 +1,78 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClosureDayCreate struct {
+	Date   string `json:"date"`
+	Reason string `json:"reason"`
+}
+
+func (h *TerminHandler) ListClosedDays(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	year := service.Now().Year()
+	if value := c.Query("year"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "year muss eine Zahl sein"})
+			return
+		}
+		year = parsed
+	}
+
+	days, err := service.ListClosedDays(c.Request.Context(), year)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": days})
+}
+
+func (h *TerminHandler) AddClosureDay(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	var CreateData ClosureDayCreate
+	if err := c.ShouldBindJSON(&CreateData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	closure, err := service.AddClosureDay(c.Request.Context(), CreateData.Date, CreateData.Reason)
+	if ent.IsConstraintError(err) {
+		c.JSON(http.StatusConflict, gin.H{"error": "Der Tag ist bereits geschlossen"})
+		return
+	}
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": closure})
+}
+
+func (h *TerminHandler) RemoveClosureDay(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	if err := service.RemoveClosureDay(c.Request.Context(), c.Param("date")); err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Geöffnet"})
+}
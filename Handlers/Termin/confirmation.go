@@ -0,0 +1,50 @@
+package termin
+
+import (
+	"TerminSystem/templates"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ConfirmPage shows the customer the button that confirms the booking with
+// the "token" query parameter. It is the target of the confirmation link sent
+// to the customer, which only confirms when the button is pressed.
+func (h *TerminHandler) ConfirmPage(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token ist erforderlich"})
+		return
+	}
+
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html")
+	templates.ConfirmAppointment(token).Render(c.Request.Context(), c.Writer)
+}
+
+// ConfirmAppoinment confirms the booking with the token in the "token" form
+// field posted by the confirmation page, or the query parameter.
+func (h *TerminHandler) ConfirmAppoinment(c *gin.Context) {
+	token := c.PostForm("token")
+	if token == "" {
+		token = c.Query("token")
+	}
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token ist erforderlich"})
+		return
+	}
+
+	appoinment, err := h.service.ConfirmAppointment(c.Request.Context(), token)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	service, _, err := h.service.ForAppointment(c.Request.Context(), appoinment.Delkey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": localize(appoinment, service.Location()).String()})
+}
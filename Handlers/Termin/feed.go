@@ -0,0 +1,78 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"TerminSystem/ent/appointment"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StaffFeed returns the upcoming appointments as iCalendar feed for the
+// staff's calendar apps, optionally filtered by the "type", "staff" and
+// "location" query parameters. Location 0 is the main shop.
+func (h *TerminHandler) StaffFeed(c *gin.Context) {
+	var filter termin.FeedFilter
+
+	if Type := c.Query("type"); Type != "" {
+		filter.Type = appointment.Type(Type)
+		if err := appointment.TypeValidator(filter.Type); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	if staff := c.Query("staff"); staff != "" {
+		id, err := strconv.Atoi(staff)
+		if err != nil || id <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "staff muss eine positive Zahl sein"})
+			return
+		}
+		filter.Staff = id
+	}
+	if location := c.Query("location"); location != "" {
+		id, err := strconv.Atoi(location)
+		if err != nil || id < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "location muss eine Zahl sein"})
+			return
+		}
+		filter.Location = &id
+	}
+
+	feed, err := h.service.StaffFeed(c.Request.Context(), filter)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("ETag", feed.ETag)
+	c.Header("Last-Modified", feed.LastModified.UTC().Format(http.TimeFormat))
+	c.Header("Cache-Control", "no-cache")
+	if notModified(c, feed) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Header("Content-Disposition", `inline; filename="termine.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", feed.ICS)
+}
+
+// notModified reports whether the client has the current feed already. The
+// ETag takes precedence over the modification time, which does not change
+// when past appointments drop out of the feed.
+func notModified(c *gin.Context, feed *termin.Feed) bool {
+	if match := c.GetHeader("If-None-Match"); match != "" {
+		for _, tag := range strings.Split(match, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == feed.ETag || tag == "*" {
+				return true
+			}
+		}
+		return false
+	}
+
+	since, err := time.Parse(http.TimeFormat, c.GetHeader("If-Modified-Since"))
+	return err == nil && !feed.LastModified.Truncate(time.Second).After(since)
+}
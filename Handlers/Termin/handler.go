@@ -2,8 +2,11 @@ package termin
 
 import (
 	termin "TerminSystem/Repositories/Termin"
+	"TerminSystem/ent"
 	"TerminSystem/ent/appointment"
+	"errors"
 	"net/http"
+	"strconv"
 	"time"
 	"github.com/gin-gonic/gin"
 )
@@ -18,22 +21,114 @@ func NewTerminHandle(service *termin.AppointmentService) *TerminHandler {
 	}
 }
 
+// errorStatus maps service errors to HTTP status codes, using fallback for
+// errors that have no dedicated status.
+func errorStatus(err error, fallback int) int {
+	var appErr *termin.AppointmentError
+	if !errors.As(err, &appErr) {
+		return fallback
+	}
+
+	switch appErr.Code {
+	case termin.SlotTakenErrorCode, termin.InvalidStatusTransitionErrorCode:
+		return http.StatusConflict
+	case termin.StaffNotFoundErrorCode, termin.LocationNotFoundErrorCode, termin.AppointmentNotFoundErrorCode, termin.CalendarObjectNotFoundErrorCode,
+		termin.ClosureDayNotFoundErrorCode:
+		return http.StatusNotFound
+	case termin.ChangeCutoffErrorCode:
+		return http.StatusForbidden
+	case termin.TooManyHoldsErrorCode:
+		return http.StatusTooManyRequests
+	case termin.OfferUnavailableErrorCode, termin.ConfirmationInvalidErrorCode:
+		return http.StatusGone
+	case termin.InvalidOpeningHoursErrorCode, termin.InvalidDateErrorCode, termin.LocationLoadErrorCode, termin.InvalidEventErrorCode,
+		termin.InvalidQueryErrorCode, termin.InvalidAppointmentErrorCode, termin.InvalidEmailErrorCode, termin.InvalidStateErrorCode:
+		return http.StatusBadRequest
+	default:
+		return fallback
+	}
+}
+
+// slotFilter reads the optional "type" and "staff" query parameters.
+func slotFilter(c *gin.Context) (termin.SlotFilter, error) {
+	var filter termin.SlotFilter
+
+	if Type := c.Query("type"); Type != "" {
+		filter.Type = appointment.Type(Type)
+		if err := appointment.TypeValidator(filter.Type); err != nil {
+			return filter, err
+		}
+	}
+
+	if staff := c.Query("staff"); staff != "" {
+		id, err := strconv.Atoi(staff)
+		if err != nil {
+			return filter, err
+		}
+		filter.Staff = id
+	}
+
+	return filter, nil
+}
+
+// localize converts the times of an appointment, which are stored in UTC,
+// into the time zone of its location for the response.
+func localize(a *ent.Appointment, loc *time.Location) *ent.Appointment {
+	a.StartTime = a.StartTime.In(loc)
+	a.EndTime = a.EndTime.In(loc)
+	return a
+}
+
+// scoped returns the service of the location in the ":id" path parameter, or
+// the main shop's service on routes without one. It answers the request
+// itself if the location cannot be used.
+func (h *TerminHandler) scoped(c *gin.Context) (*termin.AppointmentService, bool) {
+	param := c.Param("id")
+	if param == "" {
+		return h.service, true
+	}
+
+	id, err := strconv.Atoi(param)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return nil, false
+	}
+
+	service, err := h.service.At(c.Request.Context(), id)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return nil, false
+	}
+	return service, true
+}
+
 func (h *TerminHandler) GetAppointmentTimes(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
 	date := c.Query("date")
 	if date == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Datum ist erforderlich"})
 		return
 	}
 
-	times, err := h.service.GetTimeSlotsByDate(c.Request.Context(), date)
+	filter, err := slotFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	times, err := service.GetTimeSlotsByDate(c.Request.Context(), date, filter)
 
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		c.JSON(errorStatus(err, http.StatusNotFound), gin.H{"error": err.Error()})
 		return
 	}
 
 	if len(times) == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Keine Termine"})
+		c.JSON(http.StatusNotFound, gin.H{"error": "Keine Termine", "waitlist": true})
 		return
 	}
 
@@ -48,49 +143,97 @@ type AppoinmentCreate struct {
 	Desc  string `json:"desc"`
 	Type  string `json:"type"`
 	Date  string `json:"date"`
+	Staff int    `json:"staff"`
+	Hold  string `json:"hold"`
 }
 
 func (h *TerminHandler) BookAppoinment(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
 	var CreateData AppoinmentCreate
 	if err := c.ShouldBind(&CreateData); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	date,err := time.Parse("2006-01-02 15:04",CreateData.Date)
+	date,err := time.ParseInLocation("2006-01-02 15:04",CreateData.Date,service.Location())
 
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	appoinment, err := h.service.BookAppointment(c.Request.Context(),CreateData.Name,CreateData.Email,CreateData.Phone,CreateData.Desc,appointment.Type(CreateData.Type),date)
+	appoinment, err := service.BookAppointment(c.Request.Context(),CreateData.Name,CreateData.Email,CreateData.Phone,CreateData.Desc,appointment.Type(CreateData.Type),date,termin.BookingOptions{Staff: CreateData.Staff, Hold: CreateData.Hold})
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK,gin.H{"data":appoinment.String()})
+	c.JSON(http.StatusOK,gin.H{"data":localize(appoinment, service.Location()).String()})
 	return
 }
 
 
 
-func (h *TerminHandler) DeleteAppoinment(c *gin.Context) {
+type AppoinmentReschedule struct {
+	Date  string `json:"date"`
+	Staff int    `json:"staff"`
+}
+
+// RescheduleAppoinment moves the appointment with the delete key in the "key"
+// query parameter to the date in the body.
+func (h *TerminHandler) RescheduleAppoinment(c *gin.Context) {
 	key := c.Query("key")
 	if key == "" {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "key ist erforderlich"})
 		return
 	}
 
-	err := h.service.DeleteAppointment(c.Request.Context(), key)
+	var UpdateData AppoinmentReschedule
+	if err := c.ShouldBindJSON(&UpdateData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	service, _, err := h.service.ForAppointment(c.Request.Context(), key)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
 
+	date, err := time.ParseInLocation("2006-01-02 15:04", UpdateData.Date, service.Location())
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
+	appoinment, err := service.RescheduleAppointment(c.Request.Context(), key, date, termin.BookingOptions{Staff: UpdateData.Staff})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": localize(appoinment, service.Location()).String()})
+}
+
+func (h *TerminHandler) DeleteAppoinment(c *gin.Context) {
+	key := c.Query("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "key ist erforderlich"})
+		return
+	}
+
+	_, err := h.service.CancelAppointment(c.Request.Context(), key, c.Query("reason"))
+
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{"data": "Termin Losen"})
 	return
 }
\ No newline at end of file
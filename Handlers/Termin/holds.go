@@ -0,0 +1,79 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"TerminSystem/ent/appointment"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// holdCookie is the cookie that tells the browsers taking slot holds apart.
+const holdCookie = "termin_client"
+
+type SlotHoldCreate struct {
+	Type  string `json:"type"`
+	Date  string `json:"date"`
+	Staff int    `json:"staff"`
+}
+
+// HoldSlot keeps a slot free while the customer fills in the booking form.
+// The returned token is sent along as "hold" when booking.
+func (h *TerminHandler) HoldSlot(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	var HoldData SlotHoldCreate
+	if err := c.ShouldBindJSON(&HoldData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	date, err := time.ParseInLocation("2006-01-02 15:04", HoldData.Date, service.Location())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	client, err := holdClient(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	hold, err := service.HoldSlot(c.Request.Context(), appointment.Type(HoldData.Type), date, termin.BookingOptions{
+		Staff:   HoldData.Staff,
+		Client:  client,
+		Address: c.ClientIP(),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	loc := service.Location()
+	hold.StartTime = hold.StartTime.In(loc)
+	hold.EndTime = hold.EndTime.In(loc)
+	hold.ExpiresAt = hold.ExpiresAt.In(loc)
+	c.JSON(http.StatusOK, gin.H{"data": hold})
+}
+
+// holdClient returns the ID of the browser in the hold cookie, setting the
+// cookie on the first hold.
+func holdClient(c *gin.Context) (string, error) {
+	if client, err := c.Cookie(holdCookie); err == nil && client != "" {
+		return client, nil
+	}
+
+	client, err := gonanoid.New(32)
+	if err != nil {
+		return "", err
+	}
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(holdCookie, client, 0, "/api", "", c.Request.TLS != nil, true)
+	return client, nil
+}
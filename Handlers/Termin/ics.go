@@ -0,0 +1,26 @@
+package termin
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DownloadICS returns the appointment with the delete key in the "key" query
+// parameter as iCalendar file.
+func (h *TerminHandler) DownloadICS(c *gin.Context) {
+	key := c.Query("key")
+	if key == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "key ist erforderlich"})
+		return
+	}
+
+	ics, err := h.service.AppointmentICS(c.Request.Context(), key)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="termin.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", ics)
+}
@@ -0,0 +1,44 @@
+package termin
+
+import (
+	holidays "TerminSystem/Holidays"
+	termin "TerminSystem/Repositories/Termin"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LocationCreate struct {
+	Name     string `json:"name"`
+	Address  string `json:"address"`
+	Timezone string `json:"timezone"`
+	// State is the Bundesland of the branch's public holidays, that of the
+	// main shop if missing.
+	State *holidays.State `json:"state"`
+}
+
+func (h *TerminHandler) ListLocations(c *gin.Context) {
+	locations, err := h.service.ListLocations(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": locations})
+}
+
+func (h *TerminHandler) CreateLocation(c *gin.Context) {
+	var CreateData LocationCreate
+	if err := c.ShouldBindJSON(&CreateData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	location, err := h.service.CreateLocation(c.Request.Context(), CreateData.Name, CreateData.Address, CreateData.Timezone, termin.LocationOptions{State: CreateData.State})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": location})
+}
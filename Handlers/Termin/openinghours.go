@@ -0,0 +1,169 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// OpeningHoursUpdate sets the opening intervals of a day, either as a list
+// in Hours or as a single interval in Open and Close.
+type OpeningHoursUpdate struct {
+	Open  string            `json:"open"`
+	Close string            `json:"close"`
+	Hours []termin.Interval `json:"hours"`
+}
+
+func (u OpeningHoursUpdate) intervals() []termin.Interval {
+	if len(u.Hours) > 0 {
+		return u.Hours
+	}
+	return []termin.Interval{{Open: u.Open, Close: u.Close}}
+}
+
+// GetAvailableDates lists the days that can be booked, so the date picker
+// does not need to know the opening hours itself.
+func (h *TerminHandler) GetAvailableDates(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	days := 28
+	if value := c.Query("days"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "days muss eine positive Zahl sein"})
+			return
+		}
+		days = parsed
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": service.GetAvailableDates(c.Request.Context(), days)})
+}
+
+func (h *TerminHandler) ListOpeningHours(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	hours, err := service.ListOpeningHours(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": hours})
+}
+
+func (h *TerminHandler) SetOpeningHours(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	weekday, ok := weekdayParam(c)
+	if !ok {
+		return
+	}
+
+	var update OpeningHoursUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	hours, err := service.SetOpeningHours(c.Request.Context(), weekday, update.intervals())
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": hours})
+}
+
+func (h *TerminHandler) CloseWeekday(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	weekday, ok := weekdayParam(c)
+	if !ok {
+		return
+	}
+
+	if err := service.CloseWeekday(c.Request.Context(), weekday); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Geschlossen"})
+}
+
+// weekdayParam reads the ":weekday" path parameter, 0 being Sunday. It
+// answers the request itself if the parameter is invalid.
+func weekdayParam(c *gin.Context) (time.Weekday, bool) {
+	weekday, err := strconv.Atoi(c.Param("weekday"))
+	if err != nil || weekday < 0 || weekday > 6 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "weekday muss zwischen 0 (Sonntag) und 6 (Samstag) liegen"})
+		return 0, false
+	}
+	return time.Weekday(weekday), true
+}
+
+func (h *TerminHandler) ListOpeningHoursExceptions(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	from := c.DefaultQuery("from", service.Now().Format("2006-01-02"))
+
+	exceptions, err := service.ListOpeningHoursExceptions(c.Request.Context(), from)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": exceptions})
+}
+
+func (h *TerminHandler) SetOpeningHoursException(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	var update OpeningHoursUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	exception, err := service.SetOpeningHoursException(c.Request.Context(), c.Param("date"), update.intervals())
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": exception})
+}
+
+func (h *TerminHandler) RemoveOpeningHoursException(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	if err := service.RemoveOpeningHoursException(c.Request.Context(), c.Param("date")); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": "Reguläre Öffnungszeiten"})
+}
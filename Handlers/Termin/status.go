@@ -0,0 +1,43 @@
+package termin
+
+import (
+	"TerminSystem/ent/appointment"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StatusUpdate struct {
+	Status string `json:"status"`
+}
+
+// SetStatus moves the appointment in the ":id" path parameter to another
+// status, e.g. when the customer checks in or does not show up.
+func (h *TerminHandler) SetStatus(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "id muss eine Zahl sein"})
+		return
+	}
+
+	var update StatusUpdate
+	if err := c.ShouldBindJSON(&update); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status := appointment.Status(update.Status)
+	if err := appointment.StatusValidator(status); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Ungültiger Status"})
+		return
+	}
+
+	appoinment, err := h.service.SetStatus(c.Request.Context(), id, status)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusInternalServerError), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": appoinment})
+}
@@ -0,0 +1,88 @@
+package termin
+
+import (
+	termin "TerminSystem/Repositories/Termin"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/templates"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WaitlistJoin struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Phone string `json:"phone"`
+	Date  string `json:"date"`
+	Type  string `json:"type"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// JoinWaitlist puts the customer on the waitlist of a fully booked date.
+func (h *TerminHandler) JoinWaitlist(c *gin.Context) {
+	service, ok := h.scoped(c)
+	if !ok {
+		return
+	}
+
+	var JoinData WaitlistJoin
+	if err := c.ShouldBindJSON(&JoinData); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	entry, err := service.JoinWaitlist(c.Request.Context(), JoinData.Name, JoinData.Email, JoinData.Phone, JoinData.Date, termin.WaitlistOptions{
+		Type: appointment.Type(JoinData.Type),
+		From: JoinData.From,
+		To:   JoinData.To,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": entry})
+}
+
+// ClaimPage shows the customer the button that books the slot offered with
+// the "token" query parameter. It is the target of the claim link sent to the
+// customer, which only books when the button is pressed.
+func (h *TerminHandler) ClaimPage(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token ist erforderlich"})
+		return
+	}
+
+	c.Status(http.StatusOK)
+	c.Header("Content-Type", "text/html")
+	templates.ClaimWaitlistOffer(token).Render(c.Request.Context(), c.Writer)
+}
+
+// ClaimWaitlistOffer books the slot offered with the token in the "token"
+// form field posted by the claim page, or the query parameter.
+func (h *TerminHandler) ClaimWaitlistOffer(c *gin.Context) {
+	token := c.PostForm("token")
+	if token == "" {
+		token = c.Query("token")
+	}
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token ist erforderlich"})
+		return
+	}
+
+	appoinment, err := h.service.ClaimWaitlistOffer(c.Request.Context(), token)
+	if err != nil {
+		c.JSON(errorStatus(err, http.StatusBadRequest), gin.H{"error": err.Error()})
+		return
+	}
+
+	service, _, err := h.service.ForAppointment(c.Request.Context(), appoinment.Delkey)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": localize(appoinment, service.Location()).String()})
+}
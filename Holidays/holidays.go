@@ -0,0 +1,158 @@
+// Package holidays computes the German public holidays of a year, both the
+// federal ones and those of the individual Bundesländer, without any lookup
+// service.
+package holidays
+
+import (
+	"slices"
+	"time"
+)
+
+// State is the two-letter code of a German Bundesland. The empty State stands
+// for the federal holidays only.
+type State string
+
+const (
+	Federal               State = ""
+	BadenWuerttemberg     State = "BW"
+	Bayern                State = "BY"
+	Berlin                State = "BE"
+	Brandenburg           State = "BB"
+	Bremen                State = "HB"
+	Hamburg               State = "HH"
+	Hessen                State = "HE"
+	MecklenburgVorpommern State = "MV"
+	Niedersachsen         State = "NI"
+	NordrheinWestfalen    State = "NW"
+	RheinlandPfalz        State = "RP"
+	Saarland              State = "SL"
+	Sachsen               State = "SN"
+	SachsenAnhalt         State = "ST"
+	SchleswigHolstein     State = "SH"
+	Thueringen            State = "TH"
+)
+
+// States lists all Bundesländer.
+var States = []State{
+	BadenWuerttemberg, Bayern, Berlin, Brandenburg, Bremen, Hamburg, Hessen, MecklenburgVorpommern,
+	Niedersachsen, NordrheinWestfalen, RheinlandPfalz, Saarland, Sachsen, SachsenAnhalt, SchleswigHolstein, Thueringen,
+}
+
+// Valid reports whether the state is one of States or Federal.
+func (s State) Valid() bool {
+	return s == Federal || slices.Contains(States, s)
+}
+
+// Holiday is a public holiday on a calendar date. Date is midnight UTC.
+type Holiday struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+// rule describes a holiday and where and since when it is observed.
+type rule struct {
+	name string
+	// date returns the holiday's date in the given year.
+	date func(year int) time.Time
+	// states observing the holiday, nil meaning all of Germany.
+	states []State
+	// since is the first year the holiday is observed, 0 meaning always.
+	since int
+}
+
+var rules = []rule{
+	{name: "Neujahr", date: fixed(time.January, 1)},
+	{name: "Heilige Drei Könige", date: fixed(time.January, 6), states: []State{BadenWuerttemberg, Bayern, SachsenAnhalt}},
+	{name: "Internationaler Frauentag", date: fixed(time.March, 8), states: []State{Berlin}, since: 2019},
+	{name: "Internationaler Frauentag", date: fixed(time.March, 8), states: []State{MecklenburgVorpommern}, since: 2023},
+	{name: "Karfreitag", date: easterOffset(-2)},
+	{name: "Ostersonntag", date: easterOffset(0), states: []State{Brandenburg}},
+	{name: "Ostermontag", date: easterOffset(1)},
+	{name: "Tag der Arbeit", date: fixed(time.May, 1)},
+	{name: "Christi Himmelfahrt", date: easterOffset(39)},
+	{name: "Pfingstsonntag", date: easterOffset(49), states: []State{Brandenburg}},
+	{name: "Pfingstmontag", date: easterOffset(50)},
+	{name: "Fronleichnam", date: easterOffset(60), states: []State{BadenWuerttemberg, Bayern, Hessen, NordrheinWestfalen, RheinlandPfalz, Saarland}},
+	{name: "Mariä Himmelfahrt", date: fixed(time.August, 15), states: []State{Saarland}},
+	{name: "Weltkindertag", date: fixed(time.September, 20), states: []State{Thueringen}, since: 2019},
+	{name: "Tag der Deutschen Einheit", date: fixed(time.October, 3), since: 1990},
+	{name: "Reformationstag", date: fixed(time.October, 31), states: []State{Brandenburg, MecklenburgVorpommern, Sachsen, SachsenAnhalt, Thueringen}},
+	{name: "Reformationstag", date: fixed(time.October, 31), states: []State{Bremen, Hamburg, Niedersachsen, SchleswigHolstein}, since: 2018},
+	{name: "Allerheiligen", date: fixed(time.November, 1), states: []State{BadenWuerttemberg, Bayern, NordrheinWestfalen, RheinlandPfalz, Saarland}},
+	{name: "Buß- und Bettag", date: repentanceDay, states: []State{Sachsen}},
+	{name: "1. Weihnachtstag", date: fixed(time.December, 25)},
+	{name: "2. Weihnachtstag", date: fixed(time.December, 26)},
+}
+
+// ForYear returns the public holidays of the year in the state, ordered by
+// date.
+func ForYear(year int, state State) []Holiday {
+	var result []Holiday
+	for _, r := range rules {
+		if year < r.since {
+			continue
+		}
+		if r.states != nil && !slices.Contains(r.states, state) {
+			continue
+		}
+		result = append(result, Holiday{Date: r.date(year), Name: r.name})
+	}
+
+	slices.SortStableFunc(result, func(a, b Holiday) int {
+		return a.Date.Compare(b.Date)
+	})
+	return result
+}
+
+// Lookup returns the public holiday falling on the calendar date of t in the
+// state, if any.
+func Lookup(t time.Time, state State) (Holiday, bool) {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	for _, holiday := range ForYear(t.Year(), state) {
+		if holiday.Date.Equal(date) {
+			return holiday, true
+		}
+	}
+	return Holiday{}, false
+}
+
+// Easter returns Easter Sunday of the year in the Gregorian calendar, using
+// the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func Easter(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func fixed(month time.Month, day int) func(int) time.Time {
+	return func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func easterOffset(days int) func(int) time.Time {
+	return func(year int) time.Time {
+		return Easter(year).AddDate(0, 0, days)
+	}
+}
+
+// repentanceDay returns the Buß- und Bettag, the last Wednesday before
+// 23 November.
+func repentanceDay(year int) time.Time {
+	date := time.Date(year, time.November, 22, 0, 0, 0, 0, time.UTC)
+	back := (int(date.Weekday()) - int(time.Wednesday) + 7) % 7
+	return date.AddDate(0, 0, -back)
+}
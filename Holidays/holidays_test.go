@@ -0,0 +1,79 @@
+package holidays
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestEaster(t *testing.T) {
+	tests := map[int]time.Time{
+		2000: date(2000, time.April, 23),
+		2019: date(2019, time.April, 21),
+		2024: date(2024, time.March, 31),
+		2025: date(2025, time.April, 20),
+		2026: date(2026, time.April, 5),
+		2038: date(2038, time.April, 25),
+	}
+
+	for year, expected := range tests {
+		assert.Equal(t, expected, Easter(year), "Easter %d", year)
+	}
+}
+
+func TestForYear(t *testing.T) {
+	federal := ForYear(2026, Federal)
+	assert.Len(t, federal, 9)
+	assert.Equal(t, Holiday{Date: date(2026, time.January, 1), Name: "Neujahr"}, federal[0])
+	assert.Equal(t, Holiday{Date: date(2026, time.December, 26), Name: "2. Weihnachtstag"}, federal[len(federal)-1])
+
+	for _, state := range States {
+		assert.GreaterOrEqual(t, len(ForYear(2026, state)), len(federal), string(state))
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		state    State
+		expected string
+	}{
+		{"Karfreitag", date(2026, time.April, 3), Federal, "Karfreitag"},
+		{"Ostermontag", date(2025, time.April, 21), Federal, "Ostermontag"},
+		{"Christi Himmelfahrt", date(2026, time.May, 14), Federal, "Christi Himmelfahrt"},
+		{"Pfingstmontag", date(2026, time.May, 25), Berlin, "Pfingstmontag"},
+		{"Tag der Deutschen Einheit", date(2026, time.October, 3), Hamburg, "Tag der Deutschen Einheit"},
+		{"Fronleichnam in Bayern", date(2026, time.June, 4), Bayern, "Fronleichnam"},
+		{"no Fronleichnam in Berlin", date(2026, time.June, 4), Berlin, ""},
+		{"Frauentag in Berlin", date(2026, time.March, 8), Berlin, "Internationaler Frauentag"},
+		{"no Frauentag in Berlin before 2019", date(2018, time.March, 8), Berlin, ""},
+		{"Reformationstag in Hamburg", date(2026, time.October, 31), Hamburg, "Reformationstag"},
+		{"no Reformationstag in Hamburg before 2018", date(2017, time.October, 31), Hamburg, ""},
+		{"Buß- und Bettag 2025", date(2025, time.November, 19), Sachsen, "Buß- und Bettag"},
+		{"Buß- und Bettag 2026", date(2026, time.November, 18), Sachsen, "Buß- und Bettag"},
+		{"no Buß- und Bettag outside Sachsen", date(2026, time.November, 18), Bayern, ""},
+		{"ordinary day", date(2026, time.October, 19), Federal, ""},
+		{"time of day is ignored", time.Date(2026, time.December, 25, 15, 30, 0, 0, time.FixedZone("CET", 3600)), Federal, "1. Weihnachtstag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holiday, ok := Lookup(tt.date, tt.state)
+			assert.Equal(t, tt.expected != "", ok)
+			assert.Equal(t, tt.expected, holiday.Name)
+		})
+	}
+}
+
+func TestStateValid(t *testing.T) {
+	assert.True(t, Federal.Valid())
+	assert.True(t, Bayern.Valid())
+	assert.False(t, State("BA").Valid())
+	assert.False(t, State("by").Valid())
+}
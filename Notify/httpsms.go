@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPSMSSender sends text messages through a provider's HTTP API by posting
+// them as JSON:
+//
+//	{"from": "...", "to": "+49...", "text": "...", "encoding": "gsm7", "segments": 1}
+//
+// Any 2xx response counts as accepted.
+type HTTPSMSSender struct {
+	// URL is the endpoint messages are posted to.
+	URL string
+	// Token is sent as bearer token, if set.
+	Token string
+	// From is the sender name or number.
+	From string
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+	// Timeout limits sending one message, 10 seconds if zero.
+	Timeout time.Duration
+}
+
+// defaultSMSTimeout limits sending a text message unless
+// HTTPSMSSender.Timeout is set.
+const defaultSMSTimeout = 10 * time.Second
+
+type httpSMS struct {
+	From     string   `json:"from,omitempty"`
+	To       string   `json:"to"`
+	Text     string   `json:"text"`
+	Encoding Encoding `json:"encoding"`
+	Segments int      `json:"segments"`
+}
+
+func (h *HTTPSMSSender) SendSMS(ctx context.Context, sms SMS) error {
+	payload, err := json.Marshal(httpSMS{
+		From:     h.From,
+		To:       sms.To,
+		Text:     sms.Text,
+		Encoding: EncodingOf(sms.Text),
+		Segments: Segments(sms.Text),
+	})
+	if err != nil {
+		return err
+	}
+
+	timeout := h.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMSTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.Token)
+	}
+
+	client := h.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sms provider answered %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
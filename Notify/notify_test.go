@@ -0,0 +1,184 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	msg := Message{To: "kunde@example.com", Subject: "Ihr Termin am Montag", Body: "Schlüssel: abc"}
+
+	data, err := msg.Format("shop@example.com", time.Date(2030, time.May, 6, 10, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	parsed, err := mail.ReadMessage(bytes.NewReader(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "shop@example.com", parsed.Header.Get("From"))
+	assert.Equal(t, "kunde@example.com", parsed.Header.Get("To"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, msg.Subject, subject)
+
+	date, err := parsed.Header.Date()
+	assert.NoError(t, err)
+	assert.True(t, date.Equal(time.Date(2030, time.May, 6, 10, 0, 0, 0, time.UTC)))
+
+	body, err := io.ReadAll(parsed.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Schl=C3=BCssel: abc", string(body))
+}
+
+func TestFormatRejectsHeaderInjection(t *testing.T) {
+	for _, msg := range []Message{
+		{To: "kunde@example.com\r\nBcc: alle@example.com", Subject: "Termin"},
+		{To: "kunde@example.com", Subject: "Termin\nBcc: alle@example.com"},
+	} {
+		_, err := msg.Format("shop@example.com", time.Now())
+		assert.ErrorIs(t, err, ErrInvalidHeader)
+	}
+
+	_, err := Message{To: "kunde@example.com", Subject: "Termin"}.Format("shop@example.com\r\nBcc: alle@example.com", time.Now())
+	assert.ErrorIs(t, err, ErrInvalidHeader)
+}
+
+func TestFormatAttachments(t *testing.T) {
+	data := bytes.Repeat([]byte("BEGIN:VCALENDAR\r\n"), 10)
+	msg := Message{
+		To:          "kunde@example.com",
+		Subject:     "Termin",
+		Body:        "Im Anhang",
+		Attachments: []Attachment{{Name: "termin.ics", ContentType: "text/calendar; charset=utf-8", Data: data}},
+	}
+
+	formatted, err := msg.Format("shop@example.com", time.Now())
+	assert.NoError(t, err)
+	parsed, err := mail.ReadMessage(bytes.NewReader(formatted))
+	assert.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	parts := multipart.NewReader(parsed.Body, params["boundary"])
+
+	body, err := parts.NextPart()
+	assert.NoError(t, err)
+	text, err := io.ReadAll(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Im Anhang", string(text))
+
+	attachment, err := parts.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "termin.ics", attachment.FileName())
+	assert.Equal(t, "text/calendar; charset=utf-8", attachment.Header.Get("Content-Type"))
+	assert.Equal(t, "base64", attachment.Header.Get("Content-Transfer-Encoding"))
+	decoded, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, attachment))
+	assert.NoError(t, err)
+	assert.Equal(t, data, decoded)
+
+	_, err = parts.NextPart()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestSinks(t *testing.T) {
+	ctx := context.Background()
+	msg := Message{To: "kunde@example.com", Subject: "Termin", Body: "Hallo"}
+
+	var memory MemoryNotifier
+	assert.NoError(t, memory.Send(ctx, msg))
+	assert.Equal(t, []Message{msg}, memory.Sent())
+
+	dir := filepath.Join(t.TempDir(), "mails")
+	files := &FileNotifier{Dir: dir, From: "shop@example.com"}
+	assert.NoError(t, files.Send(ctx, msg))
+	assert.NoError(t, files.Send(ctx, msg))
+
+	written, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	if assert.Len(t, written, 2) {
+		content, err := os.ReadFile(filepath.Join(dir, written[0].Name()))
+		assert.NoError(t, err)
+		parsed, err := mail.ReadMessage(bytes.NewReader(content))
+		assert.NoError(t, err)
+		assert.Equal(t, "kunde@example.com", parsed.Header.Get("To"))
+	}
+}
+
+func TestSMTPNotifier(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		received <- fakeSMTP(textproto.NewConn(conn))
+
+		// The next client is never answered.
+		stalled, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer stalled.Close()
+		io.Copy(io.Discard, stalled)
+	}()
+
+	notifier := &SMTPNotifier{Addr: listener.Addr().String(), From: "shop@example.com"}
+	assert.NoError(t, notifier.Send(context.Background(), Message{To: "kunde@example.com", Subject: "Termin", Body: "Hallo"}))
+	parsed, err := mail.ReadMessage(strings.NewReader(<-received))
+	assert.NoError(t, err)
+	assert.Equal(t, "kunde@example.com", parsed.Header.Get("To"))
+
+	notifier.Timeout = 50 * time.Millisecond
+	started := time.Now()
+	assert.Error(t, notifier.Send(context.Background(), Message{To: "kunde@example.com", Subject: "Termin", Body: "Hallo"}))
+	assert.Less(t, time.Since(started), 5*time.Second)
+}
+
+// fakeSMTP serves a single email over conn and returns its data.
+func fakeSMTP(conn *textproto.Conn) string {
+	conn.PrintfLine("220 localhost ESMTP")
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return ""
+		}
+		switch verb, _, _ := strings.Cut(line, " "); strings.ToUpper(verb) {
+		case "EHLO":
+			conn.PrintfLine("250-localhost")
+			conn.PrintfLine("250 8BITMIME")
+		case "DATA":
+			conn.PrintfLine("354 go ahead")
+			data, _ := conn.ReadDotBytes()
+			conn.PrintfLine("250 queued")
+			defer func() { conn.PrintfLine("221 bye") }()
+			for {
+				if line, err := conn.ReadLine(); err != nil || strings.EqualFold(line, "QUIT") {
+					return string(data)
+				}
+			}
+		default:
+			conn.PrintfLine("250 ok")
+		}
+	}
+}
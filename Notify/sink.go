@@ -0,0 +1,65 @@
+package notify
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryNotifier keeps the emails it is sent instead of delivering them.
+type MemoryNotifier struct {
+	mu   sync.Mutex
+	sent []Message
+}
+
+func (n *MemoryNotifier) Send(ctx context.Context, msg Message) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, msg)
+	return nil
+}
+
+// Sent returns the emails sent so far, oldest first.
+func (n *MemoryNotifier) Sent() []Message {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]Message(nil), n.sent...)
+}
+
+// FileNotifier writes every email to its own .eml file in Dir, where a mail
+// client can open it.
+type FileNotifier struct {
+	Dir  string
+	From string
+}
+
+func (n *FileNotifier) Send(ctx context.Context, msg Message) error {
+	if err := os.MkdirAll(n.Dir, 0o755); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	recipient := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>| `, r) {
+			return '_'
+		}
+		return r
+	}, msg.To)
+
+	data, err := msg.Format(n.From, now)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(n.Dir, now.Format("20060102-150405")+"-"+recipient+"-*.eml")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
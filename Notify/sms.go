@@ -0,0 +1,182 @@
+package notify
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"unicode/utf16"
+)
+
+// SMS is a text message to a phone number in E.164 format.
+type SMS struct {
+	To   string
+	Text string
+}
+
+// SMSSender sends text messages.
+type SMSSender interface {
+	SendSMS(ctx context.Context, sms SMS) error
+}
+
+// Encoding is the character set a text message is sent in.
+type Encoding string
+
+const (
+	// GSM7 packs 160 characters of the GSM 03.38 alphabet into one message.
+	GSM7 Encoding = "gsm7"
+	// UCS2 is used for any other text and fits 70 characters.
+	UCS2 Encoding = "ucs2"
+)
+
+// gsm7 is the basic GSM 03.38 alphabet, gsm7Extension the characters that
+// take an escape and thus two septets.
+const (
+	gsm7          = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	gsm7Extension = "\f^{}\\[~]|€"
+)
+
+// gsmReplacements maps typographic characters to their plain GSM 03.38
+// counterparts, so a stray quote does not halve the message length.
+var gsmReplacements = strings.NewReplacer(
+	"‘", "'", "’", "'", "‚", "'",
+	"“", "\"", "”", "\"", "„", "\"",
+	"–", "-", "—", "-",
+	"…", "...",
+	"\u00a0", " ",
+)
+
+// EncodingOf returns the encoding text has to be sent in.
+func EncodingOf(text string) Encoding {
+	for _, r := range text {
+		if !strings.ContainsRune(gsm7, r) && !strings.ContainsRune(gsm7Extension, r) {
+			return UCS2
+		}
+	}
+	return GSM7
+}
+
+// Segments returns how many messages text is split into when sent.
+func Segments(text string) int {
+	single, multi := limits(EncodingOf(text))
+	length := textLength(text)
+	if length <= single {
+		return 1
+	}
+	return (length + multi - 1) / multi
+}
+
+// FitSMS prepares text for sending: typographic characters are replaced by
+// their GSM 03.38 counterparts and text that would take more than
+// maxSegments messages is cut short.
+func FitSMS(text string, maxSegments int) string {
+	text = gsmReplacements.Replace(text)
+	if maxSegments <= 0 || Segments(text) <= maxSegments {
+		return text
+	}
+
+	const ellipsis = "..."
+	encoding := EncodingOf(text)
+	single, multi := limits(encoding)
+	room := single
+	if maxSegments > 1 {
+		room = multi * maxSegments
+	}
+	room -= len(ellipsis)
+
+	var fitted strings.Builder
+	used := 0
+	for _, r := range text {
+		size := runeLength(r, encoding)
+		if used+size > room {
+			break
+		}
+		fitted.WriteRune(r)
+		used += size
+	}
+	return strings.TrimRight(fitted.String(), " \n") + ellipsis
+}
+
+// limits returns how many units of the encoding fit into a single message
+// and into each part of a concatenated one.
+func limits(encoding Encoding) (single, multi int) {
+	if encoding == GSM7 {
+		return 160, 153
+	}
+	return 70, 67
+}
+
+// textLength returns the length of text in septets for GSM 03.38 text and in
+// UTF-16 code units otherwise.
+func textLength(text string) int {
+	encoding := EncodingOf(text)
+	length := 0
+	for _, r := range text {
+		length += runeLength(r, encoding)
+	}
+	return length
+}
+
+// runeLength returns how many septets or UTF-16 code units r takes in the
+// encoding.
+func runeLength(r rune, encoding Encoding) int {
+	switch {
+	case encoding == UCS2:
+		return utf16.RuneLen(r)
+	case strings.ContainsRune(gsm7Extension, r):
+		return 2
+	default:
+		return 1
+	}
+}
+
+// E164 normalizes a German phone number as customers type it, e.g.
+// "0171 1234567" or "+49 (171) 123-4567", to "+491711234567". It reports
+// false if phone is not a phone number.
+func E164(phone string) (string, bool) {
+	digits := strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r == '+':
+			return r
+		case strings.ContainsRune(" -/().", r):
+			return -1
+		default:
+			return 'x'
+		}
+	}, phone)
+
+	switch {
+	case strings.HasPrefix(digits, "+"):
+	case strings.HasPrefix(digits, "00"):
+		digits = "+" + digits[2:]
+	case strings.HasPrefix(digits, "0"):
+		digits = "+49" + digits[1:]
+	default:
+		return "", false
+	}
+
+	if strings.ContainsAny(digits[1:], "+x") || len(digits) < 8 || len(digits) > 16 {
+		return "", false
+	}
+	return digits, true
+}
+
+// FakeSMSSender keeps the text messages it is sent instead of delivering
+// them.
+type FakeSMSSender struct {
+	mu   sync.Mutex
+	sent []SMS
+}
+
+func (f *FakeSMSSender) SendSMS(ctx context.Context, sms SMS) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, sms)
+	return nil
+}
+
+// Sent returns the text messages sent so far, oldest first.
+func (f *FakeSMSSender) Sent() []SMS {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]SMS(nil), f.sent...)
+}
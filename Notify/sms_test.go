@@ -0,0 +1,107 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoding(t *testing.T) {
+	assert.Equal(t, GSM7, EncodingOf("Ihr Termin am 06.05. für Trauringe, Straße 5 [€]"))
+	assert.Equal(t, UCS2, EncodingOf("Termin bestätigt 👍"))
+	assert.Equal(t, UCS2, EncodingOf("Termin „bestätigt“"))
+
+	assert.Equal(t, 1, Segments(strings.Repeat("a", 160)))
+	assert.Equal(t, 2, Segments(strings.Repeat("a", 161)))
+	assert.Equal(t, 3, Segments(strings.Repeat("a", 307)))
+	// Characters of the extension table take two septets.
+	assert.Equal(t, 2, Segments(strings.Repeat("€", 81)))
+	assert.Equal(t, 1, Segments(strings.Repeat("ł", 70)))
+	assert.Equal(t, 2, Segments(strings.Repeat("ł", 71)))
+	// Characters outside the BMP take two UTF-16 code units.
+	assert.Equal(t, 2, Segments(strings.Repeat("👍", 36)))
+}
+
+func TestFitSMS(t *testing.T) {
+	// Typographic characters do not force UCS-2.
+	fitted := FitSMS("Termin „bestätigt“ – bis morgen…", 1)
+	assert.Equal(t, `Termin "bestätigt" - bis morgen...`, fitted)
+	assert.Equal(t, GSM7, EncodingOf(fitted))
+
+	short := "Ihr Termin ist bestätigt."
+	assert.Equal(t, short, FitSMS(short, 1))
+
+	long := FitSMS(strings.Repeat("Termin ", 100), 2)
+	assert.Equal(t, 2, Segments(long))
+	assert.True(t, strings.HasSuffix(long, "..."), long)
+	assert.LessOrEqual(t, len(long), 306)
+
+	single := FitSMS(strings.Repeat("ł", 100), 1)
+	assert.Equal(t, 1, Segments(single))
+	assert.Equal(t, strings.Repeat("ł", 67)+"...", single)
+}
+
+func TestE164(t *testing.T) {
+	for phone, expected := range map[string]string{
+		"0171 1234567":       "+491711234567",
+		"+49 30 1234 5678":   "+493012345678",
+		"0049 (171) 123-456": "+49171123456",
+		"+43 1 234567":       "+431234567",
+	} {
+		normalized, ok := E164(phone)
+		assert.True(t, ok, phone)
+		assert.Equal(t, expected, normalized, phone)
+	}
+
+	for _, phone := range []string{"", "171 1234567", "0171 abc", "+49+171", "012"} {
+		_, ok := E164(phone)
+		assert.False(t, ok, phone)
+	}
+}
+
+func TestHTTPSMSSender(t *testing.T) {
+	var received httpSMS
+	var auth string
+	provider := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil || received.To == "" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer provider.Close()
+
+	sender := &HTTPSMSSender{URL: provider.URL, Token: "secret", From: "Juwelier"}
+	assert.NoError(t, sender.SendSMS(context.Background(), SMS{To: "+491711234567", Text: "Termin bestätigt 👍"}))
+	assert.Equal(t, "Bearer secret", auth)
+	assert.Equal(t, httpSMS{From: "Juwelier", To: "+491711234567", Text: "Termin bestätigt 👍", Encoding: UCS2, Segments: 1}, received)
+
+	err := sender.SendSMS(context.Background(), SMS{Text: "Termin"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "400")
+	}
+
+	// A provider that hangs does not hold up the sender.
+	stalled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Reading the request lets the server notice the client giving up.
+		io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	}))
+	defer stalled.Close()
+	slow := &HTTPSMSSender{URL: stalled.URL, Timeout: 50 * time.Millisecond}
+	started := time.Now()
+	assert.Error(t, slow.SendSMS(context.Background(), SMS{To: "+491711234567", Text: "Termin"}))
+	assert.Less(t, time.Since(started), 5*time.Second)
+
+	var fake FakeSMSSender
+	assert.NoError(t, fake.SendSMS(context.Background(), SMS{To: "+491711234567", Text: "Termin"}))
+	assert.Equal(t, []SMS{{To: "+491711234567", Text: "Termin"}}, fake.Sent())
+}
@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+// defaultSMTPTimeout limits sending an email unless SMTPNotifier.Timeout is
+// set.
+const defaultSMTPTimeout = 30 * time.Second
+
+// SMTPNotifier sends emails through an SMTP server.
+type SMTPNotifier struct {
+	// Addr is the "host:port" of the server.
+	Addr string
+	// From is the sender address of all emails.
+	From string
+	// Auth authenticates with the server, nil if it needs no login.
+	Auth smtp.Auth
+	// Timeout limits sending one email, 30 seconds if zero.
+	Timeout time.Duration
+}
+
+// NewSMTPNotifier returns a notifier sending as from through the server at
+// addr, logging in with PLAIN authentication if a username is given.
+func NewSMTPNotifier(addr, from, username, password string) *SMTPNotifier {
+	n := &SMTPNotifier{Addr: addr, From: from}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		n.Auth = smtp.PlainAuth("", username, password, host)
+	}
+	return n
+}
+
+// Send delivers the email like smtp.SendMail, but gives up once ctx is done
+// or the timeout has passed.
+func (n *SMTPNotifier) Send(ctx context.Context, msg Message) error {
+	data, err := msg.Format(n.From, time.Now())
+	if err != nil {
+		return err
+	}
+
+	timeout := n.Timeout
+	if timeout <= 0 {
+		timeout = defaultSMTPTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", n.Addr)
+	if err != nil {
+		return err
+	}
+	// The SMTP client knows no context, so the connection is cut instead.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	host, _, _ := net.SplitHostPort(n.Addr)
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if n.Auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := c.Auth(n.Auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(n.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
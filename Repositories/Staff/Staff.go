@@ -0,0 +1,304 @@
+package staff
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	entstaff "TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ErrStaffNotFound is returned for staff members that do not exist.
+var ErrStaffNotFound = errors.New("staff member not found")
+
+// ErrInvalidStaff wraps the errors of staff members that cannot be saved as
+// given.
+var ErrInvalidStaff = errors.New("invalid staff member")
+
+type StaffService struct {
+	client *ent.Client
+}
+
+// Shift is a block of working time of a staff member, given as "15:04" wall
+// clock times.
+type Shift struct {
+	Weekday time.Weekday `json:"weekday"`
+	Start   string       `json:"start"`
+	End     string       `json:"end"`
+}
+
+// StaffOptions holds the optional parts of a staff member.
+type StaffOptions struct {
+	// Location is the branch the staff member works at, 0 meaning the main
+	// shop.
+	Location int
+}
+
+func NewStaffService(client *ent.Client) *StaffService {
+	return &StaffService{
+		client: client,
+	}
+}
+
+// CreateStaff adds a staff member serving the given appointment types (all
+// types if empty) together with their weekly shifts.
+func (s *StaffService) CreateStaff(ctx context.Context, name string, types []appointment.Type, shifts []Shift, opts ...StaffOptions) (*ent.Staff, error) {
+	var o StaffOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	typeNames, err := validate(name, types, shifts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	create := tx.Staff.Create()
+	if o.Location != 0 {
+		create.SetLocationID(o.Location)
+	}
+
+	member, err := create.
+		SetName(name).
+		SetTypes(typeNames).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := createShifts(ctx, tx, member.ID, shifts); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetStaff(ctx, member.ID)
+}
+
+// GetStaff returns the staff member with the given ID with their shifts.
+func (s *StaffService) GetStaff(ctx context.Context, id int) (*ent.Staff, error) {
+	member, err := s.client.Staff.Query().
+		Where(entstaff.IDEQ(id)).
+		WithWorkingHours().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrStaffNotFound
+	}
+	return member, err
+}
+
+// UpdateStaff replaces the name, appointment types and location of the staff
+// member with the given ID. Nil shifts keep their working hours, otherwise
+// they replace them.
+func (s *StaffService) UpdateStaff(ctx context.Context, id int, name string, types []appointment.Type, shifts []Shift, opts ...StaffOptions) (*ent.Staff, error) {
+	var o StaffOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	typeNames, err := validate(name, types, shifts)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := tx.Staff.UpdateOneID(id).
+		SetName(name).
+		SetTypes(typeNames)
+	if o.Location != 0 {
+		update.SetLocationID(o.Location)
+	} else {
+		update.ClearLocationID()
+	}
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrStaffNotFound
+		}
+		return nil, rollback(tx, err)
+	}
+
+	if shifts != nil {
+		if err := replaceShifts(ctx, tx, id, shifts); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetStaff(ctx, id)
+}
+
+// SetWorkingHours replaces the weekly shifts of the staff member with the
+// given ID.
+func (s *StaffService) SetWorkingHours(ctx context.Context, id int, shifts []Shift) (*ent.Staff, error) {
+	if err := validateShifts(shifts); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := tx.Staff.Query().Where(entstaff.IDEQ(id)).Exist(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if !exists {
+		return nil, rollback(tx, ErrStaffNotFound)
+	}
+
+	if err := replaceShifts(ctx, tx, id, shifts); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return s.GetStaff(ctx, id)
+}
+
+// DeleteStaff removes the staff member with the given ID and their shifts.
+// Their appointments are kept without a staff member.
+func (s *StaffService) DeleteStaff(ctx context.Context, id int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.WorkingHours.Delete().
+		Where(workinghours.HasStaffWith(entstaff.IDEQ(id))).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	if err := tx.Staff.DeleteOneID(id).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			err = ErrStaffNotFound
+		}
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// ListStaff returns the staff members at the location of the options, the
+// main shop by default, with their shifts. A non-empty Type limits the result
+// to staff members serving that type.
+func (s *StaffService) ListStaff(ctx context.Context, Type appointment.Type, opts ...StaffOptions) ([]*ent.Staff, error) {
+	var o StaffOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	here := entstaff.LocationIDIsNil()
+	if o.Location != 0 {
+		here = entstaff.LocationIDEQ(o.Location)
+	}
+
+	members, err := s.client.Staff.Query().
+		Where(here).
+		WithWorkingHours().
+		Order(ent.Asc(entstaff.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if Type == "" {
+		return members, nil
+	}
+
+	var result []*ent.Staff
+	for _, member := range members {
+		if len(member.Types) == 0 || slices.Contains(member.Types, Type.String()) {
+			result = append(result, member)
+		}
+	}
+	return result, nil
+}
+
+// validate checks a staff member before saving them and returns the names of
+// their appointment types.
+func validate(name string, types []appointment.Type, shifts []Shift) ([]string, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is empty", ErrInvalidStaff)
+	}
+
+	typeNames := make([]string, 0, len(types))
+	for _, t := range types {
+		if err := appointment.TypeValidator(t); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidStaff, err)
+		}
+		typeNames = append(typeNames, t.String())
+	}
+
+	return typeNames, validateShifts(shifts)
+}
+
+// validateShifts checks that every shift ends after it starts.
+func validateShifts(shifts []Shift) error {
+	for _, shift := range shifts {
+		if shift.Weekday < time.Sunday || shift.Weekday > time.Saturday {
+			return fmt.Errorf("%w: %d is not a weekday", ErrInvalidStaff, shift.Weekday)
+		}
+		if shift.Start >= shift.End {
+			return fmt.Errorf("%w: shift on %s ends at %s before it starts at %s", ErrInvalidStaff, shift.Weekday, shift.End, shift.Start)
+		}
+	}
+	return nil
+}
+
+// createShifts adds the shifts to the staff member with the given ID.
+func createShifts(ctx context.Context, tx *ent.Tx, id int, shifts []Shift) error {
+	builders := make([]*ent.WorkingHoursCreate, 0, len(shifts))
+	for _, shift := range shifts {
+		builders = append(builders, tx.WorkingHours.Create().
+			SetStaffID(id).
+			SetWeekday(int(shift.Weekday)).
+			SetStart(shift.Start).
+			SetEnd(shift.End))
+	}
+
+	_, err := tx.WorkingHours.CreateBulk(builders...).Save(ctx)
+	if ent.IsValidationError(err) {
+		return fmt.Errorf("%w: %v", ErrInvalidStaff, err)
+	}
+	return err
+}
+
+// replaceShifts replaces the shifts of the staff member with the given ID.
+func replaceShifts(ctx context.Context, tx *ent.Tx, id int, shifts []Shift) error {
+	if _, err := tx.WorkingHours.Delete().
+		Where(workinghours.HasStaffWith(entstaff.IDEQ(id))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	return createShifts(ctx, tx, id, shifts)
+}
+
+// rollback aborts the transaction and returns err, wrapping any rollback failure.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package staff
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/enttest"
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAndListStaff(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewStaffService(client)
+
+	shifts := []Shift{{Weekday: time.Monday, Start: "10:00", End: "17:00"}}
+
+	piercer, err := service.CreateStaff(ctx, "Anna", []appointment.Type{appointment.TypeOhrlochstechen}, shifts)
+	assert.NoError(t, err)
+	assert.Len(t, piercer.Edges.WorkingHours, 1)
+
+	_, err = service.CreateStaff(ctx, "Ben", nil, shifts)
+	assert.NoError(t, err)
+
+	_, err = service.CreateStaff(ctx, "Carla", nil, []Shift{{Weekday: time.Monday, Start: "17:00", End: "10:00"}})
+	assert.Error(t, err)
+
+	_, err = service.CreateStaff(ctx, "Dora", nil, []Shift{{Weekday: time.Monday, Start: "9", End: "10:00"}})
+	assert.Error(t, err)
+
+	all, err := service.ListStaff(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	goldBuyers, err := service.ListStaff(ctx, appointment.TypeGoldankauf)
+	assert.NoError(t, err)
+	if assert.Len(t, goldBuyers, 1) {
+		assert.Equal(t, "Ben", goldBuyers[0].Name)
+	}
+
+	// Staff of a branch are only listed for it.
+	branch, err := client.Location.Create().SetName("Filiale").Save(ctx)
+	assert.NoError(t, err)
+	_, err = service.CreateStaff(ctx, "Emil", nil, shifts, StaffOptions{Location: branch.ID})
+	assert.NoError(t, err)
+
+	all, err = service.ListStaff(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, all, 2)
+	atBranch, err := service.ListStaff(ctx, "", StaffOptions{Location: branch.ID})
+	assert.NoError(t, err)
+	if assert.Len(t, atBranch, 1) {
+		assert.Equal(t, "Emil", atBranch[0].Name)
+	}
+}
+
+func TestUpdateAndDeleteStaff(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewStaffService(client)
+
+	member, err := service.CreateStaff(ctx, "Anna", nil, []Shift{{Weekday: time.Monday, Start: "10:00", End: "17:00"}})
+	assert.NoError(t, err)
+
+	updated, err := service.UpdateStaff(ctx, member.ID, "Anna Schmidt", []appointment.Type{appointment.TypeTrauringe}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Anna Schmidt", updated.Name)
+	assert.Equal(t, []string{"trauringe"}, updated.Types)
+	assert.Len(t, updated.Edges.WorkingHours, 1)
+
+	updated, err = service.SetWorkingHours(ctx, member.ID, []Shift{
+		{Weekday: time.Tuesday, Start: "09:00", End: "12:00"},
+		{Weekday: time.Tuesday, Start: "13:00", End: "18:00"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, updated.Edges.WorkingHours, 2)
+
+	_, err = service.SetWorkingHours(ctx, member.ID, []Shift{{Weekday: time.Tuesday, Start: "18:00", End: "12:00"}})
+	assert.ErrorIs(t, err, ErrInvalidStaff)
+	unchanged, err := service.GetStaff(ctx, member.ID)
+	assert.NoError(t, err)
+	assert.Len(t, unchanged.Edges.WorkingHours, 2)
+
+	_, err = service.UpdateStaff(ctx, member.ID+1, "Ben", nil, nil)
+	assert.ErrorIs(t, err, ErrStaffNotFound)
+
+	assert.NoError(t, service.DeleteStaff(ctx, member.ID))
+	assert.ErrorIs(t, service.DeleteStaff(ctx, member.ID), ErrStaffNotFound)
+	_, err = service.GetStaff(ctx, member.ID)
+	assert.ErrorIs(t, err, ErrStaffNotFound)
+}
@@ -3,49 +3,179 @@ package termin
 import (
 	"TerminSystem/ent"
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/waitlistentry"
 	"context"
+	"errors"
 	"fmt"
+	netmail "net/mail"
 	gonanoid "github.com/matoous/go-nanoid/v2"
 	"time"
 )
 
+// maxLookahead bounds how many days GetAvailableDates searches for open days.
+const maxLookahead = 366
+
 type AppointmentService struct {
 	client *ent.Client
+	config Config
+	// branch is the location the service works on, nil for the main shop.
+	branch *ent.Location
+	// main is the time zone of the main shop.
+	main *time.Location
+}
+
+// SlotFilter narrows down the slots offered by GetTimeSlotsByDate.
+type SlotFilter struct {
+	// Type is the kind of appointment the customer wants to book.
+	Type appointment.Type
+	// Staff selects a specific staff member, 0 meaning any.
+	Staff int
+}
+
+// BookingOptions holds the optional parts of a booking.
+type BookingOptions struct {
+	// Staff selects a specific staff member, 0 meaning any.
+	Staff int
+	// Hold is the token of a slot hold taken for the booking. An expired
+	// hold or one for another slot or staff member is ignored. Booking with
+	// a hold for a staff member books that one.
+	Hold string
+	// Client identifies the browser taking a slot hold, e.g. by a cookie.
+	// Its new hold replaces the previous one.
+	Client string
+	// Address is the IP address a slot hold is taken from, so the holds from
+	// one address can be capped at MaxHolds.
+	Address string
+	// offer is the waitlist entry claiming the slot offered to it.
+	offer int
+}
+
+// TimeSlot is a bookable start time together with the number of customers
+// that can still book it.
+type TimeSlot struct {
+	Time      string `json:"time"`
+	Remaining int    `json:"remaining"`
 }
 
-func NewAppointmentService(client *ent.Client) *AppointmentService {
+func NewAppointmentService(client *ent.Client, config ...Config) *AppointmentService {
+	var cfg Config
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	cfg = cfg.withDefaults()
+
 	return &AppointmentService{
 		client: client,
+		config: cfg,
+		main:   cfg.Location,
 	}
 }
 
-func (s *AppointmentService) GetBusinessHours(weekday time.Weekday) (int, int) {
-	switch weekday {
-	case time.Saturday:
-		return 10, 14
-	case time.Sunday:
-		return -1, -1
-	default:
-		return 10, 17
+// GetSlotCapacity returns how many appointments a single slot on the given
+// weekday can hold.
+func (s *AppointmentService) GetSlotCapacity(weekday time.Weekday) int {
+	if capacity, ok := s.config.WeekdayCapacity[weekday]; ok {
+		return capacity
 	}
+	return s.config.SlotCapacity
 }
 
-func (s *AppointmentService) IsValidTerminDate(dateStr string, timeStr string, now ...time.Time) (bool, error) {
-	parsedDate, err := time.Parse("2006-01-02", dateStr)
-	if err != nil {
-		return false, InvalidDateError(dateStr)
+// GetDuration returns how long an appointment of the given type takes. An
+// empty type takes a single slot interval.
+func (s *AppointmentService) GetDuration(Type appointment.Type) time.Duration {
+	if duration, ok := s.config.Durations[Type]; ok {
+		return duration
 	}
+	return s.config.SlotInterval
+}
+
+// GetBuffer returns the buffer times around an appointment of the given type.
+func (s *AppointmentService) GetBuffer(Type appointment.Type) Buffer {
+	return s.config.Buffers[Type]
+}
+
+// GetBookingWindow returns the booking window of the given type. An empty type
+// gets the window of all types.
+func (s *AppointmentService) GetBookingWindow(Type appointment.Type) BookingWindow {
+	window := s.config.Window
+	if override, ok := s.config.TypeWindows[Type]; ok {
+		if override.LeadTime != nil {
+			window.LeadTime = override.LeadTime
+		}
+		if override.Horizon != 0 {
+			window.Horizon = override.Horizon
+		}
+	}
+	return window
+}
+
+// checkEmail returns an error unless email is a plain email address, as it
+// ends up in email headers and calendar files.
+func checkEmail(email string) error {
+	address, err := netmail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return InvalidEmailError(email)
+	}
+	return nil
+}
+
+// checkBookingWindow returns an error if an appointment of the type starting
+// at start cannot be booked at now, being either too soon or too far ahead.
+func (s *AppointmentService) checkBookingWindow(Type appointment.Type, start, now time.Time) error {
+	window := s.GetBookingWindow(Type)
+	if start.Before(now.Add(window.notice())) {
+		return DateTooSoonError(start.Format("2006-01-02 15:04"), window.notice().String())
+	}
+	if start.After(now.Add(window.Horizon)) {
+		return DateNotReadyError(start.Format("2006-01-02 15:04"), now.Add(window.Horizon).Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+// maxBuffer returns the longest buffer configured for any appointment type.
+func (s *AppointmentService) maxBuffer() time.Duration {
+	var longest time.Duration
+	for _, buffer := range s.config.Buffers {
+		longest = max(longest, buffer.Before, buffer.After)
+	}
+	return longest
+}
 
-	loc, err := time.LoadLocation("Europe/Berlin")
+// Location returns the time zone of the shop.
+func (s *AppointmentService) Location() *time.Location {
+	return s.config.Location
+}
+
+// Now returns the current time in the shop's time zone.
+func (s *AppointmentService) Now() time.Time {
+	return s.config.Now().In(s.config.Location)
+}
+
+// parseDate parses a "2006-01-02" date as midnight in the shop's time zone.
+func (s *AppointmentService) parseDate(dateStr string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02", dateStr, s.config.Location)
+}
+
+// wallClock returns the time at the offset from midnight on the calendar day
+// of date in the shop's time zone. It reports false if that wall clock time
+// is skipped on the day because the clocks are put forward.
+func (s *AppointmentService) wallClock(date time.Time, offset time.Duration) (time.Time, bool) {
+	t := time.Date(date.Year(), date.Month(), date.Day(), int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, s.config.Location)
+	return t, clockOffset(t) == offset
+}
+
+func (s *AppointmentService) IsValidTerminDate(ctx context.Context, dateStr string, timeStr string, now ...time.Time) (bool, error) {
+	parsedDate, err := s.parseDate(dateStr)
 	if err != nil {
-		return false, LocationLoadError()
+		return false, InvalidDateError(dateStr)
 	}
 
 	var currentTime time.Time
 	if len(now) > 0 && !now[0].IsZero() {
-		currentTime = now[0]
+		currentTime = now[0].In(s.config.Location)
 	} else {
-		currentTime = time.Now().In(loc)
+		currentTime = s.Now()
 	}
 
 	var targetTime time.Time
@@ -55,30 +185,31 @@ func (s *AppointmentService) IsValidTerminDate(dateStr string, timeStr string, n
 			return false, InvalidDateError(timeStr)
 		}
 
-		loc, err := time.LoadLocation("Europe/Berlin")
-		if err != nil {
-			return false, LocationLoadError()
+		var exists bool
+		targetTime, exists = s.wallClock(parsedDate, clockOffset(parsedTime))
+		if !exists {
+			return false, InvalidDateError(dateStr + " " + timeStr)
 		}
-
-		targetTime = time.Date(parsedDate.Year(), parsedDate.Month(), parsedDate.Day(), parsedTime.Hour(), parsedTime.Minute(), 0, 0, loc)
 	} else {
 		targetTime = parsedDate
 	}
 
-	weekday := parsedDate.Weekday()
-	startHour, endHour := s.GetBusinessHours(weekday)
-	if startHour == -1 {
-		return false, DateShopClosedError(weekday.String())
+	days, err := s.loadOpeningDays(ctx, parsedDate, parsedDate)
+	if err != nil {
+		return false, err
+	}
+	hours, err := s.openHours(days, parsedDate)
+	if err != nil {
+		return false, err
 	}
 
 	dateOnly := timeStr == ""
-	var compareTime time.Time
 
 	if dateOnly {
-		compareTime = targetTime.Truncate(24 * time.Hour)
-		currentDate := currentTime.Truncate(24 * time.Hour)
-		if compareTime.Before(currentDate) {
-			return false, DateInPastError(compareTime.String(), currentDate.String())
+		compareDate := parsedDate.Format("2006-01-02")
+		currentDate := currentTime.Format("2006-01-02")
+		if compareDate < currentDate {
+			return false, DateInPastError(compareDate, currentDate)
 		}
 	} else {
 		if targetTime.Before(currentTime) {
@@ -86,8 +217,10 @@ func (s *AppointmentService) IsValidTerminDate(dateStr string, timeStr string, n
 		}
 	}
 
-	if timeStr != "" && (targetTime.Hour() < startHour || targetTime.Hour() >= endHour) {
-		return false, DateShopClosedError(targetTime.String())
+	if timeStr != "" {
+		if _, open := within(hours, clockOffset(targetTime)); !open {
+			return false, DateShopClosedError(targetTime.String())
+		}
 	}
 
 	return true, nil
@@ -96,109 +229,528 @@ func (s *AppointmentService) IsValidTerminDate(dateStr string, timeStr string, n
 func (s *AppointmentService) GetAvailableDates(ctx context.Context, days int) []string {
 	var availableDates []string
 
-	loc, err := time.LoadLocation("Europe/Berlin")
+	now := s.Now()
+	horizon := now.Add(s.GetBookingWindow("").Horizon)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.config.Location)
+	last := today.AddDate(0, 0, maxLookahead-1)
+	if horizon.Before(last) {
+		last = horizon
+	}
 
+	// The days are checked in memory, as they can span up to a year.
+	opening, err := s.loadOpeningDays(ctx, today, last)
 	if err != nil {
-		fmt.Println(err.Error())
-		return availableDates
+		return nil
 	}
 
-	today := time.Now().In(loc).Truncate(24 * time.Hour)
 	createdDays := 0
-	for i := 0; createdDays < days; i++ {
-		date := today.AddDate(0, 0, i)
+	for i := 0; createdDays < days && i < maxLookahead; i++ {
+		date := time.Date(now.Year(), now.Month(), now.Day()+i, 0, 0, 0, 0, s.config.Location)
 		dateStr := date.Format("2006-01-02")
 
-		isValid, _ := s.IsValidTerminDate(dateStr, "")
-		if !isValid {
+		if date.After(horizon) {
+			break
+		}
+
+		hours, err := s.openHours(opening, date)
+		if err != nil {
 			continue
 		}
 
+		// Today is only worth offering while the shop is still open.
+		if i == 0 {
+			if closing, _ := s.wallClock(date, hours[len(hours)-1].Close); !now.Before(closing) {
+				continue
+			}
+		}
+
 		availableDates = append(availableDates, dateStr)
 		createdDays += 1
 	}
 	return availableDates
 }
 
-func (s *AppointmentService) GetTimeSlotsByDate(ctx context.Context, dateStr string) ([]string, error) {
-	parsedDate, err := time.Parse("2006-01-02", dateStr)
+func (s *AppointmentService) GetTimeSlotsByDate(ctx context.Context, dateStr string, filter ...SlotFilter) ([]TimeSlot, error) {
+	var f SlotFilter
+	if len(filter) > 0 {
+		f = filter[0]
+	}
 
-	loc, err := time.LoadLocation("Europe/Berlin")
+	parsedDate, err := s.parseDate(dateStr)
 	if err != nil {
-		return nil, LocationLoadError()
+		return nil, InvalidDateError(dateStr)
 	}
 
-	currentTime := time.Now().In(loc)
+	currentTime := s.Now()
 
-	isValid, err := s.IsValidTerminDate(dateStr, "")
-
-	if !isValid {
+	// The day is loaded once, its slots are checked in memory.
+	days, err := s.loadOpeningDays(ctx, parsedDate, parsedDate)
+	if err != nil {
 		return nil, err
 	}
-
+	hours, err := s.openHours(days, parsedDate)
 	if err != nil {
-		return nil, InvalidDateError(dateStr)
+		return nil, err
+	}
+	if date, today := parsedDate.Format("2006-01-02"), currentTime.Format("2006-01-02"); date < today {
+		return nil, DateInPastError(date, today)
+	}
+
+	var terminSlots []TimeSlot
+
+	window := s.GetBookingWindow(f.Type)
+	if parsedDate.After(currentTime.Add(window.Horizon)) {
+		return nil, DateNotReadyError(dateStr, currentTime.Add(window.Horizon).Format("2006-01-02 15:04"))
 	}
 
-	var terminSlots []string
-	if parsedDate.Before(currentTime.Truncate(24 * time.Hour)) {
-		return nil, DateInPastError(dateStr, currentTime.String())
+	res, err := s.loadResources(ctx, s.client, parsedDate, parsedDate.AddDate(0, 0, 1), f.Type, f.Staff)
+	if err != nil {
+		return nil, err
 	}
 
-	weekday := currentTime.Weekday()
+	duration := s.GetDuration(f.Type)
 
-	startHour, endHour := s.GetBusinessHours(weekday)
+	for _, interval := range hours {
+		closing, _ := s.wallClock(parsedDate, interval.Close)
+
+		// Slots follow the wall clock, so on DST switches a slot is offered
+		// once per local time and skipped times are left out.
+		for offset := interval.Open; offset < interval.Close; offset += s.config.SlotInterval {
+			slotStart, exists := s.wallClock(parsedDate, offset)
+			if !exists {
+				continue
+			}
+			slotEnd := slotStart.Add(duration)
+			if slotEnd.After(closing) {
+				break
+			}
+
+			if slotStart.Before(currentTime) {
+				continue
+			}
 
-	for hour := startHour; hour < endHour; hour++ {
-		for minute := 0; minute < 60; minute += 30 {
-			timeStr := fmt.Sprintf("%02d:%02d", hour, minute)
-			isValid, err := s.IsValidTerminDate(parsedDate.Format("2006-01-02"), timeStr, currentTime)
-			if !isValid || err != nil {
+			if err := s.checkBookingWindow(f.Type, slotStart, currentTime); err != nil {
 				continue
 			}
 
-			datestr := parsedDate.Add(time.Hour*time.Duration(hour) + time.Minute*time.Duration(minute))
-			terminSlots = append(terminSlots, datestr.Format("2006-01-02 15:04"))
+			remaining, _ := res.allocate(slotStart, slotEnd)
+			if remaining <= 0 {
+				continue
+			}
+
+			terminSlots = append(terminSlots, TimeSlot{
+				Time:      slotStart.Format("2006-01-02 15:04"),
+				Remaining: remaining,
+			})
 		}
 	}
 
 	return terminSlots, nil
 }
 
-func (s *AppointmentService) BookAppointment(ctx context.Context, name, email, phone, desc string, Type appointment.Type, date time.Time) (*ent.Appointment, error) {
+// freeCounter returns the lowest counter in 1..capacity that none of the
+// appointments occupies, or 0 if every counter is in use.
+func freeCounter(appointments []*ent.Appointment, capacity int) int {
+	used := make(map[int]bool, len(appointments))
+	for _, a := range appointments {
+		if a.Counter != nil {
+			used[*a.Counter] = true
+		}
+	}
+
+	for counter := 1; counter <= capacity; counter++ {
+		if !used[counter] {
+			return counter
+		}
+	}
+	return 0
+}
+
+func (s *AppointmentService) BookAppointment(ctx context.Context, name, email, phone, desc string, Type appointment.Type, date time.Time, opts ...BookingOptions) (*ent.Appointment, error) {
+	var o BookingOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	if err := checkEmail(email); err != nil {
+		return nil, err
+	}
+
 	delkey, err := gonanoid.New(128)
 	if err != nil {
 		return nil, err
 	}
 
+	start, end, err := s.checkSlot(ctx, Type, date)
+	if err != nil {
+		return nil, err
+	}
 
-	loc, err := time.LoadLocation("Europe/Berlin")
+	created, err := retryAllocation(start, func() (*ent.Appointment, error) {
+		return s.book(ctx, name, email, phone, desc, Type, start, end, delkey, o)
+	})
 	if err != nil {
-		return nil, LocationLoadError()
+		return nil, err
 	}
 
-	if time.Now().In(loc).Add(24 * time.Hour * 28).Before(date) {
-		return nil, DateNotReadyError(date.String())
+	if created.Status == appointment.StatusPending && s.config.OnPending != nil {
+		s.config.OnPending(created, s.confirmationToken(created))
+	}
+	s.sendMail(ctx, confirmationMail, created, mailData{})
+	if created.Status == appointment.StatusConfirmed {
+		s.sendSMS(ctx, confirmedSMS, created, mailData{})
 	}
+	return created, nil
+}
 
-	isValid, err := s.IsValidTerminDate(date.Truncate(24*time.Hour).Format("2006-01-02"), date.Format("15:04"))
-	if !isValid || err != nil {
+// book creates the appointment on [start, end) at the service's location in
+// a transaction. It returns errAllocationTaken if a concurrent booking took
+// the counter or staff member it was allocated.
+func (s *AppointmentService) book(ctx context.Context, name, email, phone, desc string, Type appointment.Type, start, end time.Time, delkey string, o BookingOptions) (*ent.Appointment, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	return s.client.Appointment.Create().
+	var hold *ent.SlotHold
+	if o.Hold != "" {
+		hold, err = s.activeHold(ctx, tx.Client(), o.Hold)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if hold != nil && hold.StaffID != nil && o.Staff == 0 {
+			o.Staff = *hold.StaffID
+		}
+		if hold != nil && (!hold.StartTime.Equal(start) || (hold.StaffID != nil && *hold.StaffID != o.Staff)) {
+			hold = nil
+		}
+	}
+
+	res, err := s.loadResources(ctx, tx.Client(), start, end, Type, o.Staff)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if o.offer != 0 {
+		res.releaseOffer(o.offer)
+	}
+	if hold != nil {
+		res.releaseHold(hold.ID)
+	}
+
+	remaining, assigned := res.allocate(start, end)
+	if remaining <= 0 {
+		return nil, rollback(tx, SlotTakenError(start.Format("2006-01-02 15:04")))
+	}
+
+	create := tx.Appointment.Create().SetNillableLocationID(s.branchID())
+	if assigned.staffID != 0 {
+		create.SetStaffID(assigned.staffID)
+	} else {
+		create.SetCounter(assigned.counter)
+	}
+
+	// Waitlist offers went to the customer's email already, other bookings
+	// wait until the customer confirms them through the link sent there.
+	if o.offer != 0 {
+		create.SetStatus(appointment.StatusConfirmed)
+	} else {
+		create.SetStatus(appointment.StatusPending).
+			SetConfirmBy(s.confirmBy(start).UTC())
+	}
+
+	created, err := create.
 		SetName(name).
 		SetEmail(email).
 		SetPhone(phone).
-		SetStartTime(date).
-		SetEndTime(date.Add(30 * time.Minute)).
+		SetStartTime(start.UTC()).
+		SetEndTime(end.UTC()).
 		SetDescription(desc).
 		SetType(Type).
 		SetDelkey(delkey).
 		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, rollback(tx, errAllocationTaken)
+	}
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if o.offer != 0 {
+		claimed, err := tx.WaitlistEntry.Update().
+			Where(waitlistentry.IDEQ(o.offer), waitlistentry.StatusEQ(waitlistentry.StatusOffered)).
+			SetStatus(waitlistentry.StatusClaimed).
+			Save(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if claimed == 0 {
+			return nil, rollback(tx, OfferUnavailableError())
+		}
+	}
+
+	if hold != nil {
+		if err := tx.SlotHold.DeleteOne(hold).Exec(ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created.Unwrap(), nil
+}
+
+// allocationAttempts is how often a booking or move is tried when concurrent
+// ones keep taking the counter or staff member it was allocated.
+const allocationAttempts = 3
+
+// errAllocationTaken reports that a concurrent booking took the counter or
+// staff member allocated to an appointment, which may still fit elsewhere.
+var errAllocationTaken = errors.New("allocation taken by a concurrent booking")
+
+// retryAllocation runs save until it does not lose its allocation to a
+// concurrent booking, at most allocationAttempts times. Each attempt allocates
+// again from the appointments saved by then.
+func retryAllocation(start time.Time, save func() (*ent.Appointment, error)) (*ent.Appointment, error) {
+	for attempt := 1; ; attempt++ {
+		saved, err := save()
+		if !errors.Is(err, errAllocationTaken) {
+			return saved, err
+		}
+		if attempt == allocationAttempts {
+			return nil, SlotTakenError(start.Format("2006-01-02 15:04"))
+		}
+	}
+}
+
+// checkSlot validates that an appointment of the type can be booked at date
+// and returns its start and end in the shop's time zone.
+func (s *AppointmentService) checkSlot(ctx context.Context, Type appointment.Type, date time.Time) (time.Time, time.Time, error) {
+	// Appointments start on the minute and are judged in the shop's time zone.
+	start := date.In(s.config.Location).Truncate(time.Minute)
+	now := s.Now()
+	if err := s.checkBookingWindow(Type, start, now); err != nil {
+		return start, start, err
+	}
+
+	return s.checkOpen(ctx, Type, start, now)
+}
+
+// checkOpen validates that the shop is open for an appointment of the type
+// from start, which lies on the minute in the shop's time zone, and returns
+// its end.
+func (s *AppointmentService) checkOpen(ctx context.Context, Type appointment.Type, start, now time.Time) (time.Time, time.Time, error) {
+	isValid, err := s.IsValidTerminDate(ctx, start.Format("2006-01-02"), start.Format("15:04"), now)
+	if !isValid || err != nil {
+		return start, start, err
+	}
+
+	end := start.Add(s.GetDuration(Type))
+
+	hours, err := s.GetBusinessHours(ctx, start)
+	if err != nil {
+		return start, end, err
+	}
+	// The appointment has to end before the opening interval it starts in.
+	interval, _ := within(hours, clockOffset(start))
+	if closing, _ := s.wallClock(start, interval.Close); end.After(closing) {
+		return start, end, DateShopClosedError(end.Format("2006-01-02 15:04"))
+	}
+
+	return start, end, nil
+}
+
+// ForAppointment returns the appointment with the delete key together with a
+// service working on its location.
+func (s *AppointmentService) ForAppointment(ctx context.Context, delkey string) (*AppointmentService, *ent.Appointment, error) {
+	booked, err := s.client.Appointment.Query().
+		Where(appointment.DelkeyEQ(delkey)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, AppointmentNotFoundError()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scoped, err := s.scope(ctx, booked.LocationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scoped, booked, nil
+}
+
+// RescheduleAppointment moves the appointment with the delete key to date,
+// keeping its ID, type and location. The new slot is validated like a new
+// booking, with the appointment no longer blocking its old slot. Customers
+// cannot reschedule within the change cutoff.
+func (s *AppointmentService) RescheduleAppointment(ctx context.Context, delkey string, date time.Time, opts ...BookingOptions) (*ent.Appointment, error) {
+	var o BookingOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	scoped, booked, err := s.ForAppointment(ctx, delkey)
+	if err != nil {
+		return nil, err
+	}
+	if !active(booked.Status) {
+		return nil, InvalidStatusTransitionError(booked.Status.String(), "rescheduled")
+	}
+	if err := scoped.checkChangeCutoff(booked); err != nil {
+		return nil, err
+	}
+
+	start, end, err := scoped.checkSlot(ctx, booked.Type, date)
+	if err != nil {
+		return nil, err
+	}
+
+	return scoped.move(ctx, booked, start, end, o, AppointmentChanges{})
+}
+
+// MoveAppointment moves the appointment with the given ID to date for the
+// staff. Unlike customers, the staff can move appointments outside the
+// booking window and within the change cutoff, but the shop has to be open
+// and someone free to serve it.
+func (s *AppointmentService) MoveAppointment(ctx context.Context, id int, date time.Time, opts ...BookingOptions) (*ent.Appointment, error) {
+	var o BookingOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	return s.moveAppointment(ctx, id, date, o, AppointmentChanges{})
 }
 
+// moveAppointment moves the appointment like MoveAppointment and applies the
+// contact changes together with the move.
+func (s *AppointmentService) moveAppointment(ctx context.Context, id int, date time.Time, o BookingOptions, changes AppointmentChanges) (*ent.Appointment, error) {
+	booked, err := s.client.Appointment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, AppointmentNotFoundError()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !active(booked.Status) {
+		return nil, InvalidStatusTransitionError(booked.Status.String(), "rescheduled")
+	}
+
+	scoped, err := s.scope(ctx, booked.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := scoped.checkOpen(ctx, booked.Type, date.In(scoped.config.Location).Truncate(time.Minute), scoped.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return scoped.move(ctx, booked, start, end, o, changes)
+}
+
+// move books the appointment on [start, end) at the service's location
+// instead of its current slot, applies the contact changes and tells the
+// customer at their new address if its time changed.
+func (s *AppointmentService) move(ctx context.Context, booked *ent.Appointment, start, end time.Time, o BookingOptions, changes AppointmentChanges) (*ent.Appointment, error) {
+	var previous *ent.Appointment
+	moved, err := retryAllocation(start, func() (*ent.Appointment, error) {
+		var moved *ent.Appointment
+		var err error
+		previous, moved, err = s.relocate(ctx, booked.ID, start, end, o, changes)
+		return moved, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the staff member or counter may have changed.
+	if !start.Equal(previous.StartTime) {
+		s.releaseSlot(ctx, previous)
+		s.sendMail(ctx, rescheduleMail, moved, mailData{previous: previous})
+	}
+	return moved, nil
+}
+
+// relocate saves the move of the appointment with the given ID in a
+// transaction and returns the appointment before and after. It returns
+// errAllocationTaken if a concurrent booking took the counter or staff member
+// it was allocated.
+func (s *AppointmentService) relocate(ctx context.Context, id int, start, end time.Time, o BookingOptions, changes AppointmentChanges) (*ent.Appointment, *ent.Appointment, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Read the appointment again so a concurrent cancellation is noticed.
+	booked, err := tx.Appointment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, nil, rollback(tx, AppointmentNotFoundError())
+	}
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+	if !active(booked.Status) {
+		return nil, nil, rollback(tx, InvalidStatusTransitionError(booked.Status.String(), "rescheduled"))
+	}
+
+	res, err := s.loadResources(ctx, tx.Client(), start, end, booked.Type, o.Staff)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+	res.release(booked.ID)
+
+	remaining, assigned := res.allocate(start, end)
+	if remaining <= 0 {
+		return nil, nil, rollback(tx, SlotTakenError(start.Format("2006-01-02 15:04")))
+	}
+
+	update := tx.Appointment.UpdateOne(booked)
+	if assigned.staffID != 0 {
+		update.SetStaffID(assigned.staffID).ClearCounter()
+	} else {
+		update.SetCounter(assigned.counter).ClearStaffID()
+	}
+
+	moved, err := update.
+		SetStartTime(start.UTC()).
+		SetEndTime(end.UTC()).
+		SetNillableName(changes.Name).
+		SetNillableEmail(changes.Email).
+		SetNillablePhone(changes.Phone).
+		SetNillableDescription(changes.Description).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, nil, rollback(tx, errAllocationTaken)
+	}
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	// The customer is reminded of the new time again.
+	if !start.Equal(booked.StartTime) {
+		if _, err := tx.Reminder.Delete().Where(reminder.AppointmentIDEQ(booked.ID)).Exec(ctx); err != nil {
+			return nil, nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return booked, moved.Unwrap(), nil
+}
+
+// rollback aborts the transaction and returns err, wrapping any rollback failure.
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
+
+// DeleteAppointment cancels the appointment with the delete key unless it is
+// within the change cutoff. The appointment is kept with its cancellation for
+// the history.
 func (s *AppointmentService) DeleteAppointment(ctx context.Context, delkey string) error {
-	_, err := s.client.Appointment.Delete().Where(appointment.DelkeyEQ(delkey)).Exec(ctx)
+	_, err := s.CancelAppointment(ctx, delkey, "")
 	return err
-}
\ No newline at end of file
+}
@@ -0,0 +1,292 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/predicate"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Page sizes of ListAppointments.
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+// sortFields are the fields appointments can be listed by.
+var sortFields = []string{
+	appointment.FieldStartTime,
+	appointment.FieldName,
+	appointment.FieldType,
+	appointment.FieldStatus,
+	appointment.FieldID,
+}
+
+// AppointmentFilter selects and orders the appointments listed for the admin.
+type AppointmentFilter struct {
+	// From and To limit the start of the appointments to [From, To), either
+	// bound being open if zero.
+	From time.Time
+	To   time.Time
+	// Types and Statuses are the kinds and statuses of appointments to list,
+	// all if empty.
+	Types    []appointment.Type
+	Statuses []appointment.Status
+	// Location is the ID of the location whose appointments to list, 0
+	// meaning the main shop. Nil lists all locations.
+	Location *int
+	// Sort is the field to order by, start_time if empty. Appointments with
+	// the same value are ordered by ID.
+	Sort string
+	Desc bool
+	// Cursor continues the listing after the page it was returned with.
+	Cursor string
+	// Limit is the page size, 50 if zero.
+	Limit int
+}
+
+// AppointmentPage is a page of listed appointments. NextCursor is empty on
+// the last page.
+type AppointmentPage struct {
+	Appointments []*ent.Appointment `json:"appointments"`
+	NextCursor   string             `json:"next_cursor,omitempty"`
+}
+
+// AppointmentChanges are the changes the admin makes to an appointment, nil
+// fields staying as they are.
+type AppointmentChanges struct {
+	Name        *string
+	Email       *string
+	Phone       *string
+	Description *string
+	// Start moves the appointment like MoveAppointment.
+	Start *time.Time
+	// Staff hands the appointment to another staff member.
+	Staff *int
+}
+
+// cursor is the position after the last appointment of a page.
+type cursor struct {
+	Sort  string `json:"s"`
+	Desc  bool   `json:"d,omitempty"`
+	Value string `json:"v"`
+	ID    int    `json:"id"`
+}
+
+// ListAppointments returns a page of the appointments at all locations that
+// match the filter, including cancelled ones.
+func (s *AppointmentService) ListAppointments(ctx context.Context, filter AppointmentFilter) (*AppointmentPage, error) {
+	if filter.Sort == "" {
+		filter.Sort = appointment.FieldStartTime
+	}
+	if !slices.Contains(sortFields, filter.Sort) {
+		return nil, InvalidQueryError("Cannot sort by " + filter.Sort)
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageSize {
+		return nil, InvalidQueryError("Limit must be between 1 and " + strconv.Itoa(maxPageSize))
+	}
+
+	where := []predicate.Appointment{}
+	if !filter.From.IsZero() {
+		where = append(where, appointment.StartTimeGTE(filter.From.UTC()))
+	}
+	if !filter.To.IsZero() {
+		where = append(where, appointment.StartTimeLT(filter.To.UTC()))
+	}
+	if len(filter.Types) > 0 {
+		where = append(where, appointment.TypeIn(filter.Types...))
+	}
+	if len(filter.Statuses) > 0 {
+		where = append(where, appointment.StatusIn(filter.Statuses...))
+	}
+	if filter.Location != nil {
+		if *filter.Location == 0 {
+			where = append(where, appointment.LocationIDIsNil())
+		} else {
+			where = append(where, appointment.LocationIDEQ(*filter.Location))
+		}
+	}
+	if filter.Cursor != "" {
+		after, err := filter.after()
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, after)
+	}
+
+	order := ent.Asc
+	if filter.Desc {
+		order = ent.Desc
+	}
+	query := s.client.Appointment.Query().
+		Where(where...).
+		WithStaff().
+		WithLocation()
+	if filter.Sort != appointment.FieldID {
+		query = query.Order(order(filter.Sort))
+	}
+
+	// One more than asked for tells whether there is a next page.
+	booked, err := query.
+		Order(order(appointment.FieldID)).
+		Limit(filter.Limit + 1).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AppointmentPage{Appointments: booked}
+	if len(booked) > filter.Limit {
+		page.Appointments = booked[:filter.Limit]
+		page.NextCursor = filter.cursorAfter(page.Appointments[filter.Limit-1])
+	}
+	return page, nil
+}
+
+// cursorAfter returns the cursor continuing the listing after the appointment.
+func (f AppointmentFilter) cursorAfter(a *ent.Appointment) string {
+	c := cursor{Sort: f.Sort, Desc: f.Desc, ID: a.ID}
+	switch f.Sort {
+	case appointment.FieldStartTime:
+		c.Value = a.StartTime.UTC().Format(time.RFC3339Nano)
+	case appointment.FieldName:
+		c.Value = a.Name
+	case appointment.FieldType:
+		c.Value = a.Type.String()
+	case appointment.FieldStatus:
+		c.Value = a.Status.String()
+	}
+
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// after restricts a query to the appointments following the filter's cursor
+// in its order.
+func (f AppointmentFilter) after() (predicate.Appointment, error) {
+	var c cursor
+	data, err := base64.RawURLEncoding.DecodeString(f.Cursor)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.Sort != f.Sort || c.Desc != f.Desc {
+		return nil, InvalidQueryError("The cursor does not belong to this listing")
+	}
+
+	beyond, beyondID := sql.FieldGT, appointment.IDGT
+	if f.Desc {
+		beyond, beyondID = sql.FieldLT, appointment.IDLT
+	}
+	if f.Sort == appointment.FieldID {
+		return beyondID(c.ID), nil
+	}
+
+	var value any = c.Value
+	if f.Sort == appointment.FieldStartTime {
+		if value, err = time.Parse(time.RFC3339Nano, c.Value); err != nil {
+			return nil, InvalidQueryError("The cursor does not belong to this listing")
+		}
+	}
+
+	return appointment.Or(
+		predicate.Appointment(beyond(f.Sort, value)),
+		appointment.And(predicate.Appointment(sql.FieldEQ(f.Sort, value)), beyondID(c.ID)),
+	), nil
+}
+
+// GetAppointment returns the appointment with the given ID along with its
+// staff member, location and the reminders sent for it.
+func (s *AppointmentService) GetAppointment(ctx context.Context, id int) (*ent.Appointment, error) {
+	booked, err := s.client.Appointment.Query().
+		Where(appointment.IDEQ(id)).
+		WithStaff().
+		WithLocation().
+		WithReminders().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, AppointmentNotFoundError()
+	}
+	return booked, err
+}
+
+// UpdateAppointment changes the appointment with the given ID for the admin.
+// Contact details can be corrected any time, moving it or handing it to
+// another staff member follows the rules of MoveAppointment. Either all
+// changes are saved or none.
+func (s *AppointmentService) UpdateAppointment(ctx context.Context, id int, changes AppointmentChanges) (*ent.Appointment, error) {
+	for field, value := range map[string]*string{"name": changes.Name, "email": changes.Email, "phone": changes.Phone} {
+		if value != nil && *value == "" {
+			return nil, InvalidAppointmentError(field)
+		}
+	}
+	if changes.Email != nil {
+		if err := checkEmail(*changes.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	booked, err := s.client.Appointment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, AppointmentNotFoundError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if changes.Start != nil || changes.Staff != nil {
+		start := booked.StartTime
+		if changes.Start != nil {
+			start = *changes.Start
+		}
+		var o BookingOptions
+		if changes.Staff != nil {
+			o.Staff = *changes.Staff
+		}
+		// The contact changes are saved with the move, so the customer hears
+		// of the new time at their new address.
+		if _, err := s.moveAppointment(ctx, id, start, o, changes); err != nil {
+			return nil, err
+		}
+		return s.GetAppointment(ctx, id)
+	}
+
+	err = s.client.Appointment.UpdateOneID(id).
+		SetNillableName(changes.Name).
+		SetNillableEmail(changes.Email).
+		SetNillablePhone(changes.Phone).
+		SetNillableDescription(changes.Description).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetAppointment(ctx, id)
+}
+
+// CancelAppointmentByID cancels the appointment with the given ID for the
+// shop, which unlike the customer can do so within the change cutoff. The
+// customer is told the reason, which may be empty.
+func (s *AppointmentService) CancelAppointmentByID(ctx context.Context, id int, reason string) (*ent.Appointment, error) {
+	booked, err := s.client.Appointment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, AppointmentNotFoundError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.cancel(ctx, booked, reason); err != nil {
+		return nil, err
+	}
+	return s.GetAppointment(ctx, id)
+}
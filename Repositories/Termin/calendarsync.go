@@ -0,0 +1,272 @@
+package termin
+
+import (
+	calendar "TerminSystem/Calendar"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CalendarObject is an appointment or a blocked time as an event in the
+// calendar of a location that the shop owner's calendar apps sync with.
+type CalendarObject struct {
+	// Name identifies the event within the location's calendar.
+	Name         string
+	ICS          []byte
+	ETag         string
+	LastModified time.Time
+}
+
+// CalendarChange is an event as a calendar app created or changed it.
+type CalendarChange struct {
+	UID     string
+	Summary string
+	Start   time.Time
+	End     time.Time
+}
+
+// blockedSummary is the title of blocked times the calendar app gave none.
+const blockedSummary = "Blockiert"
+
+// CalendarObjects returns the appointments and blocked times at the service's
+// location from today on. Cancelled appointments are left out.
+func (s *AppointmentService) CalendarObjects(ctx context.Context) ([]CalendarObject, error) {
+	from, _ := s.wallClock(s.Now(), 0)
+
+	booked, err := s.client.Appointment.Query().
+		Where(
+			s.appointmentsHere(),
+			appointment.StatusNEQ(appointment.StatusCancelled),
+			appointment.EndTimeGT(from.UTC()),
+		).
+		WithStaff().
+		Order(ent.Asc(appointment.FieldStartTime), ent.Asc(appointment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := s.client.BlockedTime.Query().
+		Where(s.blocksHere(), blockedtime.EndTimeGT(from.UTC())).
+		Order(ent.Asc(blockedtime.FieldStartTime), ent.Asc(blockedtime.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]CalendarObject, 0, len(booked)+len(blocks))
+	for _, a := range booked {
+		objects = append(objects, s.appointmentObject(a))
+	}
+	for _, block := range blocks {
+		objects = append(objects, s.blockObject(block))
+	}
+	return objects, nil
+}
+
+// CalendarObject returns the event with the name from the calendar of the
+// service's location.
+func (s *AppointmentService) CalendarObject(ctx context.Context, name string) (*CalendarObject, error) {
+	if id, ok := appointmentObjectID(name); ok {
+		booked, err := s.appointmentHere(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		object := s.appointmentObject(booked)
+		return &object, nil
+	}
+
+	block, err := s.client.BlockedTime.Query().
+		Where(s.blocksHere(), blockedtime.NameEQ(name)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, CalendarObjectNotFoundError(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	object := s.blockObject(block)
+	return &object, nil
+}
+
+// PutCalendarObject takes over an event the shop owner created or changed in
+// their calendar app. Moving the event of an appointment moves the
+// appointment, keeping its duration; other changes to it are ignored. Any
+// other event blocks its time for bookings, appointments booked during it
+// are kept.
+func (s *AppointmentService) PutCalendarObject(ctx context.Context, name string, change CalendarChange) (*CalendarObject, error) {
+	if change.Start.IsZero() || !change.End.After(change.Start) {
+		return nil, InvalidEventError("Event " + name + " needs a start before its end")
+	}
+
+	if id, ok := appointmentObjectID(name); ok {
+		booked, err := s.appointmentHere(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !change.Start.Equal(booked.StartTime) {
+			if _, err := s.MoveAppointment(ctx, id, change.Start); err != nil {
+				return nil, err
+			}
+		}
+		return s.CalendarObject(ctx, name)
+	}
+
+	if change.UID == "" {
+		return nil, InvalidEventError("Event " + name + " has no UID")
+	}
+	if change.Summary == "" {
+		change.Summary = blockedSummary
+	}
+
+	updated, err := s.client.BlockedTime.Update().
+		Where(s.blocksHere(), blockedtime.NameEQ(name)).
+		SetUID(change.UID).
+		SetSummary(change.Summary).
+		SetStartTime(change.Start.UTC()).
+		SetEndTime(change.End.UTC()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		_, err = s.client.BlockedTime.Create().
+			SetName(name).
+			SetUID(change.UID).
+			SetSummary(change.Summary).
+			SetStartTime(change.Start.UTC()).
+			SetEndTime(change.End.UTC()).
+			SetNillableLocationID(s.branchID()).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s.CalendarObject(ctx, name)
+}
+
+// DeleteCalendarObject removes the event with the name from the calendar of
+// the service's location. Deleting the event of an appointment cancels it,
+// deleting a blocked time frees it again.
+func (s *AppointmentService) DeleteCalendarObject(ctx context.Context, name string) error {
+	if id, ok := appointmentObjectID(name); ok {
+		if _, err := s.appointmentHere(ctx, id); err != nil {
+			return err
+		}
+		_, err := s.SetStatus(ctx, id, appointment.StatusCancelled)
+		return err
+	}
+
+	deleted, err := s.client.BlockedTime.Delete().
+		Where(s.blocksHere(), blockedtime.NameEQ(name)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return CalendarObjectNotFoundError(name)
+	}
+	return nil
+}
+
+// appointmentHere returns the appointment with the ID at the service's
+// location unless it was cancelled.
+func (s *AppointmentService) appointmentHere(ctx context.Context, id int) (*ent.Appointment, error) {
+	booked, err := s.client.Appointment.Query().
+		Where(
+			s.appointmentsHere(),
+			appointment.IDEQ(id),
+			appointment.StatusNEQ(appointment.StatusCancelled),
+		).
+		WithStaff().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, CalendarObjectNotFoundError(appointmentObjectName(id))
+	}
+	return booked, err
+}
+
+// appointmentObject returns the appointment as an event in the calendar of
+// the service's location. Unlike in emails, its UID stays the same when the
+// appointment is moved, as calendar apps keep their copy by UID.
+func (s *AppointmentService) appointmentObject(booked *ent.Appointment) CalendarObject {
+	var changed time.Time
+	if booked.UpdatedAt != nil {
+		changed = booked.UpdatedAt.UTC()
+	}
+
+	event := s.staffEvent(booked, changed)
+	event.UID = fmt.Sprintf("termin-%d@%s", booked.ID, s.uidHost())
+	return s.calendarObject(appointmentObjectName(booked.ID), event, changed)
+}
+
+// blockObject returns the blocked time as an event in the calendar of the
+// service's location.
+func (s *AppointmentService) blockObject(block *ent.BlockedTime) CalendarObject {
+	return s.calendarObject(block.Name, calendar.Event{
+		UID:     block.UID,
+		Stamp:   block.UpdatedAt,
+		Start:   block.StartTime,
+		End:     block.EndTime,
+		Summary: block.Summary,
+	}, block.UpdatedAt)
+}
+
+func (s *AppointmentService) calendarObject(name string, event calendar.Event, changed time.Time) CalendarObject {
+	ics := calendar.Calendar{
+		TimeZone: s.config.Location,
+		Events:   []calendar.Event{event},
+	}.Encode()
+
+	return CalendarObject{
+		Name:         name,
+		ICS:          ics,
+		ETag:         contentHash(ics),
+		LastModified: changed,
+	}
+}
+
+// staffEvent returns the calendar event of the appointment as the staff sees
+// it, changed at the given time.
+func (s *AppointmentService) staffEvent(booked *ent.Appointment, changed time.Time) calendar.Event {
+	event := s.event(booked)
+	event.Stamp = changed
+	event.Summary = typeName(booked.Type) + ": " + booked.Name
+	event.Description = feedDescription(booked)
+	event.Organizer, event.Attendee, event.AttendeeName = "", "", ""
+	return event
+}
+
+// appointmentObjectName returns the name of the appointment's event in the
+// calendar of its location.
+func appointmentObjectName(id int) string {
+	return "termin-" + strconv.Itoa(id) + ".ics"
+}
+
+// appointmentObjectID returns the ID of the appointment the event name is
+// for, reporting false for other events.
+func appointmentObjectID(name string) (int, bool) {
+	id, ok := strings.CutPrefix(name, "termin-")
+	if !ok {
+		return 0, false
+	}
+	id, ok = strings.CutSuffix(id, ".ics")
+	if !ok {
+		return 0, false
+	}
+	parsed, err := strconv.Atoi(id)
+	return parsed, err == nil && parsed > 0 && strconv.Itoa(parsed) == id
+}
+
+// contentHash returns a short hex digest of data for use in ETags.
+func contentHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16])
+}
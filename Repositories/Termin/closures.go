@@ -0,0 +1,88 @@
+package termin
+
+import (
+	holidays "TerminSystem/Holidays"
+	"TerminSystem/ent"
+	"TerminSystem/ent/closureday"
+	"context"
+	"time"
+)
+
+// ClosedDay is a day the shop is closed on, either because of a public
+// holiday or a closure entered by the shop.
+type ClosedDay struct {
+	Date    string `json:"date"`
+	Reason  string `json:"reason"`
+	Holiday bool   `json:"holiday"`
+}
+
+// state returns the Bundesland whose public holidays the service's location
+// is closed on.
+func (s *AppointmentService) state() holidays.State {
+	if s.branch != nil && s.branch.State != nil {
+		return holidays.State(*s.branch.State)
+	}
+	return s.config.State
+}
+
+// ListClosedDays returns the public holidays and closure days of the year at
+// the service's location.
+func (s *AppointmentService) ListClosedDays(ctx context.Context, year int) ([]ClosedDay, error) {
+	var days []ClosedDay
+	for _, holiday := range holidays.ForYear(year, s.state()) {
+		days = append(days, ClosedDay{
+			Date:    holiday.Date.Format("2006-01-02"),
+			Reason:  holiday.Name,
+			Holiday: true,
+		})
+	}
+
+	closures, err := s.client.ClosureDay.Query().
+		Where(
+			s.closuresHere(),
+			closureday.DateGTE(time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")),
+			closureday.DateLTE(time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).Format("2006-01-02")),
+		).
+		Order(ent.Asc(closureday.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, closure := range closures {
+		days = append(days, ClosedDay{
+			Date:   closure.Date,
+			Reason: closure.Reason,
+		})
+	}
+
+	return days, nil
+}
+
+// AddClosureDay closes the service's location on the given date.
+func (s *AppointmentService) AddClosureDay(ctx context.Context, dateStr, reason string) (*ent.ClosureDay, error) {
+	if _, err := time.Parse("2006-01-02", dateStr); err != nil {
+		return nil, InvalidDateError(dateStr)
+	}
+
+	return s.client.ClosureDay.Create().
+		SetDate(dateStr).
+		SetReason(reason).
+		SetNillableLocationID(s.branchID()).
+		Save(ctx)
+}
+
+// RemoveClosureDay opens the service's location again on a date closed by
+// AddClosureDay.
+func (s *AppointmentService) RemoveClosureDay(ctx context.Context, dateStr string) error {
+	deleted, err := s.client.ClosureDay.Delete().
+		Where(s.closuresHere(), closureday.DateEQ(dateStr)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ClosureDayNotFoundError(dateStr)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package termin
+
+import (
+	holidays "TerminSystem/Holidays"
+	notify "TerminSystem/Notify"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"crypto/rand"
+	"time"
+	// The shop's time zone has to load on hosts without a zoneinfo database.
+	_ "time/tzdata"
+)
+
+// DefaultTimeZone is the time zone the shop is in unless configured otherwise.
+const DefaultTimeZone = "Europe/Berlin"
+
+// Buffer is the preparation time before and the cleanup time after an
+// appointment during which no other appointment can be served.
+type Buffer struct {
+	Before time.Duration
+	After  time.Duration
+}
+
+// BookingWindow limits when an appointment can be booked relative to the
+// time of booking.
+type BookingWindow struct {
+	// LeadTime is the minimum notice between booking and the appointment.
+	// Nil takes bookings until the appointment starts.
+	LeadTime *time.Duration
+	// Horizon is how far ahead appointments can be booked.
+	Horizon time.Duration
+}
+
+// notice returns the minimum notice of the window, which is zero without a
+// lead time.
+func (w BookingWindow) notice() time.Duration {
+	if w.LeadTime == nil {
+		return 0
+	}
+	return *w.LeadTime
+}
+
+// Duration returns a pointer to d for the optional durations of Config.
+func Duration(d time.Duration) *time.Duration {
+	return &d
+}
+
+// Config holds the booking rules an AppointmentService works with.
+type Config struct {
+	// SlotCapacity is the number of customers that can be served in one slot,
+	// e.g. the number of counters in the shop.
+	SlotCapacity int
+	// WeekdayCapacity overrides SlotCapacity for single weekdays.
+	WeekdayCapacity map[time.Weekday]int
+	// SlotInterval is the distance between two offered start times.
+	SlotInterval time.Duration
+	// Durations holds how long an appointment of each type takes. Types
+	// without an entry take SlotInterval.
+	Durations map[appointment.Type]time.Duration
+	// Buffers holds the buffer times around appointments of each type. They
+	// block other bookings but are not part of the appointment's end time.
+	Buffers map[appointment.Type]Buffer
+	// State is the Bundesland whose public holidays the shop is closed on.
+	// The zero value only observes the federal holidays.
+	State holidays.State
+	// Window is the booking window of all appointment types.
+	Window BookingWindow
+	// TypeWindows overrides the set parts of Window per type, i.e. a non-nil
+	// LeadTime and a non-zero Horizon.
+	TypeWindows map[appointment.Type]BookingWindow
+	// ChangeCutoff is how long before its start an appointment can still be
+	// cancelled or rescheduled by the customer. Nil takes the default of 12
+	// hours, zero allows changes until the appointment starts.
+	ChangeCutoff *time.Duration
+	// OfferTTL is how long a waitlist entry can claim the slot it is offered
+	// before the offer rolls over to the next entry.
+	OfferTTL time.Duration
+	// OnOffer is called with every waitlist entry that is offered a slot,
+	// after the customer was sent the claim link.
+	OnOffer func(entry *ent.WaitlistEntry)
+	// HoldTTL is how long a slot hold keeps a slot free for the customer
+	// filling in the booking form.
+	HoldTTL time.Duration
+	// MaxHolds is how many unexpired slot holds can be taken from one IP
+	// address at a time. It is shared by all customers behind the address,
+	// e.g. in an office network.
+	MaxHolds int
+	// ConfirmTTL is how long a customer has to confirm a booking through the
+	// link sent by email before the slot is released again.
+	ConfirmTTL time.Duration
+	// ConfirmSecret signs the confirmation links. Without one a random secret
+	// is used, so links do not survive a restart.
+	ConfirmSecret []byte
+	// OnPending is called with every appointment waiting for confirmation and
+	// the token of its confirmation link, to email the link to the customer.
+	OnPending func(booked *ent.Appointment, token string)
+	// Notifier sends the customers emails about their appointments. Without
+	// one no emails are sent.
+	Notifier notify.Notifier
+	// ReminderOffsets are how long before their start customers are
+	// reminded of confirmed appointments. An empty, non-nil list turns
+	// reminders off.
+	ReminderOffsets []time.Duration
+	// SMS texts the customers about their appointments. Without one no text
+	// messages are sent.
+	SMS notify.SMSSender
+	// SMSMaxSegments is how many concatenated messages a text may take at
+	// most. Longer texts are cut short.
+	SMSMaxSegments int
+	// ShopName and ShopAddress describe the main shop in emails and calendar
+	// files, branches use their own. ShopEmail is the organizer of the
+	// calendar events.
+	ShopName    string
+	ShopAddress string
+	ShopEmail   string
+	// BaseURL is where the API is reachable from the outside, for the links
+	// in emails.
+	BaseURL string
+	// Location is the time zone of the shop. Dates, opening hours and slots
+	// are wall clock times in it, while appointments are stored in UTC.
+	Location *time.Location
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+}
+
+// DefaultConfig returns the configuration used when none is given: a single
+// counter on every day and half-hour slots.
+func DefaultConfig() Config {
+	return Config{
+		SlotCapacity: 1,
+		SlotInterval: 30 * time.Minute,
+		Durations: map[appointment.Type]time.Duration{
+			appointment.TypeGoldankauf:      30 * time.Minute,
+			appointment.TypeTrauringe:       60 * time.Minute,
+			appointment.TypeVerlobungsringe: 60 * time.Minute,
+			appointment.TypeOhrlochstechen:  15 * time.Minute,
+			appointment.TypeSonstiges:       30 * time.Minute,
+		},
+		Buffers: map[appointment.Type]Buffer{
+			appointment.TypeGoldankauf:     {After: 15 * time.Minute},
+			appointment.TypeOhrlochstechen: {After: 10 * time.Minute},
+		},
+		Window: BookingWindow{
+			Horizon: 28 * 24 * time.Hour,
+		},
+		ChangeCutoff:    Duration(12 * time.Hour),
+		OfferTTL:        2 * time.Hour,
+		HoldTTL:         10 * time.Minute,
+		MaxHolds:        20,
+		ConfirmTTL:      30 * time.Minute,
+		BaseURL:         "http://localhost:8080",
+		SMSMaxSegments:  2,
+		ReminderOffsets: []time.Duration{24 * time.Hour, 2 * time.Hour},
+		Location:        defaultLocation,
+		Now:             time.Now,
+	}
+}
+
+// defaultLocation is DefaultTimeZone, which the embedded time zone database
+// always provides.
+var defaultLocation = func() *time.Location {
+	loc, err := time.LoadLocation(DefaultTimeZone)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}()
+
+// withDefaults fills the unset fields of c from DefaultConfig.
+func (c Config) withDefaults() Config {
+	defaults := DefaultConfig()
+
+	if c.SlotCapacity <= 0 {
+		c.SlotCapacity = defaults.SlotCapacity
+	}
+	if c.SlotInterval <= 0 {
+		c.SlotInterval = defaults.SlotInterval
+	}
+	if c.Durations == nil {
+		c.Durations = defaults.Durations
+	}
+	if c.Buffers == nil {
+		c.Buffers = defaults.Buffers
+	}
+	if c.Window.Horizon == 0 {
+		c.Window.Horizon = defaults.Window.Horizon
+	}
+	if c.ChangeCutoff == nil {
+		c.ChangeCutoff = defaults.ChangeCutoff
+	}
+	if c.OfferTTL <= 0 {
+		c.OfferTTL = defaults.OfferTTL
+	}
+	if c.HoldTTL <= 0 {
+		c.HoldTTL = defaults.HoldTTL
+	}
+	if c.MaxHolds <= 0 {
+		c.MaxHolds = defaults.MaxHolds
+	}
+	if c.ConfirmTTL <= 0 {
+		c.ConfirmTTL = defaults.ConfirmTTL
+	}
+	if len(c.ConfirmSecret) == 0 {
+		c.ConfirmSecret = make([]byte, 32)
+		if _, err := rand.Read(c.ConfirmSecret); err != nil {
+			panic("generating confirmation secret: " + err.Error())
+		}
+	}
+	if c.ReminderOffsets == nil {
+		c.ReminderOffsets = defaults.ReminderOffsets
+	}
+	if c.SMSMaxSegments <= 0 {
+		c.SMSMaxSegments = defaults.SMSMaxSegments
+	}
+	if c.BaseURL == "" {
+		c.BaseURL = defaults.BaseURL
+	}
+	if c.Location == nil {
+		c.Location = defaults.Location
+	}
+	if c.Now == nil {
+		c.Now = defaults.Now
+	}
+
+	return c
+}
+
+// DefaultOpeningHours returns the opening hours the shop starts out with:
+// 10:00 to 17:00 on weekdays and 10:00 to 14:00 on Saturday.
+func DefaultOpeningHours() []DayHours {
+	hours := make([]DayHours, 0, 6)
+	for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+		hours = append(hours, DayHours{Weekday: weekday, Open: "10:00", Close: "17:00"})
+	}
+	return append(hours, DayHours{Weekday: time.Saturday, Open: "10:00", Close: "14:00"})
+}
@@ -0,0 +1,117 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfirmAppointment confirms the pending appointment the confirmation token
+// was issued for. Confirming twice is not an error.
+func (s *AppointmentService) ConfirmAppointment(ctx context.Context, token string) (*ent.Appointment, error) {
+	id, ok := s.verifyConfirmation(token)
+	if !ok {
+		return nil, ConfirmationInvalidError()
+	}
+
+	booked, err := s.client.Appointment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ConfirmationInvalidError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case booked.Status == appointment.StatusConfirmed:
+		return booked, nil
+	case booked.Status != appointment.StatusPending || booked.ConfirmBy == nil || !s.Now().Before(*booked.ConfirmBy):
+		return nil, ConfirmationInvalidError()
+	}
+
+	// Only confirm if the appointment was not released in the meantime.
+	n, err := s.client.Appointment.Update().
+		Where(appointment.IDEQ(booked.ID), appointment.StatusEQ(appointment.StatusPending)).
+		SetStatus(appointment.StatusConfirmed).
+		ClearConfirmBy().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, ConfirmationInvalidError()
+	}
+
+	confirmed, err := s.client.Appointment.Get(ctx, booked.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.sendSMS(ctx, confirmedSMS, confirmed, mailData{})
+	return confirmed, nil
+}
+
+// ReleaseUnconfirmed cancels the pending appointments that were not confirmed
+// in time, so their slots can be booked again.
+func (s *AppointmentService) ReleaseUnconfirmed(ctx context.Context) error {
+	expired, err := s.client.Appointment.Query().
+		Where(
+			appointment.StatusEQ(appointment.StatusPending),
+			appointment.ConfirmByLTE(s.Now().UTC()),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, booked := range expired {
+		// Appointments cancelled in the meantime are released already.
+		var appErr *AppointmentError
+		if _, err := s.cancel(ctx, booked, "nicht bestätigt"); errors.As(err, &appErr) && appErr.Code == InvalidStatusTransitionErrorCode {
+			continue
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// confirmBy returns when an appointment starting at start and booked now has
+// to be confirmed: after ConfirmTTL, but no later than its start.
+func (s *AppointmentService) confirmBy(start time.Time) time.Time {
+	deadline := s.Now().Add(s.config.ConfirmTTL)
+	if start.Before(deadline) {
+		return start
+	}
+	return deadline
+}
+
+// confirmationToken returns the token of the confirmation link for the
+// appointment: its ID signed with ConfirmSecret.
+func (s *AppointmentService) confirmationToken(booked *ent.Appointment) string {
+	payload := strconv.Itoa(booked.ID)
+	return payload + "." + s.sign(payload)
+}
+
+// verifyConfirmation returns the appointment ID of a confirmation token and
+// whether its signature is valid.
+func (s *AppointmentService) verifyConfirmation(token string) (int, bool) {
+	payload, signature, found := strings.Cut(token, ".")
+	if !found || !hmac.Equal([]byte(signature), []byte(s.sign(payload))) {
+		return 0, false
+	}
+	id, err := strconv.Atoi(payload)
+	return id, err == nil
+}
+
+func (s *AppointmentService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.config.ConfirmSecret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
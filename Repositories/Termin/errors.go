@@ -2,6 +2,7 @@ package termin
 
 import (
 	"fmt"
+	"strconv"
 )
 
 const (
@@ -10,6 +11,25 @@ const (
 	DateShopClosedErrorCode
 	DateNotReadyErrorCode
 	LocationLoadErrorCode
+	SlotTakenErrorCode
+	StaffNotFoundErrorCode
+	InvalidOpeningHoursErrorCode
+	DateClosedDayErrorCode
+	DateTooSoonErrorCode
+	LocationNotFoundErrorCode
+	AppointmentNotFoundErrorCode
+	InvalidStatusTransitionErrorCode
+	ChangeCutoffErrorCode
+	OfferUnavailableErrorCode
+	ConfirmationInvalidErrorCode
+	CalendarObjectNotFoundErrorCode
+	InvalidEventErrorCode
+	InvalidQueryErrorCode
+	InvalidAppointmentErrorCode
+	ClosureDayNotFoundErrorCode
+	TooManyHoldsErrorCode
+	InvalidEmailErrorCode
+	InvalidStateErrorCode
 )
 
 type AppointmentError struct {
@@ -44,10 +64,107 @@ func DateShopClosedError(dateStr string) error {
 	return NewAppointmentError(DateShopClosedErrorCode, "appointment date is out of working time", "Target date: "+dateStr+" is after working time")
 }
 
-func DateNotReadyError(dateStr string) error {
-	return NewAppointmentError(DateNotReadyErrorCode, "appointment date is out of working time", "Target date: "+dateStr+" is after working time")
+// DateNotReadyError creates an error when the date is beyond the booking horizon
+func DateNotReadyError(dateStr, horizon string) error {
+	return NewAppointmentError(DateNotReadyErrorCode, "appointment date is too far in the future", "Target date: "+dateStr+" is after the booking horizon "+horizon)
 }
 
-func LocationLoadError() error {
-	return NewAppointmentError(LocationLoadErrorCode,"Location Load Failed","Could not load  Berlin time")
-}
\ No newline at end of file
+// LocationLoadError creates an error when the time zone of a location cannot be loaded
+func LocationLoadError(timezone string) error {
+	return NewAppointmentError(LocationLoadErrorCode, "Location Load Failed", "Could not load time zone "+timezone)
+}
+
+// SlotTakenError creates an error when the requested slot is already booked
+func SlotTakenError(dateStr string) error {
+	return NewAppointmentError(SlotTakenErrorCode, "slot already taken", "Target date: "+dateStr+" is already booked")
+}
+
+// StaffNotFoundError creates an error when the requested staff member does not exist
+func StaffNotFoundError(staffID int) error {
+	return NewAppointmentError(StaffNotFoundErrorCode, "staff member not found", "No staff member with id "+strconv.Itoa(staffID))
+}
+
+// InvalidOpeningHoursError creates an error for opening hours that are malformed or close before they open
+func InvalidOpeningHoursError(open, close string) error {
+	return NewAppointmentError(InvalidOpeningHoursErrorCode, "invalid opening hours", "Opening hours "+open+" - "+close+" are not a valid time range")
+}
+
+// DateClosedDayError creates an error when the shop is closed on a date because of a holiday or closure
+func DateClosedDayError(dateStr, reason string) error {
+	return NewAppointmentError(DateClosedDayErrorCode, "shop is closed on this day", "Target date: "+dateStr+" is closed ("+reason+")")
+}
+
+// DateTooSoonError creates an error when the date does not leave the required notice
+func DateTooSoonError(dateStr, leadTime string) error {
+	return NewAppointmentError(DateTooSoonErrorCode, "appointment date is too soon", "Target date: "+dateStr+" needs to be booked at least "+leadTime+" in advance")
+}
+
+// LocationNotFoundError creates an error when the requested location does not exist
+func LocationNotFoundError(locationID int) error {
+	return NewAppointmentError(LocationNotFoundErrorCode, "location not found", "No location with id "+strconv.Itoa(locationID))
+}
+
+// AppointmentNotFoundError creates an error when no appointment has the given delete key
+func AppointmentNotFoundError() error {
+	return NewAppointmentError(AppointmentNotFoundErrorCode, "appointment not found", "No appointment with the given key")
+}
+
+// InvalidStatusTransitionError creates an error when an appointment cannot change from one status to another
+func InvalidStatusTransitionError(from, to string) error {
+	return NewAppointmentError(InvalidStatusTransitionErrorCode, "invalid status change", "Appointment cannot change from "+from+" to "+to)
+}
+
+// ChangeCutoffError creates an error when an appointment is too close to be cancelled or rescheduled online
+func ChangeCutoffError(dateStr, cutoff string) error {
+	return NewAppointmentError(ChangeCutoffErrorCode, "appointment can no longer be changed online, please call the shop", "Appointment at "+dateStr+" can only be changed until "+cutoff+" before it starts")
+}
+
+// OfferUnavailableError creates an error when a waitlist offer does not exist, has expired or was claimed already
+func OfferUnavailableError() error {
+	return NewAppointmentError(OfferUnavailableErrorCode, "waitlist offer is no longer available", "The offer has expired or was claimed already")
+}
+
+// ConfirmationInvalidError creates an error when a confirmation link is forged, has expired or belongs to a released appointment
+func ConfirmationInvalidError() error {
+	return NewAppointmentError(ConfirmationInvalidErrorCode, "confirmation link is no longer valid", "The link is invalid or the appointment was not confirmed in time")
+}
+
+// CalendarObjectNotFoundError creates an error when the calendar collection has no event under the name
+func CalendarObjectNotFoundError(name string) error {
+	return NewAppointmentError(CalendarObjectNotFoundErrorCode, "calendar object not found", "No event named "+name)
+}
+
+// InvalidEventError creates an error when an event from a calendar app cannot be taken over
+func InvalidEventError(details string) error {
+	return NewAppointmentError(InvalidEventErrorCode, "invalid calendar event", details)
+}
+
+// InvalidQueryError creates an error when appointments cannot be listed with the given filter, sorting or cursor
+func InvalidQueryError(details string) error {
+	return NewAppointmentError(InvalidQueryErrorCode, "invalid appointment query", details)
+}
+
+// InvalidAppointmentError creates an error when an appointment cannot be changed to the given details
+func InvalidAppointmentError(field string) error {
+	return NewAppointmentError(InvalidAppointmentErrorCode, "invalid appointment details", "Field "+field+" must not be empty")
+}
+
+// ClosureDayNotFoundError creates an error when the shop was not closed on the date by a closure day
+func ClosureDayNotFoundError(dateStr string) error {
+	return NewAppointmentError(ClosureDayNotFoundErrorCode, "closure day not found", "Target date: "+dateStr+" is not a closure day")
+}
+
+// TooManyHoldsError creates an error when a client already holds as many slots as allowed
+func TooManyHoldsError(max int) error {
+	return NewAppointmentError(TooManyHoldsErrorCode, "too many slots held", "At most "+strconv.Itoa(max)+" slots can be held at a time")
+}
+
+// InvalidEmailError creates an error when the customer's email is not a plain email address
+func InvalidEmailError(email string) error {
+	return NewAppointmentError(InvalidEmailErrorCode, "invalid email address", "Email "+strconv.Quote(email)+" is not a valid email address")
+}
+
+// InvalidStateError creates an error when a location is given a Bundesland that has no holiday rules
+func InvalidStateError(state string) error {
+	return NewAppointmentError(InvalidStateErrorCode, "invalid state", "State "+strconv.Quote(state)+" is not a known Bundesland")
+}
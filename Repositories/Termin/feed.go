@@ -0,0 +1,119 @@
+package termin
+
+import (
+	calendar "TerminSystem/Calendar"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/predicate"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FeedFilter narrows down the appointments in the staff feed.
+type FeedFilter struct {
+	// Type is the kind of appointments to list, all if empty.
+	Type appointment.Type
+	// Staff is the ID of the staff member whose appointments to list, all
+	// if zero.
+	Staff int
+	// Location is the ID of the location whose appointments to list, 0
+	// meaning the main shop. Nil lists all locations.
+	Location *int
+}
+
+// Feed is the staff calendar in iCalendar format along with what HTTP
+// clients need to poll it cheaply.
+type Feed struct {
+	ICS          []byte
+	ETag         string
+	LastModified time.Time
+}
+
+// StaffFeed returns the calendar of the appointments from today on for the
+// staff. Cancelled appointments are left out, pending ones are tentative.
+func (s *AppointmentService) StaffFeed(ctx context.Context, filter FeedFilter) (*Feed, error) {
+	from, _ := s.wallClock(s.Now(), 0)
+	where := []predicate.Appointment{appointment.EndTimeGT(from.UTC())}
+	if filter.Type != "" {
+		where = append(where, appointment.TypeEQ(filter.Type))
+	}
+	if filter.Staff != 0 {
+		where = append(where, appointment.StaffIDEQ(filter.Staff))
+	}
+	if filter.Location != nil {
+		if *filter.Location == 0 {
+			where = append(where, appointment.LocationIDIsNil())
+		} else {
+			where = append(where, appointment.LocationIDEQ(*filter.Location))
+		}
+	}
+
+	// Cancelled appointments are read as well, their cancellation changes
+	// the feed.
+	booked, err := s.client.Appointment.Query().
+		Where(where...).
+		WithStaff().
+		Order(ent.Asc(appointment.FieldStartTime), ent.Asc(appointment.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &Feed{}
+	scoped := map[int]*AppointmentService{}
+	var events []calendar.Event
+	for _, a := range booked {
+		changed := time.Unix(0, 0).UTC()
+		if a.UpdatedAt != nil {
+			changed = a.UpdatedAt.UTC()
+		}
+		if changed.After(feed.LastModified) {
+			feed.LastModified = changed
+		}
+		if a.Status == appointment.StatusCancelled {
+			continue
+		}
+
+		location := 0
+		if a.LocationID != nil {
+			location = *a.LocationID
+		}
+		here, ok := scoped[location]
+		if !ok {
+			if here, err = s.scope(ctx, a.LocationID); err != nil {
+				return nil, err
+			}
+			scoped[location] = here
+		}
+
+		events = append(events, here.staffEvent(a, changed))
+	}
+
+	// Event times are in UTC, as the appointments can be at locations in
+	// different time zones.
+	feed.ICS = calendar.Calendar{Name: "Termine", Events: events}.Encode()
+	feed.ETag = `"` + contentHash(feed.ICS) + `"`
+	return feed, nil
+}
+
+// feedDescription lists what the staff needs to know about the appointment.
+func feedDescription(a *ent.Appointment) string {
+	lines := []string{
+		"Name: " + a.Name,
+		"E-Mail: " + a.Email,
+		"Telefon: " + a.Phone,
+	}
+	if a.Description != "" {
+		lines = append(lines, "Anliegen: "+a.Description)
+	}
+	if a.Edges.Staff != nil {
+		lines = append(lines, "Mitarbeiter: "+a.Edges.Staff.Name)
+	}
+	if a.Status == appointment.StatusPending {
+		lines = append(lines, "Noch nicht bestätigt")
+	}
+	lines = append(lines, fmt.Sprintf("Termin-ID: %d", a.ID))
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,120 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/slothold"
+	"context"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// HoldSlot keeps the slot of an appointment of the type starting at date free
+// for HoldTTL while the customer fills in the booking form. Booking with the
+// token of the hold takes the slot. A hold for a staff member only keeps that
+// one free. A client has a single hold, so picking another slot releases the
+// previous one, and at most MaxHolds holds can be taken from one address.
+func (s *AppointmentService) HoldSlot(ctx context.Context, Type appointment.Type, date time.Time, opts ...BookingOptions) (*ent.SlotHold, error) {
+	var o BookingOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	start, end, err := s.checkSlot(ctx, Type, date)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := gonanoid.New(64)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Client != "" {
+		// The previous hold is released before it could block the new one.
+		if _, err := tx.SlotHold.Delete().
+			Where(slothold.ClientEQ(o.Client)).
+			Exec(ctx); err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+	if o.Address != "" {
+		// Holds at all locations count.
+		held, err := tx.SlotHold.Query().
+			Where(slothold.AddressEQ(o.Address), slothold.ExpiresAtGT(s.Now().UTC())).
+			Count(ctx)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+		if held >= s.config.MaxHolds {
+			return nil, rollback(tx, TooManyHoldsError(s.config.MaxHolds))
+		}
+	}
+
+	res, err := s.loadResources(ctx, tx.Client(), start, end, Type, o.Staff)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if remaining, _ := res.allocate(start, end); remaining <= 0 {
+		return nil, rollback(tx, SlotTakenError(start.Format("2006-01-02 15:04")))
+	}
+
+	create := tx.SlotHold.Create()
+	if o.Staff != 0 {
+		create.SetStaffID(o.Staff)
+	}
+	if o.Client != "" {
+		create.SetClient(o.Client)
+	}
+	if o.Address != "" {
+		create.SetAddress(o.Address)
+	}
+
+	hold, err := create.
+		SetToken(token).
+		SetStartTime(start.UTC()).
+		SetEndTime(end.UTC()).
+		SetExpiresAt(s.Now().Add(s.config.HoldTTL).UTC()).
+		SetNillableLocationID(s.branchID()).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return hold.Unwrap(), nil
+}
+
+// ReleaseExpiredHolds deletes the holds that have run out. They no longer
+// count for availability even before.
+func (s *AppointmentService) ReleaseExpiredHolds(ctx context.Context) error {
+	_, err := s.client.SlotHold.Delete().
+		Where(slothold.ExpiresAtLTE(s.Now().UTC())).
+		Exec(ctx)
+	return err
+}
+
+// activeHold returns the unexpired hold with the token at the service's
+// location, nil if there is none.
+func (s *AppointmentService) activeHold(ctx context.Context, client *ent.Client, token string) (*ent.SlotHold, error) {
+	hold, err := client.SlotHold.Query().
+		Where(
+			s.holdsHere(),
+			slothold.TokenEQ(token),
+			slothold.ExpiresAtGT(s.Now().UTC()),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return hold, err
+}
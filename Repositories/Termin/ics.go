@@ -0,0 +1,135 @@
+package termin
+
+import (
+	calendar "TerminSystem/Calendar"
+	notify "TerminSystem/Notify"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// iTIP methods of the calendar files attached to emails.
+const (
+	methodRequest = "REQUEST"
+	methodCancel  = "CANCEL"
+)
+
+// AppointmentICS returns the appointment with the delete key as iCalendar
+// file for the customer's calendar app.
+func (s *AppointmentService) AppointmentICS(ctx context.Context, delkey string) ([]byte, error) {
+	scoped, booked, err := s.ForAppointment(ctx, delkey)
+	if err != nil {
+		return nil, err
+	}
+
+	return calendar.Calendar{
+		TimeZone: scoped.config.Location,
+		Events:   []calendar.Event{scoped.event(booked)},
+	}.Encode(), nil
+}
+
+// invitation returns the calendar file sent along with emails about the
+// appointment.
+func (s *AppointmentService) invitation(method string, booked *ent.Appointment) notify.Attachment {
+	event := s.event(booked)
+	name := "termin.ics"
+	if method == methodCancel {
+		// The cancellation has to supersede the invitation sent before.
+		event.Sequence++
+		event.Status = calendar.StatusCancelled
+		name = "termin-abgesagt.ics"
+	}
+
+	return notify.Attachment{
+		Name:        name,
+		ContentType: "text/calendar; charset=utf-8; method=" + method,
+		Data: calendar.Calendar{
+			Method:   method,
+			TimeZone: s.config.Location,
+			Events:   []calendar.Event{event},
+		}.Encode(),
+	}
+}
+
+// event returns the calendar event of the appointment at the service's
+// location.
+func (s *AppointmentService) event(booked *ent.Appointment) calendar.Event {
+	status := calendar.StatusConfirmed
+	switch booked.Status {
+	case appointment.StatusPending:
+		status = calendar.StatusTentative
+	case appointment.StatusCancelled:
+		status = calendar.StatusCancelled
+	}
+
+	summary := "Termin: " + typeName(booked.Type)
+	if name := s.shopName(); name != "" {
+		summary += " (" + name + ")"
+	}
+
+	return calendar.Event{
+		UID:          s.eventUID(booked),
+		Stamp:        s.Now(),
+		Start:        booked.StartTime,
+		End:          booked.EndTime,
+		Summary:      summary,
+		Description:  booked.Description,
+		Location:     s.place(),
+		Status:       status,
+		Organizer:    s.config.ShopEmail,
+		Attendee:     booked.Email,
+		AttendeeName: booked.Name,
+	}
+}
+
+// eventUID returns the UID of the appointment's calendar event. It stays the
+// same until the appointment is moved: calendar apps handle a cancelled event
+// and a new one more reliably than an event changing its time.
+func (s *AppointmentService) eventUID(booked *ent.Appointment) string {
+	return fmt.Sprintf("termin-%d-%d@%s", booked.ID, booked.StartTime.Unix(), s.uidHost())
+}
+
+// uidHost returns the host name the UIDs of calendar events end in.
+func (s *AppointmentService) uidHost() string {
+	if base, err := url.Parse(s.config.BaseURL); err == nil && base.Hostname() != "" {
+		return base.Hostname()
+	}
+	return "terminsystem"
+}
+
+// shopName returns the name of the service's location.
+func (s *AppointmentService) shopName() string {
+	if s.branch != nil {
+		return s.branch.Name
+	}
+	return s.config.ShopName
+}
+
+// place returns the name and address of the service's location as far as
+// they are known.
+func (s *AppointmentService) place() string {
+	address := s.config.ShopAddress
+	if s.branch != nil {
+		address = s.branch.Address
+	}
+
+	var parts []string
+	for _, part := range []string{s.shopName(), address} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// typeName returns the appointment type as it is written in German.
+func typeName(t appointment.Type) string {
+	name := t.String()
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
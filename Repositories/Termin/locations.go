@@ -0,0 +1,180 @@
+package termin
+
+import (
+	holidays "TerminSystem/Holidays"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"time"
+)
+
+// At returns a copy of the service working on the location with the given
+// ID: its availability is computed from the location's opening hours, staff
+// and appointments in the location's time zone. The service itself works on
+// the main shop.
+func (s *AppointmentService) At(ctx context.Context, id int) (*AppointmentService, error) {
+	branch, err := s.client.Location.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, LocationNotFoundError(id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tz, err := time.LoadLocation(branch.Timezone)
+	if err != nil {
+		return nil, LocationLoadError(branch.Timezone)
+	}
+
+	scoped := *s
+	scoped.branch = branch
+	scoped.config.Location = tz
+	return &scoped, nil
+}
+
+// scope returns a service working on the location with the given ID, nil
+// meaning the main shop.
+func (s *AppointmentService) scope(ctx context.Context, locationID *int) (*AppointmentService, error) {
+	if locationID == nil {
+		if s.branch == nil {
+			return s, nil
+		}
+		scoped := *s
+		scoped.branch = nil
+		scoped.config.Location = s.main
+		return &scoped, nil
+	}
+	if s.branch != nil && s.branch.ID == *locationID {
+		return s, nil
+	}
+	return s.At(ctx, *locationID)
+}
+
+// Branch returns the location the service works on, nil for the main shop.
+func (s *AppointmentService) Branch() *ent.Location {
+	return s.branch
+}
+
+// ListLocations returns all branches ordered by name.
+func (s *AppointmentService) ListLocations(ctx context.Context) ([]*ent.Location, error) {
+	return s.client.Location.Query().
+		Order(ent.Asc(location.FieldName)).
+		All(ctx)
+}
+
+// LocationOptions holds the optional parts of a branch.
+type LocationOptions struct {
+	// State is the Bundesland whose public holidays the branch is closed on,
+	// nil for that of the main shop.
+	State *holidays.State
+}
+
+// CreateLocation adds a branch in the given time zone, the main shop's one if
+// empty. It is closed until opening hours are set for it.
+func (s *AppointmentService) CreateLocation(ctx context.Context, name, address, timezone string, opts ...LocationOptions) (*ent.Location, error) {
+	var o LocationOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	if timezone == "" {
+		timezone = s.config.Location.String()
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, LocationLoadError(timezone)
+	}
+
+	create := s.client.Location.Create().
+		SetName(name).
+		SetAddress(address).
+		SetTimezone(timezone)
+	if o.State != nil {
+		if !o.State.Valid() {
+			return nil, InvalidStateError(string(*o.State))
+		}
+		create.SetState(string(*o.State))
+	}
+	return create.Save(ctx)
+}
+
+// branchID returns the ID of the service's location, nil for the main shop.
+func (s *AppointmentService) branchID() *int {
+	if s.branch == nil {
+		return nil
+	}
+	return &s.branch.ID
+}
+
+// appointmentsHere restricts a query to the appointments at the service's location.
+func (s *AppointmentService) appointmentsHere() predicate.Appointment {
+	if s.branch == nil {
+		return appointment.LocationIDIsNil()
+	}
+	return appointment.LocationIDEQ(s.branch.ID)
+}
+
+// staffHere restricts a query to the staff members at the service's location.
+func (s *AppointmentService) staffHere() predicate.Staff {
+	if s.branch == nil {
+		return staff.LocationIDIsNil()
+	}
+	return staff.LocationIDEQ(s.branch.ID)
+}
+
+// openingHoursHere restricts a query to the opening hours of the service's location.
+func (s *AppointmentService) openingHoursHere() predicate.OpeningHours {
+	if s.branch == nil {
+		return openinghours.LocationIDIsNil()
+	}
+	return openinghours.LocationIDEQ(s.branch.ID)
+}
+
+// exceptionsHere restricts a query to the opening hours exceptions of the
+// service's location.
+func (s *AppointmentService) exceptionsHere() predicate.OpeningHoursException {
+	if s.branch == nil {
+		return openinghoursexception.LocationIDIsNil()
+	}
+	return openinghoursexception.LocationIDEQ(s.branch.ID)
+}
+
+// waitlistHere restricts a query to the waitlist of the service's location.
+func (s *AppointmentService) waitlistHere() predicate.WaitlistEntry {
+	if s.branch == nil {
+		return waitlistentry.LocationIDIsNil()
+	}
+	return waitlistentry.LocationIDEQ(s.branch.ID)
+}
+
+// holdsHere restricts a query to the slot holds at the service's location.
+func (s *AppointmentService) holdsHere() predicate.SlotHold {
+	if s.branch == nil {
+		return slothold.LocationIDIsNil()
+	}
+	return slothold.LocationIDEQ(s.branch.ID)
+}
+
+// closuresHere restricts a query to the closure days of the service's location.
+func (s *AppointmentService) closuresHere() predicate.ClosureDay {
+	if s.branch == nil {
+		return closureday.LocationIDIsNil()
+	}
+	return closureday.LocationIDEQ(s.branch.ID)
+}
+
+// blocksHere restricts a query to the blocked times at the service's location.
+func (s *AppointmentService) blocksHere() predicate.BlockedTime {
+	if s.branch == nil {
+		return blockedtime.LocationIDIsNil()
+	}
+	return blockedtime.LocationIDEQ(s.branch.ID)
+}
@@ -0,0 +1,205 @@
+package termin
+
+import (
+	notify "TerminSystem/Notify"
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"context"
+	"log"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// mail is the template of an email to the customer about their appointment.
+type mail struct {
+	subject *template.Template
+	body    *template.Template
+	// invite is the iTIP method of the calendar file attached for the
+	// appointment, none if empty.
+	invite string
+}
+
+func newMail(name, invite, subject, body string) mail {
+	return mail{
+		subject: template.Must(template.New(name).Parse(subject)),
+		body:    template.Must(template.New(name).Parse(body)),
+		invite:  invite,
+	}
+}
+
+// mailData is what the mail and SMS templates are executed with. Times are
+// formatted in the time zone of the appointment's location.
+type mailData struct {
+	Name     string
+	Type     string
+	Start    string
+	Place    string
+	Delkey   string
+	Previous string
+	Reason   string
+	// previous is the appointment as it was before it was moved.
+	previous *ent.Appointment
+	// ConfirmLink and ConfirmBy are set for appointments that still have to
+	// be confirmed.
+	ConfirmLink string
+	ConfirmBy   string
+	// ClaimLink and ClaimBy are set for slots offered to the waitlist.
+	ClaimLink string
+	ClaimBy   string
+}
+
+var (
+	confirmationMail = newMail("confirmation", methodRequest,
+		`Ihr Termin am {{.Start}} Uhr`,
+		`Hallo {{.Name}},
+
+vielen Dank für Ihre Buchung. Ihr Termin ({{.Type}}) ist am {{.Start}} Uhr{{with .Place}} in {{.}}{{end}}.
+{{with .ConfirmLink}}
+Bitte bestätigen Sie den Termin bis {{$.ConfirmBy}} Uhr über diesen Link, sonst wird er wieder freigegeben:
+{{.}}
+{{end}}
+Zum Verschieben oder Absagen benötigen Sie diesen Schlüssel:
+{{.Delkey}}
+`)
+
+	rescheduleMail = newMail("reschedule", methodRequest,
+		`Ihr Termin wurde auf den {{.Start}} Uhr verschoben`,
+		`Hallo {{.Name}},
+
+Ihr Termin ({{.Type}}) am {{.Previous}} Uhr wurde auf den {{.Start}} Uhr{{with .Place}} in {{.}}{{end}} verschoben.
+
+Zum Verschieben oder Absagen benötigen Sie weiterhin diesen Schlüssel:
+{{.Delkey}}
+`)
+
+	reminderMail = newMail("reminder", "",
+		`Erinnerung: Ihr Termin am {{.Start}} Uhr`,
+		`Hallo {{.Name}},
+
+wir freuen uns auf Ihren Besuch am {{.Start}} Uhr{{with .Place}} in {{.}}{{end}} ({{.Type}}).
+
+Falls Sie verhindert sind, sagen Sie den Termin bitte mit diesem Schlüssel ab:
+{{.Delkey}}
+`)
+
+	cancellationMail = newMail("cancellation", methodCancel,
+		`Ihr Termin am {{.Start}} Uhr wurde abgesagt`,
+		`Hallo {{.Name}},
+
+Ihr Termin ({{.Type}}) am {{.Start}} Uhr{{with .Place}} in {{.}}{{end}} wurde abgesagt.{{with .Reason}}
+Grund: {{.}}{{end}}
+
+Einen neuen Termin können Sie jederzeit online buchen.
+`)
+
+	offerMail = newMail("offer", "",
+		`Termin am {{.Start}} Uhr frei geworden`,
+		`Hallo {{.Name}},
+
+für Sie auf der Warteliste ist ein Termin ({{.Type}}) am {{.Start}} Uhr{{with .Place}} in {{.}}{{end}} frei geworden.
+
+Er ist bis {{.ClaimBy}} Uhr für Sie reserviert. Über diesen Link buchen Sie ihn:
+{{.ClaimLink}}
+`)
+)
+
+// sendMail sends the customer of the appointment the email. Failing to do so
+// does not undo the change the email is about.
+func (s *AppointmentService) sendMail(ctx context.Context, m mail, booked *ent.Appointment, data mailData) {
+	if s.config.Notifier == nil {
+		return
+	}
+
+	msg, err := s.renderMail(ctx, m, booked, data)
+	if err == nil {
+		err = s.config.Notifier.Send(ctx, msg)
+	}
+	if err != nil {
+		log.Printf("sending %s email for appointment %d: %v", m.body.Name(), booked.ID, err)
+	}
+}
+
+// sendOfferMail sends the customer of the waitlist entry the link to claim
+// the slot it is offered at the service's location. Failing to do so leaves
+// the offer until it expires.
+func (s *AppointmentService) sendOfferMail(ctx context.Context, entry *ent.WaitlistEntry) {
+	if s.config.Notifier == nil {
+		return
+	}
+
+	data := mailData{
+		Name:      entry.Name,
+		Type:      entry.Type,
+		Start:     s.formatMailTime(*entry.OfferedStart),
+		Place:     s.place(),
+		ClaimLink: s.config.BaseURL + "/api/waitlist/claim?token=" + url.QueryEscape(*entry.Token),
+		ClaimBy:   s.formatMailTime(*entry.OfferExpiresAt),
+	}
+
+	var subject, body strings.Builder
+	err := offerMail.subject.Execute(&subject, data)
+	if err == nil {
+		err = offerMail.body.Execute(&body, data)
+	}
+	if err == nil {
+		err = s.config.Notifier.Send(ctx, notify.Message{To: entry.Email, Subject: subject.String(), Body: body.String()})
+	}
+	if err != nil {
+		log.Printf("sending offer email for waitlist entry %d: %v", entry.ID, err)
+	}
+}
+
+func (s *AppointmentService) renderMail(ctx context.Context, m mail, booked *ent.Appointment, data mailData) (notify.Message, error) {
+	scoped, data, err := s.completeMailData(ctx, booked, data)
+	if err != nil {
+		return notify.Message{}, err
+	}
+
+	var subject, body strings.Builder
+	if err := m.subject.Execute(&subject, data); err != nil {
+		return notify.Message{}, err
+	}
+	if err := m.body.Execute(&body, data); err != nil {
+		return notify.Message{}, err
+	}
+
+	msg := notify.Message{To: booked.Email, Subject: subject.String(), Body: body.String()}
+	// A moved appointment is a new event, so the old one is cancelled.
+	if data.previous != nil {
+		msg.Attachments = append(msg.Attachments, scoped.invitation(methodCancel, data.previous))
+	}
+	if m.invite != "" {
+		msg.Attachments = append(msg.Attachments, scoped.invitation(m.invite, booked))
+	}
+	return msg, nil
+}
+
+// completeMailData fills in the details of the appointment. It returns the
+// service working on the appointment's location along with them.
+func (s *AppointmentService) completeMailData(ctx context.Context, booked *ent.Appointment, data mailData) (*AppointmentService, mailData, error) {
+	scoped, err := s.scope(ctx, booked.LocationID)
+	if err != nil {
+		return nil, data, err
+	}
+
+	data.Name = booked.Name
+	data.Type = booked.Type.String()
+	data.Start = scoped.formatMailTime(booked.StartTime)
+	data.Place = scoped.place()
+	data.Delkey = booked.Delkey
+	if data.previous != nil {
+		data.Previous = scoped.formatMailTime(data.previous.StartTime)
+	}
+	if booked.Status == appointment.StatusPending && booked.ConfirmBy != nil {
+		data.ConfirmLink = s.config.BaseURL + "/api/termins/confirm?token=" + url.QueryEscape(s.confirmationToken(booked))
+		data.ConfirmBy = scoped.formatMailTime(*booked.ConfirmBy)
+	}
+	return scoped, data, nil
+}
+
+// formatMailTime formats t the way German customers read it.
+func (s *AppointmentService) formatMailTime(t time.Time) string {
+	return t.In(s.config.Location).Format("02.01.2006 15:04")
+}
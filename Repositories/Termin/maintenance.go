@@ -0,0 +1,42 @@
+package termin
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// Maintain does the housekeeping that depends on time passing: waitlist
+// offers that were not claimed in time roll over to the next entry, expired
+// slot holds are deleted and unconfirmed bookings are released.
+func (s *AppointmentService) Maintain(ctx context.Context) error {
+	return errors.Join(
+		s.ExpireWaitlistOffers(ctx),
+		s.ReleaseExpiredHolds(ctx),
+		s.ReleaseUnconfirmed(ctx),
+	)
+}
+
+// RunMaintenance calls Maintain every interval until ctx is done.
+func (s *AppointmentService) RunMaintenance(ctx context.Context, interval time.Duration) {
+	runEvery(ctx, interval, "maintenance", s.Maintain)
+}
+
+// runEvery calls job every interval until ctx is done, logging its errors
+// under name.
+func runEvery(ctx context.Context, interval time.Duration, name string, job func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				log.Printf("%s: %v", name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,367 @@
+package termin
+
+import (
+	holidays "TerminSystem/Holidays"
+	"TerminSystem/ent"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"context"
+	"slices"
+	"time"
+)
+
+// BusinessHours is an opening interval of the shop, given as offsets from
+// midnight.
+type BusinessHours struct {
+	Open  time.Duration
+	Close time.Duration
+}
+
+// Interval is an opening interval as "15:04" wall clock times.
+type Interval struct {
+	Open  string `json:"open"`
+	Close string `json:"close"`
+}
+
+// DayHours is an opening interval on a weekday. A weekday can be listed
+// several times to open it in multiple intervals.
+type DayHours struct {
+	Weekday time.Weekday `json:"weekday"`
+	Open    string       `json:"open"`
+	Close   string       `json:"close"`
+}
+
+// GetBusinessHours returns the opening intervals on the calendar date of
+// date ordered by time, taking exceptions for that date over the weekly
+// opening hours. The shop is closed if there are none.
+func (s *AppointmentService) GetBusinessHours(ctx context.Context, date time.Time) ([]BusinessHours, error) {
+	exceptions, err := s.client.OpeningHoursException.Query().
+		Where(s.exceptionsHere(), openinghoursexception.DateEQ(date.Format("2006-01-02"))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(exceptions) > 0 {
+		intervals := make([]Interval, 0, len(exceptions))
+		for _, exception := range exceptions {
+			intervals = append(intervals, Interval{Open: exception.Open, Close: exception.Close})
+		}
+		return toBusinessHours(intervals)
+	}
+
+	rows, err := s.client.OpeningHours.Query().
+		Where(s.openingHoursHere(), openinghours.WeekdayEQ(int(date.Weekday()))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make([]Interval, 0, len(rows))
+	for _, row := range rows {
+		intervals = append(intervals, Interval{Open: row.Open, Close: row.Close})
+	}
+	return toBusinessHours(intervals)
+}
+
+// openingDays holds what decides whether the service's location is open on
+// the days of a date range, so they can be checked without further queries.
+type openingDays struct {
+	// closures holds the reason of each closure day by date.
+	closures map[string]string
+	// exceptions holds the opening hours exceptions by date.
+	exceptions map[string][]Interval
+	// weekly holds the opening hours by weekday.
+	weekly map[time.Weekday][]Interval
+}
+
+// loadOpeningDays loads the closure days and opening hours of the service's
+// location for the calendar dates from through to.
+func (s *AppointmentService) loadOpeningDays(ctx context.Context, from, to time.Time) (*openingDays, error) {
+	first, last := from.Format("2006-01-02"), to.Format("2006-01-02")
+	days := &openingDays{
+		closures:   map[string]string{},
+		exceptions: map[string][]Interval{},
+		weekly:     map[time.Weekday][]Interval{},
+	}
+
+	closures, err := s.client.ClosureDay.Query().
+		Where(s.closuresHere(), closureday.DateGTE(first), closureday.DateLTE(last)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, closure := range closures {
+		days.closures[closure.Date] = closure.Reason
+	}
+
+	exceptions, err := s.client.OpeningHoursException.Query().
+		Where(s.exceptionsHere(), openinghoursexception.DateGTE(first), openinghoursexception.DateLTE(last)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, exception := range exceptions {
+		days.exceptions[exception.Date] = append(days.exceptions[exception.Date], Interval{Open: exception.Open, Close: exception.Close})
+	}
+
+	rows, err := s.client.OpeningHours.Query().
+		Where(s.openingHoursHere()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		weekday := time.Weekday(row.Weekday)
+		days.weekly[weekday] = append(days.weekly[weekday], Interval{Open: row.Open, Close: row.Close})
+	}
+
+	return days, nil
+}
+
+// openHours returns the opening intervals on the calendar date of date like
+// GetBusinessHours, or an error if the location is closed on the day because
+// of a public holiday, a closure day or its opening hours.
+func (s *AppointmentService) openHours(days *openingDays, date time.Time) ([]BusinessHours, error) {
+	dateStr := date.Format("2006-01-02")
+	if holiday, ok := holidays.Lookup(date, s.state()); ok {
+		return nil, DateClosedDayError(dateStr, holiday.Name)
+	}
+	if reason, ok := days.closures[dateStr]; ok {
+		return nil, DateClosedDayError(dateStr, reason)
+	}
+
+	intervals, ok := days.exceptions[dateStr]
+	if !ok {
+		intervals = days.weekly[date.Weekday()]
+	}
+	hours, err := toBusinessHours(intervals)
+	if err != nil {
+		return nil, err
+	}
+	if len(hours) == 0 {
+		return nil, DateShopClosedError(date.Weekday().String())
+	}
+	return hours, nil
+}
+
+// ListOpeningHours returns the opening intervals of all open weekdays.
+func (s *AppointmentService) ListOpeningHours(ctx context.Context) ([]*ent.OpeningHours, error) {
+	return s.client.OpeningHours.Query().
+		Where(s.openingHoursHere()).
+		Order(ent.Asc(openinghours.FieldWeekday), ent.Asc(openinghours.FieldOpen)).
+		All(ctx)
+}
+
+// SetOpeningHours opens the shop on the weekday in the given intervals,
+// replacing the hours set before.
+func (s *AppointmentService) SetOpeningHours(ctx context.Context, weekday time.Weekday, intervals []Interval) ([]*ent.OpeningHours, error) {
+	if err := validateHours(intervals); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.OpeningHours.Delete().
+		Where(s.openingHoursHere(), openinghours.WeekdayEQ(int(weekday))).
+		Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	builders := make([]*ent.OpeningHoursCreate, 0, len(intervals))
+	for _, interval := range intervals {
+		builders = append(builders, tx.OpeningHours.Create().
+			SetNillableLocationID(s.branchID()).
+			SetWeekday(int(weekday)).
+			SetOpen(interval.Open).
+			SetClose(interval.Close))
+	}
+
+	rows, err := tx.OpeningHours.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		rows[i] = rows[i].Unwrap()
+	}
+	return rows, nil
+}
+
+// CloseWeekday removes the opening hours of the weekday so the shop is
+// closed on it.
+func (s *AppointmentService) CloseWeekday(ctx context.Context, weekday time.Weekday) error {
+	_, err := s.client.OpeningHours.Delete().
+		Where(s.openingHoursHere(), openinghours.WeekdayEQ(int(weekday))).
+		Exec(ctx)
+	return err
+}
+
+// SeedOpeningHours stores the given opening hours unless opening hours have
+// been set up already.
+func (s *AppointmentService) SeedOpeningHours(ctx context.Context, hours []DayHours) error {
+	exists, err := s.client.OpeningHours.Query().Where(s.openingHoursHere()).Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	byWeekday := make(map[time.Weekday][]Interval)
+	builders := make([]*ent.OpeningHoursCreate, 0, len(hours))
+	for _, day := range hours {
+		byWeekday[day.Weekday] = append(byWeekday[day.Weekday], Interval{Open: day.Open, Close: day.Close})
+		builders = append(builders, s.client.OpeningHours.Create().
+			SetNillableLocationID(s.branchID()).
+			SetWeekday(int(day.Weekday)).
+			SetOpen(day.Open).
+			SetClose(day.Close))
+	}
+
+	for _, intervals := range byWeekday {
+		if err := validateHours(intervals); err != nil {
+			return err
+		}
+	}
+
+	_, err = s.client.OpeningHours.CreateBulk(builders...).Save(ctx)
+	return err
+}
+
+// ListOpeningHoursExceptions returns the exceptions from the weekly opening
+// hours for dates from the given one on.
+func (s *AppointmentService) ListOpeningHoursExceptions(ctx context.Context, from string) ([]*ent.OpeningHoursException, error) {
+	return s.client.OpeningHoursException.Query().
+		Where(s.exceptionsHere(), openinghoursexception.DateGTE(from)).
+		Order(ent.Asc(openinghoursexception.FieldDate), ent.Asc(openinghoursexception.FieldOpen)).
+		All(ctx)
+}
+
+// SetOpeningHoursException opens the shop in the given intervals on the
+// date, regardless of its weekly opening hours.
+func (s *AppointmentService) SetOpeningHoursException(ctx context.Context, dateStr string, intervals []Interval) ([]*ent.OpeningHoursException, error) {
+	if _, err := time.Parse("2006-01-02", dateStr); err != nil {
+		return nil, InvalidDateError(dateStr)
+	}
+	if err := validateHours(intervals); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.OpeningHoursException.Delete().
+		Where(s.exceptionsHere(), openinghoursexception.DateEQ(dateStr)).
+		Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	builders := make([]*ent.OpeningHoursExceptionCreate, 0, len(intervals))
+	for _, interval := range intervals {
+		builders = append(builders, tx.OpeningHoursException.Create().
+			SetNillableLocationID(s.branchID()).
+			SetDate(dateStr).
+			SetOpen(interval.Open).
+			SetClose(interval.Close))
+	}
+
+	exceptions, err := tx.OpeningHoursException.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for i := range exceptions {
+		exceptions[i] = exceptions[i].Unwrap()
+	}
+	return exceptions, nil
+}
+
+// RemoveOpeningHoursException restores the weekly opening hours on the date.
+func (s *AppointmentService) RemoveOpeningHoursException(ctx context.Context, dateStr string) error {
+	_, err := s.client.OpeningHoursException.Delete().
+		Where(s.exceptionsHere(), openinghoursexception.DateEQ(dateStr)).
+		Exec(ctx)
+	return err
+}
+
+// toBusinessHours parses the intervals and orders them by opening time.
+func toBusinessHours(intervals []Interval) ([]BusinessHours, error) {
+	hours := make([]BusinessHours, 0, len(intervals))
+	for _, interval := range intervals {
+		open, err := parseClock(interval.Open)
+		if err != nil {
+			return nil, InvalidOpeningHoursError(interval.Open, interval.Close)
+		}
+		close, err := parseClock(interval.Close)
+		if err != nil {
+			return nil, InvalidOpeningHoursError(interval.Open, interval.Close)
+		}
+		hours = append(hours, BusinessHours{Open: open, Close: close})
+	}
+
+	slices.SortFunc(hours, func(a, b BusinessHours) int {
+		return int(a.Open - b.Open)
+	})
+	return hours, nil
+}
+
+// validateHours checks that the intervals of a day are well-formed and do
+// not overlap each other.
+func validateHours(intervals []Interval) error {
+	if len(intervals) == 0 {
+		return InvalidOpeningHoursError("", "")
+	}
+
+	hours, err := toBusinessHours(intervals)
+	if err != nil {
+		return err
+	}
+
+	for i, interval := range hours {
+		if interval.Close <= interval.Open || (i > 0 && interval.Open < hours[i-1].Close) {
+			return InvalidOpeningHoursError(formatClock(interval.Open), formatClock(interval.Close))
+		}
+	}
+	return nil
+}
+
+// within returns the opening interval containing the offset from midnight.
+func within(hours []BusinessHours, offset time.Duration) (BusinessHours, bool) {
+	for _, interval := range hours {
+		if interval.Open <= offset && offset < interval.Close {
+			return interval, true
+		}
+	}
+	return BusinessHours{}, false
+}
+
+// parseClock converts a "15:04" wall clock time into an offset from midnight.
+func parseClock(clock string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// formatClock converts an offset from midnight into a "15:04" wall clock time.
+func formatClock(offset time.Duration) string {
+	return time.Time{}.Add(offset).Format("15:04")
+}
+
+// clockOffset returns the offset of t's wall clock time from midnight.
+func clockOffset(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+}
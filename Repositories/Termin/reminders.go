@@ -0,0 +1,71 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// SendReminders reminds the customers of their confirmed appointments when
+// one of the reminder offsets before the start is reached. Every reminder is
+// recorded, so none is sent twice. Appointments booked after an offset has
+// passed only get the reminder of the closest offset.
+func (s *AppointmentService) SendReminders(ctx context.Context) error {
+	offsets := slices.Sorted(slices.Values(s.config.ReminderOffsets))
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	now := s.Now()
+	upcoming, err := s.client.Appointment.Query().
+		Where(
+			appointment.StatusEQ(appointment.StatusConfirmed),
+			appointment.StartTimeGT(now.UTC()),
+			appointment.StartTimeLTE(now.Add(offsets[len(offsets)-1]).UTC()),
+		).
+		WithReminders().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, booked := range upcoming {
+		left := booked.StartTime.Sub(now)
+		due := offsets[slices.IndexFunc(offsets, func(offset time.Duration) bool { return offset >= left })]
+
+		sent := slices.ContainsFunc(booked.Edges.Reminders, func(r *ent.Reminder) bool { return r.Offset == due })
+		if !sent {
+			errs = append(errs, s.remind(ctx, booked, due))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// remind records the reminder due offset before the appointment and sends it.
+func (s *AppointmentService) remind(ctx context.Context, booked *ent.Appointment, offset time.Duration) error {
+	_, err := s.client.Reminder.Create().
+		SetAppointmentID(booked.ID).
+		SetOffset(offset).
+		SetSentAt(s.Now().UTC()).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Sent in the meantime.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.sendMail(ctx, reminderMail, booked, mailData{})
+	s.sendSMS(ctx, reminderSMS, booked, mailData{})
+	return nil
+}
+
+// RunReminders calls SendReminders every interval until ctx is done.
+func (s *AppointmentService) RunReminders(ctx context.Context, interval time.Duration) {
+	runEvery(ctx, interval, "reminders", s.SendReminders)
+}
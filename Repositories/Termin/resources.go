@@ -0,0 +1,247 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/staff"
+	"context"
+	"slices"
+	"time"
+)
+
+// resources describes who serves the appointments of a day: the staff
+// members able to handle the requested type or, if the shop has no staff
+// configured, a fixed number of counters.
+type resources struct {
+	byStaff  bool
+	staff    []*ent.Staff
+	capacity int
+	booked   []*ent.Appointment
+	// reserved are slots kept free for someone, e.g. offered to the waitlist.
+	reserved []reservation
+	// blocked is time the shop owner blocked, nothing can be booked during it.
+	blocked []*ent.BlockedTime
+	// buffer is the buffer of the requested type, buffers those of all types.
+	buffer  Buffer
+	buffers map[appointment.Type]Buffer
+}
+
+// reservation keeps [start, end) free for the waitlist entry it is offered
+// to or the slot hold taking it. A reservation with a staff member only
+// keeps that one free.
+type reservation struct {
+	start   time.Time
+	end     time.Time
+	offer   int
+	hold    int
+	staffID int
+}
+
+// assignment is the staff member or counter an appointment is booked on.
+type assignment struct {
+	staffID int
+	counter int
+}
+
+// loadResources collects the resources and the existing bookings and
+// reservations at the service's location that could conflict with
+// appointments in [from, to). A non-zero staffID restricts the staff members
+// to that one.
+func (s *AppointmentService) loadResources(ctx context.Context, client *ent.Client, from, to time.Time, Type appointment.Type, staffID int) (*resources, error) {
+	reach := s.maxBuffer()
+	booked, err := client.Appointment.Query().
+		Where(
+			s.appointmentsHere(),
+			appointment.StatusNEQ(appointment.StatusCancelled),
+			appointment.StartTimeLT(to.Add(reach).UTC()),
+			appointment.EndTimeGT(from.Add(-reach).UTC()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved, err := s.loadReservations(ctx, client, from.Add(-reach), to.Add(reach))
+	if err != nil {
+		return nil, err
+	}
+
+	blocked, err := client.BlockedTime.Query().
+		Where(
+			s.blocksHere(),
+			blockedtime.StartTimeLT(to.UTC()),
+			blockedtime.EndTimeGT(from.UTC()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hasStaff, err := client.Staff.Query().Where(s.staffHere()).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasStaff {
+		if staffID != 0 {
+			return nil, StaffNotFoundError(staffID)
+		}
+		return &resources{
+			capacity: s.GetSlotCapacity(from.Weekday()),
+			booked:   booked,
+			reserved: reserved,
+			blocked:  blocked,
+			buffer:   s.GetBuffer(Type),
+			buffers:  s.config.Buffers,
+		}, nil
+	}
+
+	query := client.Staff.Query().Where(s.staffHere()).WithWorkingHours()
+	if staffID != 0 {
+		query = query.Where(staff.IDEQ(staffID))
+	}
+
+	members, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if staffID != 0 && len(members) == 0 {
+		return nil, StaffNotFoundError(staffID)
+	}
+
+	var able []*ent.Staff
+	for _, member := range members {
+		if canServe(member, Type) {
+			able = append(able, member)
+		}
+	}
+
+	return &resources{
+		byStaff:  true,
+		staff:    able,
+		booked:   booked,
+		reserved: reserved,
+		blocked:  blocked,
+		buffer:   s.GetBuffer(Type),
+		buffers:  s.config.Buffers,
+	}, nil
+}
+
+// allocate returns how many more appointments fit into [start, end) and the
+// resource the next one would be booked on.
+func (r *resources) allocate(start, end time.Time) (int, assignment) {
+	for _, block := range r.blocked {
+		if block.StartTime.Before(end) && block.EndTime.After(start) {
+			return 0, assignment{}
+		}
+	}
+
+	busy := r.conflicting(start, end)
+	reserved := r.reservedDuring(start, end)
+
+	if !r.byStaff {
+		return r.capacity - len(busy) - len(reserved), assignment{counter: freeCounter(busy, r.capacity)}
+	}
+
+	busyStaff := make(map[int]bool, len(busy))
+	unassigned := 0
+	for _, a := range busy {
+		if a.StaffID == nil {
+			unassigned++
+			continue
+		}
+		busyStaff[*a.StaffID] = true
+	}
+	pooled := 0
+	for _, held := range reserved {
+		if held.staffID == 0 {
+			pooled++
+			continue
+		}
+		busyStaff[held.staffID] = true
+	}
+
+	var free []*ent.Staff
+	for _, member := range r.staff {
+		if !busyStaff[member.ID] && worksDuring(member, start, end) {
+			free = append(free, member)
+		}
+	}
+
+	// Appointments booked before staff was configured still occupy someone,
+	// as do reservations for any staff member.
+	remaining := len(free) - unassigned - pooled
+	if remaining <= 0 {
+		return 0, assignment{}
+	}
+	return remaining, assignment{staffID: free[0].ID}
+}
+
+// release drops the appointment with the given ID from the bookings, so it
+// does not conflict with the slot it is moved to.
+func (r *resources) release(id int) {
+	r.booked = slices.DeleteFunc(r.booked, func(a *ent.Appointment) bool {
+		return a.ID == id
+	})
+}
+
+// releaseOffer drops the reservation of the waitlist entry, so its slot can
+// be booked for it.
+func (r *resources) releaseOffer(offer int) {
+	r.reserved = slices.DeleteFunc(r.reserved, func(held reservation) bool {
+		return held.offer == offer
+	})
+}
+
+// releaseHold drops the reservation of the slot hold, so its slot can be
+// booked with it.
+func (r *resources) releaseHold(hold int) {
+	r.reserved = slices.DeleteFunc(r.reserved, func(held reservation) bool {
+		return held.hold == hold
+	})
+}
+
+// reservedDuring returns the reservations overlapping [start, end).
+func (r *resources) reservedDuring(start, end time.Time) []reservation {
+	var result []reservation
+	for _, held := range r.reserved {
+		if held.start.Before(end) && held.end.After(start) {
+			result = append(result, held)
+		}
+	}
+	return result
+}
+
+// conflicting returns the booked appointments that are too close to an
+// appointment in [start, end). Two appointments need to be at least as far
+// apart as the longer of the buffers between them.
+func (r *resources) conflicting(start, end time.Time) []*ent.Appointment {
+	var result []*ent.Appointment
+	for _, a := range r.booked {
+		other := r.buffers[a.Type]
+		after := max(r.buffer.After, other.Before)
+		before := max(r.buffer.Before, other.After)
+		if a.StartTime.Before(end.Add(after)) && a.EndTime.Add(before).After(start) {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// canServe reports whether the staff member handles appointments of type t.
+func canServe(member *ent.Staff, t appointment.Type) bool {
+	return len(member.Types) == 0 || t == "" || slices.Contains(member.Types, t.String())
+}
+
+// worksDuring reports whether one of the staff member's shifts covers
+// [start, end) completely. start and end are in the shop's time zone.
+func worksDuring(member *ent.Staff, start, end time.Time) bool {
+	from, to := start.Format("15:04"), end.Format("15:04")
+	for _, shift := range member.Edges.WorkingHours {
+		if shift.Weekday == int(start.Weekday()) && shift.Start <= from && to <= shift.End {
+			return true
+		}
+	}
+	return false
+}
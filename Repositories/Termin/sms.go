@@ -0,0 +1,54 @@
+package termin
+
+import (
+	notify "TerminSystem/Notify"
+	"TerminSystem/ent"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"text/template"
+)
+
+// confirmedSMS tells the customer that their appointment is booked for good.
+var confirmedSMS = template.Must(template.New("confirmed").Parse(
+	`Ihr Termin ({{.Type}}) am {{.Start}} Uhr{{with .Place}} in {{.}}{{end}} ist bestätigt. Zum Absagen nutzen Sie bitte den Schlüssel aus unserer E-Mail.`))
+
+// reminderSMS reminds the customer of their appointment.
+var reminderSMS = template.Must(template.New("reminder").Parse(
+	`Erinnerung: Ihr Termin ({{.Type}}) ist am {{.Start}} Uhr{{with .Place}} in {{.}}{{end}}. Falls Sie verhindert sind, sagen Sie bitte ab.`))
+
+// sendSMS texts the customer of the appointment. Failing to do so does not
+// undo the change the message is about.
+func (s *AppointmentService) sendSMS(ctx context.Context, t *template.Template, booked *ent.Appointment, data mailData) {
+	if s.config.SMS == nil {
+		return
+	}
+
+	sms, err := s.renderSMS(ctx, t, booked, data)
+	if err == nil {
+		err = s.config.SMS.SendSMS(ctx, sms)
+	}
+	if err != nil {
+		log.Printf("sending %s SMS for appointment %d: %v", t.Name(), booked.ID, err)
+	}
+}
+
+func (s *AppointmentService) renderSMS(ctx context.Context, t *template.Template, booked *ent.Appointment, data mailData) (notify.SMS, error) {
+	to, ok := notify.E164(booked.Phone)
+	if !ok {
+		return notify.SMS{}, fmt.Errorf("invalid phone number %q", booked.Phone)
+	}
+
+	_, data, err := s.completeMailData(ctx, booked, data)
+	if err != nil {
+		return notify.SMS{}, err
+	}
+
+	var text strings.Builder
+	if err := t.Execute(&text, data); err != nil {
+		return notify.SMS{}, err
+	}
+
+	return notify.SMS{To: to, Text: notify.FitSMS(text.String(), s.config.SMSMaxSegments)}, nil
+}
@@ -0,0 +1,145 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"context"
+	"slices"
+)
+
+// transitions lists the statuses an appointment can move to from each status.
+// Completed, no-show and cancelled appointments are final.
+var transitions = map[appointment.Status][]appointment.Status{
+	appointment.StatusPending:   {appointment.StatusConfirmed, appointment.StatusCancelled},
+	appointment.StatusConfirmed: {appointment.StatusCheckedIn, appointment.StatusNoShow, appointment.StatusCancelled},
+	appointment.StatusCheckedIn: {appointment.StatusCompleted},
+}
+
+// CanTransition reports whether an appointment with status from can be moved
+// to status to.
+func CanTransition(from, to appointment.Status) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// active reports whether the appointment still lies ahead and can be moved or
+// cancelled.
+func active(status appointment.Status) bool {
+	return status == appointment.StatusPending || status == appointment.StatusConfirmed
+}
+
+// SetStatus moves the appointment with the given ID to the status. Cancelling
+// goes through CancelAppointment to record when and why.
+func (s *AppointmentService) SetStatus(ctx context.Context, id int, status appointment.Status) (*ent.Appointment, error) {
+	if err := appointment.StatusValidator(status); err != nil {
+		return nil, InvalidStatusTransitionError("", status.String())
+	}
+
+	booked, err := s.client.Appointment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, AppointmentNotFoundError()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if status == appointment.StatusCancelled {
+		return s.cancel(ctx, booked, "")
+	}
+	return s.setStatus(ctx, booked, status)
+}
+
+// setStatus moves the appointment to a status other than cancelled.
+func (s *AppointmentService) setStatus(ctx context.Context, booked *ent.Appointment, status appointment.Status) (*ent.Appointment, error) {
+	if !CanTransition(booked.Status, status) {
+		return nil, InvalidStatusTransitionError(booked.Status.String(), status.String())
+	}
+
+	// Only change the status if nobody else changed it in the meantime.
+	n, err := s.client.Appointment.Update().
+		Where(appointment.IDEQ(booked.ID), appointment.StatusEQ(booked.Status)).
+		SetStatus(status).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, InvalidStatusTransitionError(booked.Status.String(), status.String())
+	}
+
+	updated, err := s.client.Appointment.Get(ctx, booked.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == appointment.StatusConfirmed {
+		s.sendSMS(ctx, confirmedSMS, updated, mailData{})
+	}
+	return updated, nil
+}
+
+// CancelAppointment cancels the appointment with the delete key for the given
+// reason, which may be empty. The appointment is kept but its slot is free
+// again. Customers cannot cancel within the change cutoff.
+func (s *AppointmentService) CancelAppointment(ctx context.Context, delkey, reason string) (*ent.Appointment, error) {
+	booked, err := s.client.Appointment.Query().
+		Where(appointment.DelkeyEQ(delkey)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, AppointmentNotFoundError()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkChangeCutoff(booked); err != nil {
+		return nil, err
+	}
+
+	return s.cancel(ctx, booked, reason)
+}
+
+// checkChangeCutoff returns an error if the appointment starts too soon for
+// the customer to change it.
+func (s *AppointmentService) checkChangeCutoff(booked *ent.Appointment) error {
+	cutoff := *s.config.ChangeCutoff
+	if !active(booked.Status) {
+		return nil
+	}
+	if start := booked.StartTime.In(s.config.Location); s.Now().Add(cutoff).After(start) {
+		return ChangeCutoffError(start.Format("2006-01-02 15:04"), cutoff.String())
+	}
+	return nil
+}
+
+// cancel records the cancellation of the appointment.
+func (s *AppointmentService) cancel(ctx context.Context, booked *ent.Appointment, reason string) (*ent.Appointment, error) {
+	if !CanTransition(booked.Status, appointment.StatusCancelled) {
+		return nil, InvalidStatusTransitionError(booked.Status.String(), appointment.StatusCancelled.String())
+	}
+
+	// Only cancel if the appointment was not cancelled in the meantime, so
+	// its slot is released once.
+	n, err := s.client.Appointment.Update().
+		Where(
+			appointment.IDEQ(booked.ID),
+			appointment.StatusIn(appointment.StatusPending, appointment.StatusConfirmed),
+		).
+		SetStatus(appointment.StatusCancelled).
+		SetCancelledAt(s.Now().UTC()).
+		SetCancelReason(reason).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, InvalidStatusTransitionError(booked.Status.String(), appointment.StatusCancelled.String())
+	}
+
+	cancelled, err := s.client.Appointment.Get(ctx, booked.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.releaseSlot(ctx, cancelled)
+	s.sendMail(ctx, cancellationMail, cancelled, mailData{Reason: reason})
+	return cancelled, nil
+}
@@ -1,9 +1,17 @@
 package termin
 
 import (
+	holidays "TerminSystem/Holidays"
+	notify "TerminSystem/Notify"
+	staff "TerminSystem/Repositories/Staff"
+	"TerminSystem/ent"
 	"TerminSystem/ent/appointment"
 	"TerminSystem/ent/enttest"
+	"TerminSystem/ent/hook"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/waitlistentry"
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +20,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+// testNow is the time the tests run at, a Monday morning over three weeks
+// before the next public holiday, so the tests do not depend on the real clock.
+var testNow = time.Date(2030, time.May, 6, 8, 0, 0, 0, DefaultConfig().Location)
+
+func fixedNow() time.Time {
+	return testNow
+}
+
 func isWeekday(date time.Time) bool {
 	weekday := date.Weekday()
 	return weekday != time.Saturday && weekday != time.Sunday
@@ -27,7 +43,10 @@ func TestBookAppointment(t *testing.T) {
 
 	ctx := context.Background()
 
-	service := NewAppointmentService(client)
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
 
 	dates := service.GetAvailableDates(ctx, 14)
 	assert.NotEmpty(t, dates)
@@ -76,7 +95,10 @@ func TestGetAvailableDatesLength(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	service := NewAppointmentService(client)
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
 
 	dates := service.GetAvailableDates(ctx, 14)
 	assert.Len(t, dates, 14)
@@ -91,7 +113,10 @@ func TestWeekday(t *testing.T) {
 	}
 
 	ctx := context.Background()
-	service := NewAppointmentService(client)
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
 
 	dates := service.GetAvailableDates(ctx, 7)
 
@@ -114,8 +139,8 @@ func TestWeekday(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, timeslots)
 
-	TimeStart := timeslots[0]
-	TimeEnd := timeslots[len(timeslots)-1]
+	TimeStart := timeslots[0].Time
+	TimeEnd := timeslots[len(timeslots)-1].Time
 
 	assert.Equal(t, tomorrow+" 10:00", TimeStart)
 	assert.Equal(t, tomorrow+" 16:30", TimeEnd)
@@ -129,14 +154,19 @@ func TestDateValidator(t *testing.T) {
 		t.Fatalf("failed creating schema: %v", err)
 	}
 
-	service := NewAppointmentService(client)
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
 
 	loc, err := time.LoadLocation("Europe/Berlin")
 	if err != nil {
 		t.Fatal("Could not load Loc")
 	}
 
-	today := time.Now().In(loc).Truncate(24 * time.Hour)
+	// Tuesday, 7 May 2030: the day before is an open Monday and the 11th the
+	// following Saturday.
+	today := time.Date(2030, time.May, 7, 0, 0, 0, 0, loc)
 
 	tests := []struct {
 		name      string
@@ -149,7 +179,7 @@ func TestDateValidator(t *testing.T) {
 	}{
 		{
 			name:      "past date",
-			date:      today.AddDate(0, 0, -1).Format("2006-01-02"),
+			date:      "2030-05-06",
 			time:      "",
 			reference: today,
 			expected:  false,
@@ -158,7 +188,7 @@ func TestDateValidator(t *testing.T) {
 		},
 		{
 			name:      "today",
-			date:      today.Format("2006-01-02"),
+			date:      "2030-05-07",
 			time:      "",
 			reference: today,
 			expected:  true,
@@ -167,8 +197,8 @@ func TestDateValidator(t *testing.T) {
 		},
 		{
 			name:      "valid date and time",
-			date:      today.Format("2006-01-02"),
-			time:      today.Add(11 * time.Hour).Format("15:04"),
+			date:      "2030-05-07",
+			time:      "11:00",
 			reference: today,
 			expected:  true,
 			expectErr: false,
@@ -176,7 +206,7 @@ func TestDateValidator(t *testing.T) {
 		},
 		{
 			name:      "valid date without time (edgecase to check if reference date gets compared without time)",
-			date:      today.Format("2006-01-02"),
+			date:      "2030-05-07",
 			time:      "",
 			reference: today,
 			expected:  true,
@@ -185,7 +215,7 @@ func TestDateValidator(t *testing.T) {
 		},
 		{
 			name:      "next Saturday",
-			date:      today.AddDate(0, 0, (6-int(today.Weekday())+7)%7).Format("2006-01-02"),
+			date:      "2030-05-11",
 			time:      "",
 			reference: today,
 			expected:  true,
@@ -194,8 +224,8 @@ func TestDateValidator(t *testing.T) {
 		},
 		{
 			name:      "invalid time on Saturday",
-			date:      today.AddDate(0, 0, (6-int(today.Weekday())+7)%7).Format("2006-01-02"),
-			time:      today.Add(15 * time.Hour).Format("15:04"),
+			date:      "2030-05-11",
+			time:      "15:00",
 			reference: today,
 			expected:  false,
 			expectErr: true,
@@ -205,7 +235,7 @@ func TestDateValidator(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			isValid, err := service.IsValidTerminDate(tt.date, tt.time, tt.reference)
+			isValid, err := service.IsValidTerminDate(context.Background(), tt.date, tt.time, tt.reference)
 
 			assert.Equal(t, tt.expected, isValid)
 			if tt.expectErr {
@@ -225,3 +255,2130 @@ func TestDateValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestBookAppointmentSlotTaken(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	start := day.Add(11 * time.Hour)
+
+	_, err := service.BookAppointment(ctx, "First", "first@example.com", "123456789", "First", appointment.TypeSonstiges, start)
+	assert.NoError(t, err)
+
+	_, err = service.BookAppointment(ctx, "Second", "second@example.com", "987654321", "Second", appointment.TypeSonstiges, start)
+	assert.Error(t, err)
+
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err.Error())
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, day.Format("2006-01-02"))
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), start.Format("2006-01-02 15:04"))
+	assert.Contains(t, slotTimes(timeslots), start.Add(30*time.Minute).Format("2006-01-02 15:04"))
+
+	count, err := client.Appointment.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestInvalidEmail(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{ChangeCutoff: Duration(0), Now: fixedNow})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+
+	for _, email := range []string{
+		"not an email",
+		"kunde@example.com\r\nBcc: alle@example.com",
+		"Kunde <kunde@example.com>",
+	} {
+		_, err := service.BookAppointment(ctx, "Test User", email, "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+		customErr, ok := err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		assert.Equal(t, InvalidEmailErrorCode, customErr.Code, email)
+
+		_, err = service.JoinWaitlist(ctx, "Test User", email, "123456789", day.Format("2006-01-02"))
+		customErr, ok = err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		assert.Equal(t, InvalidEmailErrorCode, customErr.Code, email)
+	}
+
+	booked, err := service.BookAppointment(ctx, "Test User", "kunde@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+
+	injected := "kunde@example.com\nBcc: alle@example.com"
+	_, err = service.UpdateAppointment(ctx, booked.ID, AppointmentChanges{Email: &injected})
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidEmailErrorCode, customErr.Code)
+}
+
+func TestSlotCapacity(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{
+		SlotCapacity:    2,
+		WeekdayCapacity: map[time.Weekday]int{time.Saturday: 3},
+		Now:             fixedNow,
+	})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	assert.Equal(t, 2, service.GetSlotCapacity(time.Monday))
+	assert.Equal(t, 3, service.GetSlotCapacity(time.Saturday))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	start := day.Add(12 * time.Hour)
+	slot := start.Format("2006-01-02 15:04")
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, timeslots, TimeSlot{Time: slot, Remaining: 2})
+
+	first, err := service.BookAppointment(ctx, "First", "first@example.com", "123456789", "First", appointment.TypeSonstiges, start)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *first.Counter)
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, timeslots, TimeSlot{Time: slot, Remaining: 1})
+
+	second, err := service.BookAppointment(ctx, "Second", "second@example.com", "987654321", "Second", appointment.TypeSonstiges, start)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *second.Counter)
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), slot)
+
+	_, err = service.BookAppointment(ctx, "Third", "third@example.com", "555555555", "Third", appointment.TypeSonstiges, start)
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+}
+
+func TestConcurrentBooking(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	monday := time.Date(2030, time.May, 6, 8, 0, 0, 0, DefaultConfig().Location)
+	service := NewAppointmentService(client, Config{SlotCapacity: 2, Now: func() time.Time { return monday }})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	// Another booking takes the allocated counter right before the first
+	// attempt saves.
+	raced := false
+	client.Appointment.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.AppointmentFunc(func(ctx context.Context, m *ent.AppointmentMutation) (ent.Value, error) {
+			if counter, ok := m.Counter(); ok && m.Op().Is(ent.OpCreate) && !raced {
+				raced = true
+				start, _ := m.StartTime()
+				end, _ := m.EndTime()
+				if _, err := m.Client().Appointment.Create().
+					SetName("Other").SetEmail("other@example.com").SetPhone("123456789").
+					SetType(appointment.TypeSonstiges).SetStartTime(start).SetEndTime(end).
+					SetCounter(counter).SetDescription("Test").SetDelkey("other").
+					Save(ctx); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, monday.Add(2*time.Hour))
+	assert.True(t, raced)
+	if assert.NoError(t, err) {
+		assert.NotNil(t, booked.Counter)
+	}
+}
+
+func TestStaffAvailability(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+	staffService := staff.NewStaffService(client)
+
+	var morning, afternoon []staff.Shift
+	for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+		morning = append(morning, staff.Shift{Weekday: weekday, Start: "10:00", End: "13:00"})
+		afternoon = append(afternoon, staff.Shift{Weekday: weekday, Start: "12:00", End: "17:00"})
+	}
+
+	anna, err := staffService.CreateStaff(ctx, "Anna", []appointment.Type{appointment.TypeTrauringe}, morning)
+	assert.NoError(t, err)
+	_, err = staffService.CreateStaff(ctx, "Ben", nil, afternoon)
+	assert.NoError(t, err)
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	at := func(clock string) string { return dateStr + " " + clock }
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe})
+	assert.NoError(t, err)
+	assert.Contains(t, timeslots, TimeSlot{Time: at("10:00"), Remaining: 1})
+	assert.Contains(t, timeslots, TimeSlot{Time: at("12:00"), Remaining: 2})
+	assert.Contains(t, timeslots, TimeSlot{Time: at("15:00"), Remaining: 1})
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeOhrlochstechen})
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), at("10:00"))
+	assert.Contains(t, timeslots, TimeSlot{Time: at("12:00"), Remaining: 1})
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe, Staff: anna.ID})
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), at("12:00"))
+	assert.NotContains(t, slotTimes(timeslots), at("15:00"))
+
+	booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Rings", appointment.TypeTrauringe, day.Add(12*time.Hour), BookingOptions{Staff: anna.ID})
+	assert.NoError(t, err)
+	if assert.NotNil(t, booked.StaffID) {
+		assert.Equal(t, anna.ID, *booked.StaffID)
+	}
+	assert.Nil(t, booked.Counter)
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe})
+	assert.NoError(t, err)
+	assert.Contains(t, timeslots, TimeSlot{Time: at("12:00"), Remaining: 1})
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe, Staff: anna.ID})
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), at("12:00"))
+
+	_, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Staff: 999})
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, StaffNotFoundErrorCode, customErr.Code)
+}
+
+func TestTypeDurations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	assert.Equal(t, 15*time.Minute, service.GetDuration(appointment.TypeOhrlochstechen))
+	assert.Equal(t, 60*time.Minute, service.GetDuration(appointment.TypeTrauringe))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	at := func(clock string) string { return dateStr + " " + clock }
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe})
+	assert.NoError(t, err)
+	assert.Equal(t, at("16:00"), timeslots[len(timeslots)-1].Time)
+
+	rings, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Rings", appointment.TypeTrauringe, day.Add(11*time.Hour))
+	assert.NoError(t, err)
+	assert.WithinDuration(t, day.Add(12*time.Hour), rings.EndTime, time.Second)
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeSonstiges})
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), at("10:30"))
+	assert.NotContains(t, slotTimes(timeslots), at("11:00"))
+	assert.NotContains(t, slotTimes(timeslots), at("11:30"))
+	assert.Contains(t, slotTimes(timeslots), at("12:00"))
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe})
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), at("10:30"))
+	assert.Contains(t, slotTimes(timeslots), at("12:00"))
+
+	_, err = service.BookAppointment(ctx, "Late User", "late@example.com", "123456789", "Rings", appointment.TypeTrauringe, day.Add(16*time.Hour+30*time.Minute))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateShopClosedErrorCode, customErr.Code)
+}
+
+func TestTypeBuffers(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{
+		SlotInterval: 5 * time.Minute,
+		Buffers: map[appointment.Type]Buffer{
+			appointment.TypeOhrlochstechen: {After: 10 * time.Minute},
+			appointment.TypeGoldankauf:     {Before: 5 * time.Minute},
+		},
+		Now: fixedNow,
+	})
+	if err := service.SeedOpeningHours(context.Background(), DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	at := func(clock string) string { return dateStr + " " + clock }
+
+	piercing, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Piercing", appointment.TypeOhrlochstechen, day.Add(11*time.Hour))
+	assert.NoError(t, err)
+	assert.WithinDuration(t, day.Add(11*time.Hour+15*time.Minute), piercing.EndTime, time.Second)
+
+	_, err = service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Other", appointment.TypeSonstiges, day.Add(14*time.Hour))
+	assert.NoError(t, err)
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeSonstiges})
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), at("10:30"))
+	assert.NotContains(t, slotTimes(timeslots), at("11:20"))
+	assert.Contains(t, slotTimes(timeslots), at("11:25"))
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeGoldankauf})
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), at("14:30"))
+	assert.Contains(t, slotTimes(timeslots), at("14:35"))
+
+	_, err = service.BookAppointment(ctx, "Late User", "late@example.com", "123456789", "Gold", appointment.TypeGoldankauf, day.Add(14*time.Hour+30*time.Minute))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+}
+
+func TestOpeningHours(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(ctx, DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	saturday := time.Date(2030, time.January, 5, 0, 0, 0, 0, time.UTC)
+	hours, err := service.GetBusinessHours(ctx, saturday)
+	assert.NoError(t, err)
+	assert.Equal(t, []BusinessHours{{Open: 10 * time.Hour, Close: 14 * time.Hour}}, hours)
+
+	hours, err = service.GetBusinessHours(ctx, saturday.AddDate(0, 0, 1))
+	assert.NoError(t, err)
+	assert.Empty(t, hours)
+
+	_, err = service.SetOpeningHours(ctx, time.Monday, []Interval{{Open: "12:00", Close: "09:00"}})
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidOpeningHoursErrorCode, customErr.Code)
+
+	_, err = service.SetOpeningHours(ctx, time.Monday, []Interval{{Open: "09:30", Close: "12:15"}})
+	assert.NoError(t, err)
+	assert.NoError(t, service.CloseWeekday(ctx, time.Saturday))
+
+	rows, err := service.ListOpeningHours(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 5)
+
+	dates := service.GetAvailableDates(ctx, 14)
+	var monday string
+	for i, v := range dates {
+		date, err := time.Parse("2006-01-02", v)
+		assert.NoError(t, err)
+		assert.NotEqual(t, time.Saturday, date.Weekday())
+
+		if i > 0 && monday == "" && date.Weekday() == time.Monday {
+			monday = v
+		}
+	}
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, monday)
+	assert.NoError(t, err)
+	assert.Equal(t, monday+" 09:30", timeslots[0].Time)
+	assert.Equal(t, monday+" 11:30", timeslots[len(timeslots)-1].Time)
+
+	isValid, err := service.IsValidTerminDate(ctx, monday, "12:15")
+	assert.False(t, isValid)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateShopClosedErrorCode, customErr.Code)
+}
+
+func TestClosedDays(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{State: holidays.Bayern, Now: fixedNow})
+	if err := service.SeedOpeningHours(ctx, DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	nextYear := testNow.Year() + 1
+	for _, date := range []string{
+		fmt.Sprintf("%d-12-25", nextYear),
+		fmt.Sprintf("%d-10-03", nextYear),
+		fmt.Sprintf("%d-11-01", nextYear),
+		holidays.Easter(nextYear).AddDate(0, 0, 1).Format("2006-01-02"),
+	} {
+		isValid, err := service.IsValidTerminDate(ctx, date, "")
+		assert.False(t, isValid, date)
+
+		customErr, ok := err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		assert.Equal(t, DateClosedDayErrorCode, customErr.Code, date)
+	}
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+
+	_, err := service.AddClosureDay(ctx, dateStr, "Inventur")
+	assert.NoError(t, err)
+
+	_, err = service.AddClosureDay(ctx, "not a date", "Urlaub")
+	assert.Error(t, err)
+
+	assert.NotContains(t, service.GetAvailableDates(ctx, 14), dateStr)
+
+	_, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateClosedDayErrorCode, customErr.Code)
+
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateClosedDayErrorCode, customErr.Code)
+
+	closed, err := service.ListClosedDays(ctx, day.Year())
+	assert.NoError(t, err)
+	assert.Contains(t, closed, ClosedDay{Date: dateStr, Reason: "Inventur"})
+	assert.Contains(t, closed, ClosedDay{Date: fmt.Sprintf("%d-01-06", day.Year()), Reason: "Heilige Drei Könige", Holiday: true})
+
+	assert.NoError(t, service.RemoveClosureDay(ctx, dateStr))
+	assert.Contains(t, service.GetAvailableDates(ctx, 14), dateStr)
+
+	err = service.RemoveClosureDay(ctx, dateStr)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, ClosureDayNotFoundErrorCode, customErr.Code)
+
+	// A branch has its own closures and the holidays of its own state.
+	berlin, unknown := holidays.Berlin, holidays.State("XX")
+	_, err = service.CreateLocation(ctx, "Falsch", "", "", LocationOptions{State: &unknown})
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidStateErrorCode, customErr.Code)
+
+	branch, err := service.CreateLocation(ctx, "Filiale", "Nebenstraße 2", "", LocationOptions{State: &berlin})
+	assert.NoError(t, err)
+	scoped, err := service.At(ctx, branch.ID)
+	assert.NoError(t, err)
+
+	_, err = service.AddClosureDay(ctx, dateStr, "Inventur")
+	assert.NoError(t, err)
+	_, err = scoped.AddClosureDay(ctx, dateStr, "Betriebsausflug")
+	assert.NoError(t, err)
+	assert.NoError(t, scoped.RemoveClosureDay(ctx, dateStr))
+
+	closed, err = scoped.ListClosedDays(ctx, day.Year())
+	assert.NoError(t, err)
+	assert.NotContains(t, closed, ClosedDay{Date: dateStr, Reason: "Inventur"})
+	assert.NotContains(t, closed, ClosedDay{Date: fmt.Sprintf("%d-01-06", day.Year()), Reason: "Heilige Drei Könige", Holiday: true})
+	assert.Contains(t, closed, ClosedDay{Date: fmt.Sprintf("%d-03-08", day.Year()), Reason: "Internationaler Frauentag", Holiday: true})
+
+	closed, err = service.ListClosedDays(ctx, day.Year())
+	assert.NoError(t, err)
+	assert.Contains(t, closed, ClosedDay{Date: dateStr, Reason: "Inventur"})
+	assert.NotContains(t, closed, ClosedDay{Date: fmt.Sprintf("%d-03-08", day.Year()), Reason: "Internationaler Frauentag", Holiday: true})
+}
+
+func TestOpeningHoursExceptions(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(ctx, DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+
+	var saturday, sunday time.Time
+	for i := 1; saturday.IsZero() || sunday.IsZero(); i++ {
+		date := day.AddDate(0, 0, i)
+		if _, closed := holidays.Lookup(date, holidays.Federal); closed {
+			continue
+		}
+		switch date.Weekday() {
+		case time.Saturday:
+			saturday = date
+		case time.Sunday:
+			sunday = date
+		}
+	}
+
+	_, err := service.SetOpeningHoursException(ctx, dateStr, []Interval{{Open: "09:00", Close: "12:00"}})
+	assert.NoError(t, err)
+	_, err = service.SetOpeningHoursException(ctx, saturday.Format("2006-01-02"), []Interval{{Open: "10:00", Close: "18:00"}})
+	assert.NoError(t, err)
+	_, err = service.SetOpeningHoursException(ctx, sunday.Format("2006-01-02"), []Interval{{Open: "12:00", Close: "16:00"}})
+	assert.NoError(t, err)
+
+	_, err = service.SetOpeningHoursException(ctx, dateStr, []Interval{{Open: "18:00", Close: "12:00"}})
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidOpeningHoursErrorCode, customErr.Code)
+
+	exceptions, err := service.ListOpeningHoursExceptions(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Len(t, exceptions, 3)
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Equal(t, dateStr+" 09:00", timeslots[0].Time)
+	assert.Equal(t, dateStr+" 11:30", timeslots[len(timeslots)-1].Time)
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, saturday.Format("2006-01-02"))
+	assert.NoError(t, err)
+	assert.Equal(t, saturday.Format("2006-01-02")+" 17:30", timeslots[len(timeslots)-1].Time)
+
+	assert.Contains(t, service.GetAvailableDates(ctx, 21), sunday.Format("2006-01-02"))
+
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(14*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateShopClosedErrorCode, customErr.Code)
+
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(9*time.Hour))
+	assert.NoError(t, err)
+
+	assert.NoError(t, service.RemoveOpeningHoursException(ctx, dateStr))
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Equal(t, dateStr+" 16:30", timeslots[len(timeslots)-1].Time)
+}
+
+func TestLunchBreak(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	if err := service.SeedOpeningHours(ctx, DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	_, err := service.SetOpeningHours(ctx, time.Monday, []Interval{{Open: "10:00", Close: "13:30"}, {Open: "13:00", Close: "17:00"}})
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidOpeningHoursErrorCode, customErr.Code)
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	at := func(clock string) string { return dateStr + " " + clock }
+
+	_, err = service.SetOpeningHours(ctx, day.Weekday(), []Interval{{Open: "14:00", Close: "17:00"}, {Open: "10:00", Close: "13:00"}})
+	assert.NoError(t, err)
+
+	hours, err := service.GetBusinessHours(ctx, day)
+	assert.NoError(t, err)
+	assert.Equal(t, []BusinessHours{{Open: 10 * time.Hour, Close: 13 * time.Hour}, {Open: 14 * time.Hour, Close: 17 * time.Hour}}, hours)
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), at("12:30"))
+	assert.NotContains(t, slotTimes(timeslots), at("13:00"))
+	assert.NotContains(t, slotTimes(timeslots), at("13:30"))
+	assert.Contains(t, slotTimes(timeslots), at("14:00"))
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr, SlotFilter{Type: appointment.TypeTrauringe})
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), at("12:00"))
+	assert.NotContains(t, slotTimes(timeslots), at("12:30"))
+
+	isValid, err := service.IsValidTerminDate(ctx, dateStr, "13:30")
+	assert.False(t, isValid)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateShopClosedErrorCode, customErr.Code)
+
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Rings", appointment.TypeTrauringe, day.Add(12*time.Hour+30*time.Minute))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateShopClosedErrorCode, customErr.Code)
+}
+
+func TestBookingWindow(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{
+		Window: BookingWindow{LeadTime: Duration(5 * 24 * time.Hour), Horizon: 10 * 24 * time.Hour},
+		TypeWindows: map[appointment.Type]BookingWindow{
+			appointment.TypeTrauringe: {LeadTime: Duration(7 * 24 * time.Hour)},
+		},
+		Now: fixedNow,
+	})
+	if err := service.SeedOpeningHours(ctx, DefaultOpeningHours()); err != nil {
+		t.Fatalf("failed seeding opening hours: %v", err)
+	}
+
+	assert.Equal(t, BookingWindow{LeadTime: Duration(7 * 24 * time.Hour), Horizon: 10 * 24 * time.Hour}, service.GetBookingWindow(appointment.TypeTrauringe))
+
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal("Could not load Loc")
+	}
+	now := testNow
+
+	dates := service.GetAvailableDates(ctx, 30)
+	assert.NotEmpty(t, dates)
+	assert.Less(t, len(dates), 30)
+
+	offered := 0
+	for _, date := range dates {
+		day, err := time.ParseInLocation("2006-01-02", date, loc)
+		assert.NoError(t, err)
+		assert.False(t, day.After(now.Add(10*24*time.Hour)), date)
+
+		for Type, lead := range map[appointment.Type]time.Duration{
+			appointment.TypeSonstiges: 5 * 24 * time.Hour,
+			appointment.TypeTrauringe: 7 * 24 * time.Hour,
+		} {
+			timeslots, err := service.GetTimeSlotsByDate(ctx, date, SlotFilter{Type: Type})
+			assert.NoError(t, err)
+
+			for _, slot := range timeslots {
+				start, err := time.ParseInLocation("2006-01-02 15:04", slot.Time, loc)
+				assert.NoError(t, err)
+				assert.False(t, start.Before(now.Add(lead)), slot.Time)
+				offered++
+			}
+		}
+	}
+	assert.NotZero(t, offered)
+
+	day := firstBookableWeekday(t, dates)
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateTooSoonErrorCode, customErr.Code)
+
+	var late time.Time
+	for i := 12; late.IsZero(); i++ {
+		date := day.AddDate(0, 0, i)
+		if _, closed := holidays.Lookup(date, holidays.Federal); !closed && isWeekday(date) {
+			late = date
+		}
+	}
+
+	_, err = service.GetTimeSlotsByDate(ctx, late.Format("2006-01-02"))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateNotReadyErrorCode, customErr.Code)
+
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, late.Add(10*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateNotReadyErrorCode, customErr.Code)
+
+	// Without a lead time, bookings are taken until the appointment starts,
+	// also for a type whose lead time is set to zero.
+	soon := day.Add(10*time.Hour + 5*time.Minute)
+	noNotice := NewAppointmentService(client, Config{
+		Window: BookingWindow{LeadTime: Duration(time.Hour)},
+		TypeWindows: map[appointment.Type]BookingWindow{
+			appointment.TypeSonstiges: {LeadTime: Duration(0)},
+		},
+		Now: func() time.Time { return soon },
+	})
+	assert.Nil(t, NewAppointmentService(client, Config{Now: fixedNow}).GetBookingWindow(appointment.TypeSonstiges).LeadTime)
+	_, err = noNotice.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour+30*time.Minute))
+	assert.NoError(t, err)
+}
+
+func TestRescheduleAppointment(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	// The first bookable day may be tomorrow, within the change cutoff.
+	service := NewAppointmentService(client, Config{ChangeCutoff: Duration(0), Now: fixedNow})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	at := func(clock string) string { return dateStr + " " + clock }
+
+	rings, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Rings", appointment.TypeTrauringe, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	_, err = service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Other", appointment.TypeSonstiges, day.Add(14*time.Hour))
+	assert.NoError(t, err)
+
+	// The other appointment blocks the new slot and nothing changes.
+	_, err = service.RescheduleAppointment(ctx, rings.Delkey, day.Add(13*time.Hour+30*time.Minute))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	unchanged, err := client.Appointment.Get(ctx, rings.ID)
+	assert.NoError(t, err)
+	assert.WithinDuration(t, rings.StartTime, unchanged.StartTime, time.Second)
+
+	// Moving within its own old slot is fine as it does not block itself.
+	moved, err := service.RescheduleAppointment(ctx, rings.Delkey, day.Add(10*time.Hour+30*time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, rings.ID, moved.ID)
+	assert.Equal(t, rings.Delkey, moved.Delkey)
+	assert.WithinDuration(t, day.Add(10*time.Hour+30*time.Minute), moved.StartTime, time.Second)
+	assert.WithinDuration(t, day.Add(11*time.Hour+30*time.Minute), moved.EndTime, time.Second)
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), at("10:00"))
+	assert.NotContains(t, slotTimes(timeslots), at("11:00"))
+
+	count, err := client.Appointment.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	_, err = service.RescheduleAppointment(ctx, "unknown", day.Add(12*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, AppointmentNotFoundErrorCode, customErr.Code)
+
+	_, err = service.RescheduleAppointment(ctx, rings.Delkey, day.AddDate(0, 0, -7).Add(12*time.Hour))
+	assert.Error(t, err)
+}
+
+func TestAppointmentStatus(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	// The first bookable day may be tomorrow, within the change cutoff.
+	service := NewAppointmentService(client, Config{ChangeCutoff: Duration(0), Now: fixedNow})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	start := day.Add(10 * time.Hour)
+
+	first, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, start)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusPending, first.Status)
+
+	// Cancelling keeps the appointment but frees its slot.
+	assert.NoError(t, service.DeleteAppointment(ctx, first.Delkey))
+	cancelled, err := client.Appointment.Get(ctx, first.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCancelled, cancelled.Status)
+	assert.NotNil(t, cancelled.CancelledAt)
+
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), start.Format("2006-01-02 15:04"))
+
+	second, err := service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, start)
+	assert.NoError(t, err)
+
+	err = service.DeleteAppointment(ctx, first.Delkey)
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidStatusTransitionErrorCode, customErr.Code)
+
+	_, err = service.RescheduleAppointment(ctx, first.Delkey, day.Add(12*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidStatusTransitionErrorCode, customErr.Code)
+
+	// A visit goes from confirmed over checked in to completed and ends there.
+	_, err = service.SetStatus(ctx, second.ID, appointment.StatusCheckedIn)
+	assert.Error(t, err)
+	_, err = service.SetStatus(ctx, second.ID, appointment.StatusConfirmed)
+	assert.NoError(t, err)
+	visit, err := service.SetStatus(ctx, second.ID, appointment.StatusCheckedIn)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCheckedIn, visit.Status)
+	visit, err = service.SetStatus(ctx, second.ID, appointment.StatusCompleted)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCompleted, visit.Status)
+
+	for _, status := range []appointment.Status{appointment.StatusConfirmed, appointment.StatusCancelled, appointment.StatusNoShow} {
+		_, err = service.SetStatus(ctx, second.ID, status)
+		customErr, ok = err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		assert.Equal(t, InvalidStatusTransitionErrorCode, customErr.Code, status)
+	}
+
+	third, err := service.BookAppointment(ctx, "Third User", "third@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(11*time.Hour))
+	assert.NoError(t, err)
+	_, err = service.SetStatus(ctx, third.ID, appointment.StatusConfirmed)
+	assert.NoError(t, err)
+	noShow, err := service.SetStatus(ctx, third.ID, appointment.StatusNoShow)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusNoShow, noShow.Status)
+	_, err = service.SetStatus(ctx, third.ID, appointment.StatusCheckedIn)
+	assert.Error(t, err)
+
+	fourth, err := service.BookAppointment(ctx, "Fourth User", "fourth@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+	withReason, err := service.CancelAppointment(ctx, fourth.Delkey, "Krank")
+	assert.NoError(t, err)
+	assert.Equal(t, "Krank", withReason.CancelReason)
+
+	_, err = service.CancelAppointment(ctx, "unknown", "")
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, AppointmentNotFoundErrorCode, customErr.Code)
+
+	// Of two racing cancellations only the first one goes through.
+	fifth, err := service.BookAppointment(ctx, "Fifth User", "fifth@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(13*time.Hour))
+	assert.NoError(t, err)
+	_, err = service.CancelAppointmentByID(ctx, fifth.ID, "")
+	assert.NoError(t, err)
+	_, err = service.cancel(ctx, fifth, "")
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidStatusTransitionErrorCode, customErr.Code)
+
+	// Neither does a status change racing the cancellation.
+	assert.True(t, CanTransition(fifth.Status, appointment.StatusConfirmed))
+	_, err = service.setStatus(ctx, fifth, appointment.StatusConfirmed)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidStatusTransitionErrorCode, customErr.Code)
+	stillCancelled, err := client.Appointment.Get(ctx, fifth.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCancelled, stillCancelled.Status)
+
+	count, err := client.Appointment.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, count)
+}
+
+func TestChangeCutoff(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	now := func() time.Time { return day.Add(6 * time.Hour) }
+	service := NewAppointmentService(client, Config{ChangeCutoff: Duration(5 * time.Hour), Now: now})
+
+	soon, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Soon", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	later, err := service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Later", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+
+	// Four hours ahead the customer has to call the shop instead.
+	err = service.DeleteAppointment(ctx, soon.Delkey)
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, ChangeCutoffErrorCode, customErr.Code)
+	assert.Contains(t, customErr.Message, "call the shop")
+
+	_, err = service.RescheduleAppointment(ctx, soon.Delkey, day.Add(15*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, ChangeCutoffErrorCode, customErr.Code)
+
+	unchanged, err := client.Appointment.Get(ctx, soon.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusPending, unchanged.Status)
+
+	// Six hours ahead is outside the cutoff.
+	assert.NoError(t, service.DeleteAppointment(ctx, later.Delkey))
+
+	// The shop itself can still cancel.
+	cancelled, err := service.SetStatus(ctx, soon.ID, appointment.StatusCancelled)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCancelled, cancelled.Status)
+
+	// A negative cutoff allows changes until the start.
+	lenient := NewAppointmentService(client, Config{ChangeCutoff: Duration(0), Now: now})
+	last, err := lenient.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Last", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	assert.NoError(t, lenient.DeleteAppointment(ctx, last.Delkey))
+
+	// Only an unset cutoff takes the default.
+	assert.Equal(t, 12*time.Hour, *NewAppointmentService(client, Config{Now: fixedNow}).config.ChangeCutoff)
+	assert.Equal(t, time.Duration(0), *lenient.config.ChangeCutoff)
+}
+
+func TestWaitlist(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	now := day.Add(6 * time.Hour)
+
+	var offers []*ent.WaitlistEntry
+	mails := &notify.MemoryNotifier{}
+	service := NewAppointmentService(client, Config{
+		Window:       BookingWindow{LeadTime: Duration(2 * time.Hour)},
+		ChangeCutoff: Duration(0),
+		OfferTTL:     time.Hour,
+		Now:          func() time.Time { return now },
+		OnOffer:      func(entry *ent.WaitlistEntry) { offers = append(offers, entry) },
+		Notifier:     mails,
+		BaseURL:      "https://termine.example.com",
+	})
+
+	booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+
+	afternoon, err := service.JoinWaitlist(ctx, "Afternoon", "afternoon@example.com", "123456789", dateStr, WaitlistOptions{From: "14:00", To: "16:00"})
+	assert.NoError(t, err)
+	rings, err := service.JoinWaitlist(ctx, "Rings", "rings@example.com", "123456789", dateStr, WaitlistOptions{Type: appointment.TypeTrauringe})
+	assert.NoError(t, err)
+	anything, err := service.JoinWaitlist(ctx, "Anything", "anything@example.com", "123456789", dateStr)
+	assert.NoError(t, err)
+
+	_, err = service.JoinWaitlist(ctx, "Test User", "example@example.com", "123456789", dateStr, WaitlistOptions{From: "16:00", To: "14:00"})
+	assert.Error(t, err)
+
+	// The first entry that suits the freed slot gets it offered.
+	assert.NoError(t, service.DeleteAppointment(ctx, booked.Delkey))
+	if assert.Len(t, offers, 1) {
+		assert.Equal(t, rings.ID, offers[0].ID)
+		assert.Equal(t, appointment.TypeTrauringe.String(), offers[0].Type)
+		assert.WithinDuration(t, day.Add(12*time.Hour), *offers[0].OfferedStart, time.Second)
+		assert.WithinDuration(t, now.Add(time.Hour), *offers[0].OfferExpiresAt, time.Second)
+
+		// The customer is sent the link to claim it.
+		var offerMails []notify.Message
+		for _, msg := range mails.Sent() {
+			if msg.To == "rings@example.com" {
+				offerMails = append(offerMails, msg)
+			}
+		}
+		if assert.Len(t, offerMails, 1) {
+			assert.Contains(t, offerMails[0].Body, "https://termine.example.com/api/waitlist/claim?token="+*offers[0].Token)
+			assert.Contains(t, offerMails[0].Body, day.Add(12*time.Hour).Format("02.01.2006 15:04"))
+		}
+	}
+
+	// Nobody else can take the slot while it is offered.
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), dateStr+" 12:00")
+
+	_, err = service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	// An unclaimed offer rolls over to the next entry, which has to claim it
+	// before the lead time begins.
+	now = now.Add(3*time.Hour + 30*time.Minute)
+	assert.NoError(t, service.Maintain(ctx))
+	if assert.Len(t, offers, 2) {
+		assert.Equal(t, anything.ID, offers[1].ID)
+		assert.WithinDuration(t, day.Add(10*time.Hour), *offers[1].OfferExpiresAt, time.Second)
+	}
+
+	_, err = service.ClaimWaitlistOffer(ctx, *offers[0].Token)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, OfferUnavailableErrorCode, customErr.Code)
+
+	claimed, err := service.ClaimWaitlistOffer(ctx, *offers[1].Token)
+	assert.NoError(t, err)
+	assert.Equal(t, "Anything", claimed.Name)
+	assert.WithinDuration(t, day.Add(12*time.Hour), claimed.StartTime, time.Second)
+
+	_, err = service.ClaimWaitlistOffer(ctx, *offers[1].Token)
+	assert.Error(t, err)
+
+	statuses := map[int]waitlistentry.Status{}
+	entries, err := client.WaitlistEntry.Query().All(ctx)
+	assert.NoError(t, err)
+	for _, entry := range entries {
+		statuses[entry.ID] = entry.Status
+	}
+	assert.Equal(t, waitlistentry.StatusWaiting, statuses[afternoon.ID])
+	assert.Equal(t, waitlistentry.StatusExpired, statuses[rings.ID])
+	assert.Equal(t, waitlistentry.StatusClaimed, statuses[anything.ID])
+}
+
+func TestSlotHolds(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	now := day.Add(-24 * time.Hour)
+
+	service := NewAppointmentService(client, Config{
+		HoldTTL: 10 * time.Minute,
+		Now:     func() time.Time { return now },
+	})
+
+	hold, err := service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+	assert.WithinDuration(t, now.Add(10*time.Minute), hold.ExpiresAt, time.Second)
+
+	// The held slot is gone for everybody else.
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), dateStr+" 12:00")
+
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(12*time.Hour))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	_, err = service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	// A hold for another slot does not help.
+	_, err = service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour), BookingOptions{Hold: "unknown"})
+	assert.Error(t, err)
+
+	// The holder books the slot and uses up the hold.
+	_, err = service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour), BookingOptions{Hold: hold.Token})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, client.SlotHold.Query().CountX(ctx))
+
+	// An expired hold no longer blocks the slot and is cleaned up.
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(14*time.Hour))
+	assert.NoError(t, err)
+
+	now = now.Add(10 * time.Minute)
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), dateStr+" 14:00")
+
+	assert.NoError(t, service.Maintain(ctx))
+	assert.Equal(t, 0, client.SlotHold.Query().CountX(ctx))
+}
+
+func TestSlotHoldsWithStaff(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	now := day.Add(-24 * time.Hour)
+
+	shifts := []staff.Shift{{Weekday: day.Weekday(), Start: "10:00", End: "17:00"}}
+	anna, err := staff.NewStaffService(client).CreateStaff(ctx, "Anna", nil, shifts)
+	assert.NoError(t, err)
+	ben, err := staff.NewStaffService(client).CreateStaff(ctx, "Ben", nil, shifts)
+	assert.NoError(t, err)
+
+	service := NewAppointmentService(client, Config{
+		MaxHolds: 2,
+		Now:      func() time.Time { return now },
+	})
+
+	// A hold for Anna leaves Ben bookable.
+	hold, err := service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(12*time.Hour), BookingOptions{Staff: anna.ID, Client: "192.0.2.1"})
+	assert.NoError(t, err)
+	if assert.NotNil(t, hold.StaffID) {
+		assert.Equal(t, anna.ID, *hold.StaffID)
+	}
+
+	withBen, err := service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour), BookingOptions{Staff: ben.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, ben.ID, *withBen.StaffID)
+
+	_, err = service.BookAppointment(ctx, "Third User", "third@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	// Booking with the hold books Anna.
+	withAnna, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour), BookingOptions{Hold: hold.Token})
+	assert.NoError(t, err)
+	assert.Equal(t, anna.ID, *withAnna.StaffID)
+
+	// Picking another slot releases the client's previous hold, so a
+	// single staff member can be held for one slot after another.
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(13*time.Hour), BookingOptions{Staff: ben.ID, Client: "browser-1"})
+	assert.NoError(t, err)
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(13*time.Hour+30*time.Minute), BookingOptions{Staff: ben.ID, Client: "browser-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, client.SlotHold.Query().Where(slothold.ClientEQ("browser-1")).CountX(ctx))
+	_, err = service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(13*time.Hour), BookingOptions{Staff: ben.ID})
+	assert.NoError(t, err)
+
+	// Only so many slots can be held from one address at a time.
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(14*time.Hour), BookingOptions{Client: "browser-2", Address: "192.0.2.1"})
+	assert.NoError(t, err)
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(15*time.Hour), BookingOptions{Client: "browser-3", Address: "192.0.2.1"})
+	assert.NoError(t, err)
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(16*time.Hour), BookingOptions{Client: "browser-4", Address: "192.0.2.1"})
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, TooManyHoldsErrorCode, customErr.Code)
+
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(16*time.Hour), BookingOptions{Client: "browser-5", Address: "192.0.2.2"})
+	assert.NoError(t, err)
+
+	now = now.Add(10 * time.Minute)
+	_, err = service.HoldSlot(ctx, appointment.TypeSonstiges, day.Add(16*time.Hour), BookingOptions{Client: "browser-4", Address: "192.0.2.1"})
+	assert.NoError(t, err)
+}
+
+func TestConfirmation(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+	now := day.Add(-24 * time.Hour)
+
+	tokens := map[int]string{}
+	service := NewAppointmentService(client, Config{
+		ConfirmTTL:    30 * time.Minute,
+		ConfirmSecret: []byte("secret"),
+		Now:           func() time.Time { return now },
+		OnPending:     func(booked *ent.Appointment, token string) { tokens[booked.ID] = token },
+	})
+
+	first, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusPending, first.Status)
+	assert.WithinDuration(t, now.Add(30*time.Minute), *first.ConfirmBy, time.Second)
+	second, err := service.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 2)
+
+	// Pending appointments take their slot.
+	timeslots, err := service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.NotContains(t, slotTimes(timeslots), dateStr+" 10:00")
+
+	// Tokens signed with another secret or for another appointment are rejected.
+	other := NewAppointmentService(client, Config{ConfirmSecret: []byte("other"), Now: func() time.Time { return now }})
+	for _, token := range []string{other.confirmationToken(first), fmt.Sprintf("%d.%s", second.ID, strings.SplitN(tokens[first.ID], ".", 2)[1]), "garbage"} {
+		_, err = service.ConfirmAppointment(ctx, token)
+		customErr, ok := err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		assert.Equal(t, ConfirmationInvalidErrorCode, customErr.Code)
+	}
+
+	confirmed, err := service.ConfirmAppointment(ctx, tokens[first.ID])
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusConfirmed, confirmed.Status)
+	assert.Nil(t, confirmed.ConfirmBy)
+
+	// Following the link twice is fine.
+	_, err = service.ConfirmAppointment(ctx, tokens[first.ID])
+	assert.NoError(t, err)
+
+	// The unconfirmed appointment is released after the timeout.
+	now = now.Add(30 * time.Minute)
+	assert.NoError(t, service.Maintain(ctx))
+
+	released, err := client.Appointment.Get(ctx, second.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCancelled, released.Status)
+	kept, err := client.Appointment.Get(ctx, first.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusConfirmed, kept.Status)
+
+	timeslots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(timeslots), dateStr+" 12:00")
+
+	_, err = service.ConfirmAppointment(ctx, tokens[second.ID])
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, ConfirmationInvalidErrorCode, customErr.Code)
+}
+
+func TestMails(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	now := day.Add(-24 * time.Hour)
+
+	mails := &notify.MemoryNotifier{}
+	service := NewAppointmentService(client, Config{
+		Notifier: mails,
+		BaseURL:  "https://termine.example.com",
+		Now:      func() time.Time { return now },
+	})
+
+	booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+
+	sent := mails.Sent()
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, "example@example.com", sent[0].To)
+		assert.Contains(t, sent[0].Subject, day.Format("02.01.2006")+" 10:00")
+		assert.Contains(t, sent[0].Body, booked.Delkey)
+		assert.Contains(t, sent[0].Body, "https://termine.example.com/api/termins/confirm?token=")
+	}
+
+	_, err = service.RescheduleAppointment(ctx, booked.Delkey, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+
+	sent = mails.Sent()
+	if assert.Len(t, sent, 2) {
+		assert.Contains(t, sent[1].Subject, "verschoben")
+		assert.Contains(t, sent[1].Body, day.Format("02.01.2006")+" 10:00")
+		assert.Contains(t, sent[1].Body, day.Format("02.01.2006")+" 12:00")
+		assert.Contains(t, sent[1].Body, booked.Delkey)
+	}
+
+	_, err = service.CancelAppointment(ctx, booked.Delkey, "Krank")
+	assert.NoError(t, err)
+
+	sent = mails.Sent()
+	if assert.Len(t, sent, 3) {
+		assert.Contains(t, sent[2].Subject, "abgesagt")
+		assert.Contains(t, sent[2].Body, "Grund: Krank")
+	}
+}
+
+func TestSMS(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	now := day.Add(-24 * time.Hour)
+
+	texts := &notify.FakeSMSSender{}
+	tokens := map[int]string{}
+	service := NewAppointmentService(client, Config{
+		SMS:       texts,
+		Now:       func() time.Time { return now },
+		OnPending: func(booked *ent.Appointment, token string) { tokens[booked.ID] = token },
+	})
+
+	booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "0171 1234567", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, texts.Sent())
+
+	// The customer gets a text once the booking is confirmed.
+	_, err = service.ConfirmAppointment(ctx, tokens[booked.ID])
+	assert.NoError(t, err)
+
+	sent := texts.Sent()
+	if assert.Len(t, sent, 1) {
+		assert.Equal(t, "+491711234567", sent[0].To)
+		assert.Contains(t, sent[0].Text, day.Format("02.01.2006")+" 10:00")
+		assert.Equal(t, notify.GSM7, notify.EncodingOf(sent[0].Text))
+		assert.Equal(t, 1, notify.Segments(sent[0].Text))
+	}
+
+	// Numbers that cannot be texted do not fail the booking.
+	other, err := service.BookAppointment(ctx, "Other User", "other@example.com", "kein Handy", "Test", appointment.TypeSonstiges, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+	_, err = service.SetStatus(ctx, other.ID, appointment.StatusConfirmed)
+	assert.NoError(t, err)
+	assert.Len(t, texts.Sent(), 1)
+}
+
+func TestReminders(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	now := day.Add(-48 * time.Hour)
+
+	mails := &notify.MemoryNotifier{}
+	texts := &notify.FakeSMSSender{}
+	config := Config{
+		ChangeCutoff:    Duration(0),
+		ReminderOffsets: []time.Duration{2 * time.Hour, 24 * time.Hour},
+		Notifier:        mails,
+		SMS:             texts,
+		Now:             func() time.Time { return now },
+	}
+	service := NewAppointmentService(client, config)
+
+	// reminded returns the subjects of the reminders sent so far, checking
+	// that each went out by SMS as well.
+	reminded := func() []string {
+		var subjects []string
+		for _, msg := range mails.Sent() {
+			if strings.HasPrefix(msg.Subject, "Erinnerung") {
+				subjects = append(subjects, msg.Subject)
+			}
+		}
+		var sms int
+		for _, text := range texts.Sent() {
+			if strings.HasPrefix(text.Text, "Erinnerung") {
+				sms++
+			}
+		}
+		assert.Equal(t, len(subjects), sms)
+		return subjects
+	}
+
+	book := func(hour time.Duration, confirm bool) *ent.Appointment {
+		booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "0171 1234567", "Test", appointment.TypeTrauringe, day.Add(hour*time.Hour))
+		assert.NoError(t, err)
+		if confirm {
+			booked, err = service.SetStatus(ctx, booked.ID, appointment.StatusConfirmed)
+			assert.NoError(t, err)
+		}
+		return booked
+	}
+	morning := book(10, true)
+	afternoon := book(16, true)
+	book(12, false)
+
+	now = morning.StartTime.Add(-25 * time.Hour)
+	assert.NoError(t, service.SendReminders(ctx))
+	assert.Empty(t, reminded())
+
+	now = morning.StartTime.Add(-23 * time.Hour)
+	assert.NoError(t, service.SendReminders(ctx))
+	if assert.Len(t, reminded(), 1) {
+		assert.Contains(t, reminded()[0], day.Format("02.01.2006")+" 10:00")
+	}
+
+	// Nothing is sent twice, not even after a restart.
+	assert.NoError(t, service.SendReminders(ctx))
+	assert.NoError(t, NewAppointmentService(client, config).SendReminders(ctx))
+	assert.Len(t, reminded(), 1)
+
+	// The afternoon appointment only gets the 24h reminder once it is due,
+	// and only one of them although both offsets passed for the morning one.
+	now = day.Add(9 * time.Hour)
+	assert.NoError(t, service.SendReminders(ctx))
+	assert.Len(t, reminded(), 3)
+
+	// Moving an appointment starts its reminders over.
+	_, err := service.RescheduleAppointment(ctx, afternoon.Delkey, day.Add(15*time.Hour))
+	assert.NoError(t, err)
+	assert.NoError(t, service.SendReminders(ctx))
+	assert.Len(t, reminded(), 4)
+
+	now = day.Add(13*time.Hour + 30*time.Minute)
+	assert.NoError(t, service.SendReminders(ctx))
+	assert.Len(t, reminded(), 5)
+
+	count, err := client.Reminder.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+}
+
+func TestICS(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	now := day.Add(-24 * time.Hour)
+
+	mails := &notify.MemoryNotifier{}
+	service := NewAppointmentService(client, Config{
+		ShopName:    "Juwelier",
+		ShopAddress: "Hauptstraße 1",
+		ShopEmail:   "shop@example.com",
+		BaseURL:     "https://termine.example.com",
+		Notifier:    mails,
+		Now:         func() time.Time { return now },
+	})
+
+	booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Test", appointment.TypeTrauringe, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	uid := fmt.Sprintf("UID:termin-%d-%d@termine.example.com\r\n", booked.ID, booked.StartTime.Unix())
+
+	ics, err := service.AppointmentICS(ctx, booked.Delkey)
+	assert.NoError(t, err)
+	assert.Contains(t, string(ics), uid)
+	assert.Contains(t, string(ics), "DTSTART;TZID=Europe/Berlin:"+day.Format("20060102")+"T100000\r\n")
+	assert.Contains(t, string(ics), "LOCATION:Juwelier\\, Hauptstraße 1\r\n")
+	assert.Contains(t, string(ics), "STATUS:TENTATIVE\r\n")
+	assert.Contains(t, string(ics), "TZID:Europe/Berlin\r\n")
+
+	// The UID is stable.
+	again, err := service.AppointmentICS(ctx, booked.Delkey)
+	assert.NoError(t, err)
+	assert.Contains(t, string(again), uid)
+
+	_, err = service.AppointmentICS(ctx, "unknown")
+	assert.Error(t, err)
+
+	// methods returns the iTIP methods of the calendar files attached to
+	// the email and the files themselves.
+	methods := func(msg notify.Message) ([]string, []string) {
+		var methods, files []string
+		for _, attachment := range msg.Attachments {
+			_, method, _ := strings.Cut(attachment.ContentType, "method=")
+			methods = append(methods, method)
+			files = append(files, string(attachment.Data))
+		}
+		return methods, files
+	}
+
+	sent := mails.Sent()
+	if assert.Len(t, sent, 1) {
+		invites, files := methods(sent[0])
+		assert.Equal(t, []string{"REQUEST"}, invites)
+		assert.Contains(t, files[0], "METHOD:REQUEST\r\n")
+		assert.Contains(t, files[0], uid)
+		assert.Contains(t, files[0], "ORGANIZER:mailto:shop@example.com\r\n")
+	}
+
+	// Moving cancels the old event and sends the new one.
+	moved, err := service.RescheduleAppointment(ctx, booked.Delkey, day.Add(14*time.Hour))
+	assert.NoError(t, err)
+	movedUID := fmt.Sprintf("UID:termin-%d-%d@termine.example.com\r\n", moved.ID, moved.StartTime.Unix())
+
+	sent = mails.Sent()
+	if assert.Len(t, sent, 2) {
+		invites, files := methods(sent[1])
+		assert.Equal(t, []string{"CANCEL", "REQUEST"}, invites)
+		assert.Contains(t, files[0], uid)
+		assert.Contains(t, files[0], "STATUS:CANCELLED\r\n")
+		assert.Contains(t, files[0], "SEQUENCE:1\r\n")
+		assert.Contains(t, files[1], movedUID)
+	}
+
+	_, err = service.CancelAppointment(ctx, booked.Delkey, "")
+	assert.NoError(t, err)
+
+	sent = mails.Sent()
+	if assert.Len(t, sent, 3) {
+		invites, files := methods(sent[2])
+		assert.Equal(t, []string{"CANCEL"}, invites)
+		assert.Contains(t, files[0], "METHOD:CANCEL\r\n")
+		assert.Contains(t, files[0], movedUID)
+	}
+}
+
+func TestStaffFeed(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{ChangeCutoff: Duration(0), Now: fixedNow})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+
+	rings, err := service.BookAppointment(ctx, "Ring Kunde", "rings@example.com", "123456789", "Größe 54", appointment.TypeTrauringe, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+	gold, err := service.BookAppointment(ctx, "Gold Kunde", "gold@example.com", "123456789", "Test", appointment.TypeGoldankauf, day.Add(12*time.Hour))
+	assert.NoError(t, err)
+
+	branch, err := service.CreateLocation(ctx, "Filiale", "Nebenstraße 2", "")
+	assert.NoError(t, err)
+	scoped, err := service.At(ctx, branch.ID)
+	assert.NoError(t, err)
+	assert.NoError(t, scoped.SeedOpeningHours(ctx, DefaultOpeningHours()))
+	away, err := scoped.BookAppointment(ctx, "Filial Kunde", "branch@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+
+	feed, err := service.StaffFeed(ctx, FeedFilter{})
+	assert.NoError(t, err)
+	ics := strings.ReplaceAll(string(feed.ICS), "\r\n ", "")
+	assert.Contains(t, ics, "SUMMARY:Trauringe: Ring Kunde\r\n")
+	assert.Contains(t, ics, "SUMMARY:Goldankauf: Gold Kunde\r\n")
+	assert.Contains(t, ics, "SUMMARY:Sonstiges: Filial Kunde\r\n")
+	assert.Contains(t, ics, "LOCATION:Filiale\\, Nebenstraße 2\r\n")
+	assert.Contains(t, ics, "Anliegen: Größe 54")
+	assert.Contains(t, ics, "STATUS:TENTATIVE\r\n")
+	assert.NotContains(t, ics, "ATTENDEE")
+	assert.False(t, feed.LastModified.IsZero())
+
+	// The feed only changes when the appointments do.
+	unchanged, err := service.StaffFeed(ctx, FeedFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, feed.ETag, unchanged.ETag)
+	assert.Equal(t, feed.ICS, unchanged.ICS)
+
+	main := 0
+	for filter, expected := range map[*FeedFilter][]string{
+		{Type: appointment.TypeTrauringe}: {"Ring Kunde"},
+		{Location: &main}:                 {"Ring Kunde", "Gold Kunde"},
+		{Location: &branch.ID}:            {"Filial Kunde"},
+	} {
+		filtered, err := service.StaffFeed(ctx, *filter)
+		assert.NoError(t, err)
+		assert.Equal(t, len(expected), strings.Count(string(filtered.ICS), "BEGIN:VEVENT"), *filter)
+		for _, name := range expected {
+			assert.Contains(t, string(filtered.ICS), name)
+		}
+		assert.NotEqual(t, feed.ETag, filtered.ETag)
+	}
+
+	// Cancelled appointments drop out.
+	_, err = service.CancelAppointment(ctx, gold.Delkey, "")
+	assert.NoError(t, err)
+
+	changed, err := service.StaffFeed(ctx, FeedFilter{})
+	assert.NoError(t, err)
+	assert.NotEqual(t, feed.ETag, changed.ETag)
+	assert.False(t, changed.LastModified.Before(feed.LastModified))
+	assert.NotContains(t, string(changed.ICS), "Gold Kunde")
+	assert.Contains(t, string(changed.ICS), fmt.Sprintf("Termin-ID: %d", rings.ID))
+	assert.Contains(t, string(changed.ICS), fmt.Sprintf("Termin-ID: %d", away.ID))
+}
+
+func TestCalendarObjects(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	current := day.Add(6 * time.Hour)
+	service := NewAppointmentService(client, Config{Now: func() time.Time { return current }})
+
+	branch, err := service.CreateLocation(ctx, "Filiale", "Nebenstraße 2", "")
+	assert.NoError(t, err)
+	scoped, err := service.At(ctx, branch.ID)
+	assert.NoError(t, err)
+	assert.NoError(t, scoped.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	booked, err := service.BookAppointment(ctx, "Max Mustermann", "max@example.com", "123456789", "Test", appointment.TypeGoldankauf, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+
+	code := func(err error) int {
+		customErr, ok := err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		return customErr.Code
+	}
+
+	// Time blocked at the branch does not affect the main shop.
+	object, err := scoped.PutCalendarObject(ctx, "inventur.ics", CalendarChange{UID: "inventur@example.com", Start: day.Add(9 * time.Hour), End: day.Add(18 * time.Hour)})
+	assert.NoError(t, err)
+	assert.Contains(t, string(object.ICS), "SUMMARY:Blockiert")
+
+	slots, err := scoped.GetTimeSlotsByDate(ctx, day.Format("2006-01-02"))
+	assert.NoError(t, err)
+	assert.Empty(t, slots)
+	slots, err = service.GetTimeSlotsByDate(ctx, day.Format("2006-01-02"))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, slots)
+
+	_, err = service.CalendarObject(ctx, "inventur.ics")
+	assert.Equal(t, CalendarObjectNotFoundErrorCode, code(err))
+	_, err = scoped.CalendarObject(ctx, fmt.Sprintf("termin-%d.ics", booked.ID))
+	assert.Equal(t, CalendarObjectNotFoundErrorCode, code(err))
+
+	objects, err := service.CalendarObjects(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+	objects, err = scoped.CalendarObjects(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, objects, 1)
+
+	_, err = scoped.PutCalendarObject(ctx, "leer.ics", CalendarChange{UID: "leer@example.com", Start: day.Add(9 * time.Hour), End: day.Add(9 * time.Hour)})
+	assert.Equal(t, InvalidEventErrorCode, code(err))
+
+	// Two hours ahead the customer cannot move the appointment anymore, the
+	// staff can.
+	current = day.Add(8 * time.Hour)
+	_, err = service.RescheduleAppointment(ctx, booked.Delkey, day.Add(11*time.Hour))
+	assert.Equal(t, ChangeCutoffErrorCode, code(err))
+
+	name := fmt.Sprintf("termin-%d.ics", booked.ID)
+	_, err = service.PutCalendarObject(ctx, name, CalendarChange{Start: day.Add(11 * time.Hour), End: day.Add(13 * time.Hour)})
+	assert.NoError(t, err)
+	moved, err := client.Appointment.Get(ctx, booked.ID)
+	assert.NoError(t, err)
+	assert.True(t, moved.StartTime.Equal(day.Add(11*time.Hour)))
+	assert.True(t, moved.EndTime.Equal(day.Add(11*time.Hour+30*time.Minute)), "the duration stays that of the type")
+
+	_, err = service.PutCalendarObject(ctx, name, CalendarChange{Start: day.Add(20 * time.Hour), End: day.Add(21 * time.Hour)})
+	assert.Equal(t, DateShopClosedErrorCode, code(err))
+
+	// Each calendar has its own names.
+	_, err = service.PutCalendarObject(ctx, "inventur.ics", CalendarChange{UID: "inventur-haupt@example.com", Start: day.Add(18 * time.Hour), End: day.Add(19 * time.Hour)})
+	assert.NoError(t, err)
+	_, err = service.PutCalendarObject(ctx, "inventur.ics", CalendarChange{UID: "inventur-haupt@example.com", Start: day.Add(19 * time.Hour), End: day.Add(20 * time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, client.BlockedTime.Query().CountX(ctx))
+
+	assert.NoError(t, scoped.DeleteCalendarObject(ctx, "inventur.ics"))
+	assert.Equal(t, CalendarObjectNotFoundErrorCode, code(scoped.DeleteCalendarObject(ctx, "inventur.ics")))
+	_, err = service.CalendarObject(ctx, "inventur.ics")
+	assert.NoError(t, err)
+}
+
+func TestListAppointments(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{SlotCapacity: 2, Now: fixedNow})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	var booked []*ent.Appointment
+	for i, name := range []string{"Dora", "Anna", "Carl", "Bert", "Emil"} {
+		Type := appointment.TypeSonstiges
+		if i%2 == 1 {
+			Type = appointment.TypeGoldankauf
+		}
+		// Two appointments share each start time.
+		a, err := service.BookAppointment(ctx, name, "test@example.com", "123456789", "Test", Type, day.Add(10*time.Hour+time.Duration(i/2)*time.Hour))
+		assert.NoError(t, err)
+		booked = append(booked, a)
+	}
+	_, err := service.CancelAppointment(ctx, booked[4].Delkey, "")
+	assert.NoError(t, err)
+
+	names := func(page *AppointmentPage) []string {
+		var result []string
+		for _, a := range page.Appointments {
+			result = append(result, a.Name)
+		}
+		return result
+	}
+
+	// Pages follow each other without gaps, even between appointments
+	// starting at the same time.
+	var listed []string
+	filter := AppointmentFilter{Limit: 2}
+	for pages := 0; ; pages++ {
+		page, err := service.ListAppointments(ctx, filter)
+		assert.NoError(t, err)
+		listed = append(listed, names(page)...)
+		if page.NextCursor == "" {
+			assert.Equal(t, 2, pages)
+			break
+		}
+		filter.Cursor = page.NextCursor
+	}
+	assert.Equal(t, []string{"Dora", "Anna", "Carl", "Bert", "Emil"}, listed)
+
+	listed = nil
+	filter = AppointmentFilter{Sort: appointment.FieldName, Desc: true, Limit: 3}
+	page, err := service.ListAppointments(ctx, filter)
+	assert.NoError(t, err)
+	listed = append(listed, names(page)...)
+	filter.Cursor = page.NextCursor
+	page, err = service.ListAppointments(ctx, filter)
+	assert.NoError(t, err)
+	listed = append(listed, names(page)...)
+	assert.Equal(t, []string{"Emil", "Dora", "Carl", "Bert", "Anna"}, listed)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = service.ListAppointments(ctx, AppointmentFilter{
+		Types:    []appointment.Type{appointment.TypeSonstiges},
+		Statuses: []appointment.Status{appointment.StatusPending},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Dora", "Carl"}, names(page))
+
+	page, err = service.ListAppointments(ctx, AppointmentFilter{From: day.Add(11 * time.Hour), To: day.Add(12 * time.Hour)})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Carl", "Bert"}, names(page))
+
+	main := 0
+	page, err = service.ListAppointments(ctx, AppointmentFilter{Location: &main, Statuses: []appointment.Status{appointment.StatusCancelled}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Emil"}, names(page))
+
+	for _, invalid := range []AppointmentFilter{
+		{Sort: "email"},
+		{Limit: 1000},
+		{Cursor: "kaputt"},
+		{Sort: appointment.FieldName, Cursor: filter.Cursor},
+	} {
+		_, err = service.ListAppointments(ctx, invalid)
+		customErr, ok := err.(*AppointmentError)
+		if !ok {
+			t.Fatal("Wrong Error type return?", err)
+		}
+		assert.Equal(t, InvalidQueryErrorCode, customErr.Code)
+	}
+}
+
+func TestUpdateAppointment(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	assert.NoError(t, NewAppointmentService(client, Config{Now: fixedNow}).SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	day := firstBookableWeekday(t, NewAppointmentService(client, Config{Now: fixedNow}).GetAvailableDates(ctx, 14))
+	notifier := &notify.MemoryNotifier{}
+	service := NewAppointmentService(client, Config{
+		Now:      func() time.Time { return day.Add(8 * time.Hour) },
+		Notifier: notifier,
+	})
+
+	booked, err := service.BookAppointment(ctx, "Max Mustermann", "max@example.com", "123456789", "Test", appointment.TypeSonstiges, day.Add(10*time.Hour))
+	assert.NoError(t, err)
+
+	name, phone, empty := "Max Muster", "987654321", ""
+	updated, err := service.UpdateAppointment(ctx, booked.ID, AppointmentChanges{Name: &name, Phone: &phone})
+	assert.NoError(t, err)
+	assert.Equal(t, "Max Muster", updated.Name)
+	assert.Equal(t, "987654321", updated.Phone)
+	assert.Equal(t, "max@example.com", updated.Email)
+	assert.True(t, updated.StartTime.Equal(booked.StartTime))
+	assert.Len(t, notifier.Sent(), 1, "correcting contact details sends no email")
+
+	_, err = service.UpdateAppointment(ctx, booked.ID, AppointmentChanges{Email: &empty})
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidAppointmentErrorCode, customErr.Code)
+
+	// The shop can move the appointment within the change cutoff.
+	start := day.Add(11 * time.Hour)
+	updated, err = service.UpdateAppointment(ctx, booked.ID, AppointmentChanges{Start: &start})
+	assert.NoError(t, err)
+	assert.True(t, updated.StartTime.Equal(start))
+	assert.Len(t, notifier.Sent(), 2)
+
+	// A failed move keeps the contact details, a successful one tells the
+	// customer at the new address.
+	email, late := "muster@example.com", day.Add(21*time.Hour)
+	_, err = service.UpdateAppointment(ctx, booked.ID, AppointmentChanges{Name: &booked.Name, Email: &email, Start: &late})
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, DateShopClosedErrorCode, customErr.Code)
+	unchanged, err := service.GetAppointment(ctx, booked.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "max@example.com", unchanged.Email)
+	assert.Equal(t, "Max Muster", unchanged.Name)
+
+	start = day.Add(12 * time.Hour)
+	updated, err = service.UpdateAppointment(ctx, booked.ID, AppointmentChanges{Email: &email, Start: &start})
+	assert.NoError(t, err)
+	assert.Equal(t, email, updated.Email)
+	assert.True(t, updated.StartTime.Equal(start))
+	if sent := notifier.Sent(); assert.Len(t, sent, 3) {
+		assert.Equal(t, email, sent[2].To)
+	}
+
+	cancelled, err := service.CancelAppointmentByID(ctx, booked.ID, "Krankheit")
+	assert.NoError(t, err)
+	assert.Equal(t, appointment.StatusCancelled, cancelled.Status)
+	assert.Equal(t, "Krankheit", cancelled.CancelReason)
+	sent := notifier.Sent()
+	assert.Contains(t, sent[len(sent)-1].Body, "Grund: Krankheit")
+
+	_, err = service.CancelAppointmentByID(ctx, booked.ID, "")
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, InvalidStatusTransitionErrorCode, customErr.Code)
+
+	_, err = service.GetAppointment(ctx, booked.ID+100)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, AppointmentNotFoundErrorCode, customErr.Code)
+}
+
+func TestLocations(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	service := NewAppointmentService(client, Config{Now: fixedNow})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	branch, err := service.CreateLocation(ctx, "Filiale London", "1 Oxford Street", "Europe/London")
+	assert.NoError(t, err)
+
+	london, err := service.At(ctx, branch.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/London", london.Location().String())
+	assert.Equal(t, branch.ID, london.Branch().ID)
+	assert.Nil(t, service.Branch())
+
+	// A new branch is closed until it gets opening hours of its own.
+	assert.Empty(t, london.GetAvailableDates(ctx, 7))
+	assert.NoError(t, london.SeedOpeningHours(ctx, DefaultOpeningHours()))
+	assert.NotEmpty(t, london.GetAvailableDates(ctx, 7))
+
+	hours, err := london.ListOpeningHours(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, hours, 6)
+
+	day := firstBookableWeekday(t, service.GetAvailableDates(ctx, 14))
+	dateStr := day.Format("2006-01-02")
+
+	// 11:00 in Berlin and 10:00 in London are the same instant, booked on the
+	// first counter of each shop.
+	main, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Main", appointment.TypeSonstiges, day.Add(11*time.Hour))
+	assert.NoError(t, err)
+	assert.Nil(t, main.LocationID)
+
+	londonStart := time.Date(day.Year(), day.Month(), day.Day(), 10, 0, 0, 0, london.Location())
+	assert.True(t, londonStart.Equal(main.StartTime))
+	booked, err := london.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "London", appointment.TypeSonstiges, londonStart)
+	assert.NoError(t, err)
+	assert.Equal(t, branch.ID, *booked.LocationID)
+
+	_, err = london.BookAppointment(ctx, "Other User", "other@example.com", "123456789", "London", appointment.TypeSonstiges, londonStart)
+	customErr, ok := err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, SlotTakenErrorCode, customErr.Code)
+
+	// Slots are offered in the branch's time zone and only its own
+	// appointments take them.
+	slots, err := london.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Equal(t, dateStr+" 10:30", slots[0].Time)
+
+	slots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Contains(t, slotTimes(slots), dateStr+" 10:00")
+	assert.NotContains(t, slotTimes(slots), dateStr+" 11:00")
+
+	// Staff of the branch serve only there, the main shop keeps its counter.
+	var shifts []staff.Shift
+	for weekday := time.Monday; weekday <= time.Friday; weekday++ {
+		shifts = append(shifts, staff.Shift{Weekday: weekday, Start: "14:00", End: "17:00"})
+	}
+	member, err := staff.NewStaffService(client).CreateStaff(ctx, "Emma", nil, shifts, staff.StaffOptions{Location: branch.ID})
+	assert.NoError(t, err)
+	assert.Equal(t, branch.ID, *member.LocationID)
+
+	slots, err = london.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Equal(t, dateStr+" 14:00", slots[0].Time)
+
+	slots, err = service.GetTimeSlotsByDate(ctx, dateStr)
+	assert.NoError(t, err)
+	assert.Equal(t, dateStr+" 10:00", slots[0].Time)
+
+	_, err = service.At(ctx, branch.ID+1)
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, LocationNotFoundErrorCode, customErr.Code)
+
+	_, err = service.CreateLocation(ctx, "Filiale Mars", "", "Mars/Olympus_Mons")
+	customErr, ok = err.(*AppointmentError)
+	if !ok {
+		t.Fatal("Wrong Error type return?", err)
+	}
+	assert.Equal(t, LocationLoadErrorCode, customErr.Code)
+}
+
+func TestDaylightSavingTime(t *testing.T) {
+	loc := DefaultConfig().Location
+
+	// The clocks change in the night to the last Sunday of March and October,
+	// which the shop opens for with special hours.
+	cases := []struct {
+		name  string
+		now   time.Time
+		date  string
+		slots []string
+		// before and after are 10:00 on the Friday before and the Monday
+		// after the switch, stored as the given UTC times.
+		before, after       time.Time
+		beforeUTC, afterUTC string
+	}{
+		{
+			name:      "march",
+			now:       time.Date(2028, time.March, 20, 12, 0, 0, 0, loc),
+			date:      "2028-03-26",
+			slots:     []string{"01:00", "01:30", "03:00", "03:30"},
+			before:    time.Date(2028, time.March, 24, 10, 0, 0, 0, loc),
+			after:     time.Date(2028, time.March, 27, 10, 0, 0, 0, loc),
+			beforeUTC: "09:00",
+			afterUTC:  "08:00",
+		},
+		{
+			name:      "october",
+			now:       time.Date(2028, time.October, 23, 12, 0, 0, 0, loc),
+			date:      "2028-10-29",
+			slots:     []string{"01:00", "01:30", "02:00", "02:30", "03:00", "03:30"},
+			before:    time.Date(2028, time.October, 27, 10, 0, 0, 0, loc),
+			after:     time.Date(2028, time.October, 30, 10, 0, 0, 0, loc),
+			beforeUTC: "08:00",
+			afterUTC:  "09:00",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+			defer client.Close()
+
+			ctx := context.Background()
+			service := NewAppointmentService(client, Config{Now: func() time.Time { return tc.now }})
+			assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+			_, err := service.SetOpeningHoursException(ctx, tc.date, []Interval{{Open: "01:00", Close: "04:00"}})
+			assert.NoError(t, err)
+
+			slots, err := service.GetTimeSlotsByDate(ctx, tc.date, SlotFilter{Type: appointment.TypeSonstiges})
+			assert.NoError(t, err)
+			expected := make([]string, 0, len(tc.slots))
+			for _, slot := range tc.slots {
+				expected = append(expected, tc.date+" "+slot)
+			}
+			assert.Equal(t, expected, slotTimes(slots))
+
+			night, err := time.ParseInLocation("2006-01-02 15:04", tc.date+" 01:30", loc)
+			assert.NoError(t, err)
+			booked, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Night", appointment.TypeSonstiges, night)
+			assert.NoError(t, err)
+			assert.Equal(t, 30*time.Minute, booked.EndTime.Sub(booked.StartTime))
+
+			before, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "Before", appointment.TypeSonstiges, tc.before)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.beforeUTC, before.StartTime.UTC().Format("15:04"))
+
+			after, err := service.BookAppointment(ctx, "Test User", "example@example.com", "123456789", "After", appointment.TypeSonstiges, tc.after)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.afterUTC, after.StartTime.UTC().Format("15:04"))
+
+			stored, err := client.Appointment.Query().All(ctx)
+			assert.NoError(t, err)
+			assert.Len(t, stored, 3)
+			for _, a := range stored {
+				assert.Equal(t, time.UTC, a.StartTime.Location(), a.Description)
+				assert.Equal(t, time.UTC, a.EndTime.Location(), a.Description)
+			}
+
+			slots, err = service.GetTimeSlotsByDate(ctx, tc.date, SlotFilter{Type: appointment.TypeSonstiges})
+			assert.NoError(t, err)
+			assert.NotContains(t, slotTimes(slots), tc.date+" 01:30")
+
+			slots, err = service.GetTimeSlotsByDate(ctx, tc.after.Format("2006-01-02"))
+			assert.NoError(t, err)
+			assert.NotContains(t, slotTimes(slots), tc.after.Format("2006-01-02 15:04"))
+		})
+	}
+}
+
+// firstBookableWeekday returns midnight in the shop's time zone of the first
+// Monday-Friday among dates, skipping today so that every slot of the day is
+// still in the future.
+func TestAvailabilityQueries(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	defer client.Close()
+
+	ctx := context.Background()
+	monday := time.Date(2030, time.May, 6, 8, 0, 0, 0, DefaultConfig().Location)
+	service := NewAppointmentService(client, Config{Now: func() time.Time { return monday }})
+	assert.NoError(t, service.SeedOpeningHours(ctx, DefaultOpeningHours()))
+
+	queries := 0
+	client.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			queries++
+			return next.Query(ctx, q)
+		})
+	}))
+
+	// The days and slots are checked in memory instead of querying each one.
+	dates := service.GetAvailableDates(ctx, 365)
+	assert.Len(t, dates, 24)
+	assert.LessOrEqual(t, queries, 3)
+
+	queries = 0
+	slots, err := service.GetTimeSlotsByDate(ctx, "2030-05-07")
+	assert.NoError(t, err)
+	assert.Len(t, slots, 14)
+	assert.LessOrEqual(t, queries, 8)
+}
+
+func firstBookableWeekday(t *testing.T, dates []string) time.Time {
+	t.Helper()
+
+	for i, v := range dates {
+		if i == 0 {
+			continue
+		}
+		date, err := time.ParseInLocation("2006-01-02", v, DefaultConfig().Location)
+		assert.NoError(t, err)
+
+		if isWeekday(date) {
+			return date
+		}
+	}
+
+	t.Fatal("no weekday among available dates")
+	return time.Time{}
+}
+
+func slotTimes(slots []TimeSlot) []string {
+	times := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		times = append(times, slot.Time)
+	}
+	return times
+}
@@ -0,0 +1,260 @@
+package termin
+
+import (
+	"TerminSystem/ent"
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"log"
+	"time"
+
+	gonanoid "github.com/matoous/go-nanoid/v2"
+)
+
+// WaitlistOptions narrows down the slots a waitlist entry is offered.
+type WaitlistOptions struct {
+	// Type is the kind of appointment the customer wants, any if empty.
+	Type appointment.Type
+	// From and To limit the "15:04" wall clock times the appointment can
+	// start and end at. Empty means the whole day.
+	From string
+	To   string
+}
+
+// JoinWaitlist puts the customer on the waitlist for the date at the service's
+// location. Entries are offered freed slots in the order they joined.
+func (s *AppointmentService) JoinWaitlist(ctx context.Context, name, email, phone, dateStr string, opts ...WaitlistOptions) (*ent.WaitlistEntry, error) {
+	var o WaitlistOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	if err := checkEmail(email); err != nil {
+		return nil, err
+	}
+	if isValid, err := s.IsValidTerminDate(ctx, dateStr, ""); !isValid {
+		return nil, err
+	}
+
+	if o.Type != "" {
+		if err := appointment.TypeValidator(o.Type); err != nil {
+			return nil, err
+		}
+	}
+	for _, clock := range []string{o.From, o.To} {
+		if _, err := parseClock(clock); clock != "" && err != nil {
+			return nil, InvalidDateError(clock)
+		}
+	}
+	if o.From != "" && o.To != "" && o.From >= o.To {
+		return nil, InvalidDateError(o.From + " - " + o.To)
+	}
+
+	create := s.client.WaitlistEntry.Create().
+		SetName(name).
+		SetEmail(email).
+		SetPhone(phone).
+		SetDate(dateStr).
+		SetType(o.Type.String()).
+		SetNillableLocationID(s.branchID())
+	if o.From != "" {
+		create.SetWindowStart(o.From)
+	}
+	if o.To != "" {
+		create.SetWindowEnd(o.To)
+	}
+	return create.Save(ctx)
+}
+
+// ClaimWaitlistOffer books the slot offered to the waitlist entry with the
+// token for the customer.
+func (s *AppointmentService) ClaimWaitlistOffer(ctx context.Context, token string) (*ent.Appointment, error) {
+	entry, err := s.client.WaitlistEntry.Query().
+		Where(waitlistentry.TokenEQ(token)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, OfferUnavailableError()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if entry.Status != waitlistentry.StatusOffered || !s.Now().Before(*entry.OfferExpiresAt) {
+		return nil, OfferUnavailableError()
+	}
+
+	scoped, err := s.scope(ctx, entry.LocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return scoped.BookAppointment(ctx, entry.Name, entry.Email, entry.Phone, "", appointment.Type(entry.Type), *entry.OfferedStart, BookingOptions{offer: entry.ID})
+}
+
+// ExpireWaitlistOffers ends the offers that were not claimed in time and
+// offers their slots to the next entries on the waitlist.
+func (s *AppointmentService) ExpireWaitlistOffers(ctx context.Context) error {
+	expired, err := s.client.WaitlistEntry.Query().
+		Where(
+			waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+			waitlistentry.OfferExpiresAtLTE(s.Now().UTC()),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range expired {
+		// Only roll over offers that were not claimed in the meantime.
+		n, err := s.client.WaitlistEntry.Update().
+			Where(waitlistentry.IDEQ(entry.ID), waitlistentry.StatusEQ(waitlistentry.StatusOffered)).
+			SetStatus(waitlistentry.StatusExpired).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+
+		scoped, err := s.scope(ctx, entry.LocationID)
+		if err != nil {
+			return err
+		}
+		if err := scoped.offerSlot(ctx, *entry.OfferedStart, appointment.Type(entry.Type)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseSlot offers the slot of an appointment that no longer takes it to
+// the waitlist. Failing to do so does not undo the change that freed it.
+func (s *AppointmentService) releaseSlot(ctx context.Context, freed *ent.Appointment) {
+	scoped, err := s.scope(ctx, freed.LocationID)
+	if err == nil {
+		err = scoped.offerSlot(ctx, freed.StartTime, freed.Type)
+	}
+	if err != nil {
+		log.Printf("offering slot of appointment %d to the waitlist: %v", freed.ID, err)
+	}
+}
+
+// offerSlot offers the slot starting at start to the first waiting entry it
+// suits. Entries without a type are offered it for Type, the type of the
+// appointment that freed it.
+func (s *AppointmentService) offerSlot(ctx context.Context, start time.Time, Type appointment.Type) error {
+	start = start.In(s.config.Location)
+	now := s.Now()
+
+	entries, err := s.client.WaitlistEntry.Query().
+		Where(
+			s.waitlistHere(),
+			waitlistentry.DateEQ(start.Format("2006-01-02")),
+			waitlistentry.StatusEQ(waitlistentry.StatusWaiting),
+		).
+		Order(ent.Asc(waitlistentry.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		wanted := Type
+		if entry.Type != "" {
+			wanted = appointment.Type(entry.Type)
+		}
+
+		_, end, err := s.checkSlot(ctx, wanted, start)
+		if err != nil {
+			continue
+		}
+		if entry.WindowStart != "" && start.Format("15:04") < entry.WindowStart {
+			continue
+		}
+		if entry.WindowEnd != "" && end.Format("15:04") > entry.WindowEnd {
+			continue
+		}
+
+		res, err := s.loadResources(ctx, s.client, start, end, wanted, 0)
+		if err != nil {
+			return err
+		}
+		if remaining, _ := res.allocate(start, end); remaining <= 0 {
+			continue
+		}
+
+		token, err := gonanoid.New(64)
+		if err != nil {
+			return err
+		}
+
+		// The offer has to be claimed while the slot can still be booked.
+		expires := now.Add(s.config.OfferTTL)
+		if latest := start.Add(-s.GetBookingWindow(wanted).notice()); latest.Before(expires) {
+			expires = latest
+		}
+
+		offered, err := entry.Update().
+			SetStatus(waitlistentry.StatusOffered).
+			SetType(wanted.String()).
+			SetToken(token).
+			SetOfferedStart(start.UTC()).
+			SetOfferedEnd(end.UTC()).
+			SetOfferExpiresAt(expires.UTC()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		s.sendOfferMail(ctx, offered)
+		if s.config.OnOffer != nil {
+			s.config.OnOffer(offered)
+		}
+		return nil
+	}
+	return nil
+}
+
+// loadReservations returns the slots in [from, to) at the service's location
+// that are offered to waitlist entries or held, as long as the offer or hold
+// has not expired.
+func (s *AppointmentService) loadReservations(ctx context.Context, client *ent.Client, from, to time.Time) ([]reservation, error) {
+	offers, err := client.WaitlistEntry.Query().
+		Where(
+			s.waitlistHere(),
+			waitlistentry.StatusEQ(waitlistentry.StatusOffered),
+			waitlistentry.OfferExpiresAtGT(s.Now().UTC()),
+			waitlistentry.OfferedStartLT(to.UTC()),
+			waitlistentry.OfferedEndGT(from.UTC()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	holds, err := client.SlotHold.Query().
+		Where(
+			s.holdsHere(),
+			slothold.ExpiresAtGT(s.Now().UTC()),
+			slothold.StartTimeLT(to.UTC()),
+			slothold.EndTimeGT(from.UTC()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := make([]reservation, 0, len(offers)+len(holds))
+	for _, offer := range offers {
+		reserved = append(reserved, reservation{start: *offer.OfferedStart, end: *offer.OfferedEnd, offer: offer.ID})
+	}
+	for _, hold := range holds {
+		held := reservation{start: hold.StartTime, end: hold.EndTime, hold: hold.ID}
+		if hold.StaffID != nil {
+			held.staffID = *hold.StaffID
+		}
+		reserved = append(reserved, held)
+	}
+	return reserved, nil
+}
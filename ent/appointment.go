@@ -4,6 +4,8 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/staff"
 	"fmt"
 	"strings"
 	"time"
@@ -32,20 +34,83 @@ type Appointment struct {
 	// EndTime holds the value of the "end_time" field.
 	EndTime time.Time `json:"end_time,omitempty"`
 	// Description holds the value of the "description" field.
-	Description  string `json:"description,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Status holds the value of the "status" field.
+	Status appointment.Status `json:"status,omitempty"`
+	// CancelledAt holds the value of the "cancelled_at" field.
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	// CancelReason holds the value of the "cancel_reason" field.
+	CancelReason string `json:"cancel_reason,omitempty"`
+	// ConfirmBy holds the value of the "confirm_by" field.
+	ConfirmBy *time.Time `json:"confirm_by,omitempty"`
+	// Counter holds the value of the "counter" field.
+	Counter *int `json:"counter,omitempty"`
+	// StaffID holds the value of the "staff_id" field.
+	StaffID *int `json:"staff_id,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AppointmentQuery when eager-loading is set.
+	Edges        AppointmentEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// AppointmentEdges holds the relations/edges for other nodes in the graph.
+type AppointmentEdges struct {
+	// Staff holds the value of the staff edge.
+	Staff *Staff `json:"staff,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// Reminders holds the value of the reminders edge.
+	Reminders []*Reminder `json:"reminders,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// StaffOrErr returns the Staff value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentEdges) StaffOrErr() (*Staff, error) {
+	if e.Staff != nil {
+		return e.Staff, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: staff.Label}
+	}
+	return nil, &NotLoadedError{edge: "staff"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AppointmentEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// RemindersOrErr returns the Reminders value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppointmentEdges) RemindersOrErr() ([]*Reminder, error) {
+	if e.loadedTypes[2] {
+		return e.Reminders, nil
+	}
+	return nil, &NotLoadedError{edge: "reminders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Appointment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case appointment.FieldID:
+		case appointment.FieldID, appointment.FieldCounter, appointment.FieldStaffID, appointment.FieldLocationID:
 			values[i] = new(sql.NullInt64)
-		case appointment.FieldName, appointment.FieldEmail, appointment.FieldPhone, appointment.FieldType, appointment.FieldDelkey, appointment.FieldDescription:
+		case appointment.FieldName, appointment.FieldEmail, appointment.FieldPhone, appointment.FieldType, appointment.FieldDelkey, appointment.FieldDescription, appointment.FieldStatus, appointment.FieldCancelReason:
 			values[i] = new(sql.NullString)
-		case appointment.FieldStartTime, appointment.FieldEndTime:
+		case appointment.FieldStartTime, appointment.FieldEndTime, appointment.FieldCancelledAt, appointment.FieldConfirmBy, appointment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -116,6 +181,60 @@ func (a *Appointment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Description = value.String
 			}
+		case appointment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				a.Status = appointment.Status(value.String)
+			}
+		case appointment.FieldCancelledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cancelled_at", values[i])
+			} else if value.Valid {
+				a.CancelledAt = new(time.Time)
+				*a.CancelledAt = value.Time
+			}
+		case appointment.FieldCancelReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_reason", values[i])
+			} else if value.Valid {
+				a.CancelReason = value.String
+			}
+		case appointment.FieldConfirmBy:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirm_by", values[i])
+			} else if value.Valid {
+				a.ConfirmBy = new(time.Time)
+				*a.ConfirmBy = value.Time
+			}
+		case appointment.FieldCounter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field counter", values[i])
+			} else if value.Valid {
+				a.Counter = new(int)
+				*a.Counter = int(value.Int64)
+			}
+		case appointment.FieldStaffID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field staff_id", values[i])
+			} else if value.Valid {
+				a.StaffID = new(int)
+				*a.StaffID = int(value.Int64)
+			}
+		case appointment.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				a.LocationID = new(int)
+				*a.LocationID = int(value.Int64)
+			}
+		case appointment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				a.UpdatedAt = new(time.Time)
+				*a.UpdatedAt = value.Time
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -129,6 +248,21 @@ func (a *Appointment) Value(name string) (ent.Value, error) {
 	return a.selectValues.Get(name)
 }
 
+// QueryStaff queries the "staff" edge of the Appointment entity.
+func (a *Appointment) QueryStaff() *StaffQuery {
+	return NewAppointmentClient(a.config).QueryStaff(a)
+}
+
+// QueryLocation queries the "location" edge of the Appointment entity.
+func (a *Appointment) QueryLocation() *LocationQuery {
+	return NewAppointmentClient(a.config).QueryLocation(a)
+}
+
+// QueryReminders queries the "reminders" edge of the Appointment entity.
+func (a *Appointment) QueryReminders() *ReminderQuery {
+	return NewAppointmentClient(a.config).QueryReminders(a)
+}
+
 // Update returns a builder for updating this Appointment.
 // Note that you need to call Appointment.Unwrap() before calling this method if this Appointment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -175,6 +309,42 @@ func (a *Appointment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(a.Description)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", a.Status))
+	builder.WriteString(", ")
+	if v := a.CancelledAt; v != nil {
+		builder.WriteString("cancelled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("cancel_reason=")
+	builder.WriteString(a.CancelReason)
+	builder.WriteString(", ")
+	if v := a.ConfirmBy; v != nil {
+		builder.WriteString("confirm_by=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.Counter; v != nil {
+		builder.WriteString("counter=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.StaffID; v != nil {
+		builder.WriteString("staff_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,9 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/staff"
 	"context"
 	"errors"
 	"fmt"
@@ -68,6 +71,143 @@ func (ac *AppointmentCreate) SetDescription(s string) *AppointmentCreate {
 	return ac
 }
 
+// SetStatus sets the "status" field.
+func (ac *AppointmentCreate) SetStatus(a appointment.Status) *AppointmentCreate {
+	ac.mutation.SetStatus(a)
+	return ac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableStatus(a *appointment.Status) *AppointmentCreate {
+	if a != nil {
+		ac.SetStatus(*a)
+	}
+	return ac
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (ac *AppointmentCreate) SetCancelledAt(t time.Time) *AppointmentCreate {
+	ac.mutation.SetCancelledAt(t)
+	return ac
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableCancelledAt(t *time.Time) *AppointmentCreate {
+	if t != nil {
+		ac.SetCancelledAt(*t)
+	}
+	return ac
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (ac *AppointmentCreate) SetCancelReason(s string) *AppointmentCreate {
+	ac.mutation.SetCancelReason(s)
+	return ac
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableCancelReason(s *string) *AppointmentCreate {
+	if s != nil {
+		ac.SetCancelReason(*s)
+	}
+	return ac
+}
+
+// SetConfirmBy sets the "confirm_by" field.
+func (ac *AppointmentCreate) SetConfirmBy(t time.Time) *AppointmentCreate {
+	ac.mutation.SetConfirmBy(t)
+	return ac
+}
+
+// SetNillableConfirmBy sets the "confirm_by" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableConfirmBy(t *time.Time) *AppointmentCreate {
+	if t != nil {
+		ac.SetConfirmBy(*t)
+	}
+	return ac
+}
+
+// SetCounter sets the "counter" field.
+func (ac *AppointmentCreate) SetCounter(i int) *AppointmentCreate {
+	ac.mutation.SetCounter(i)
+	return ac
+}
+
+// SetNillableCounter sets the "counter" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableCounter(i *int) *AppointmentCreate {
+	if i != nil {
+		ac.SetCounter(*i)
+	}
+	return ac
+}
+
+// SetStaffID sets the "staff_id" field.
+func (ac *AppointmentCreate) SetStaffID(i int) *AppointmentCreate {
+	ac.mutation.SetStaffID(i)
+	return ac
+}
+
+// SetNillableStaffID sets the "staff_id" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableStaffID(i *int) *AppointmentCreate {
+	if i != nil {
+		ac.SetStaffID(*i)
+	}
+	return ac
+}
+
+// SetLocationID sets the "location_id" field.
+func (ac *AppointmentCreate) SetLocationID(i int) *AppointmentCreate {
+	ac.mutation.SetLocationID(i)
+	return ac
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableLocationID(i *int) *AppointmentCreate {
+	if i != nil {
+		ac.SetLocationID(*i)
+	}
+	return ac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ac *AppointmentCreate) SetUpdatedAt(t time.Time) *AppointmentCreate {
+	ac.mutation.SetUpdatedAt(t)
+	return ac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ac *AppointmentCreate) SetNillableUpdatedAt(t *time.Time) *AppointmentCreate {
+	if t != nil {
+		ac.SetUpdatedAt(*t)
+	}
+	return ac
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (ac *AppointmentCreate) SetStaff(s *Staff) *AppointmentCreate {
+	return ac.SetStaffID(s.ID)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ac *AppointmentCreate) SetLocation(l *Location) *AppointmentCreate {
+	return ac.SetLocationID(l.ID)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (ac *AppointmentCreate) AddReminderIDs(ids ...int) *AppointmentCreate {
+	ac.mutation.AddReminderIDs(ids...)
+	return ac
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (ac *AppointmentCreate) AddReminders(r ...*Reminder) *AppointmentCreate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ac.AddReminderIDs(ids...)
+}
+
 // Mutation returns the AppointmentMutation object of the builder.
 func (ac *AppointmentCreate) Mutation() *AppointmentMutation {
 	return ac.mutation
@@ -75,6 +215,7 @@ func (ac *AppointmentCreate) Mutation() *AppointmentMutation {
 
 // Save creates the Appointment in the database.
 func (ac *AppointmentCreate) Save(ctx context.Context) (*Appointment, error) {
+	ac.defaults()
 	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
 }
 
@@ -100,6 +241,18 @@ func (ac *AppointmentCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (ac *AppointmentCreate) defaults() {
+	if _, ok := ac.mutation.Status(); !ok {
+		v := appointment.DefaultStatus
+		ac.mutation.SetStatus(v)
+	}
+	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		v := appointment.DefaultUpdatedAt()
+		ac.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (ac *AppointmentCreate) check() error {
 	if _, ok := ac.mutation.Name(); !ok {
@@ -151,6 +304,19 @@ func (ac *AppointmentCreate) check() error {
 	if _, ok := ac.mutation.Description(); !ok {
 		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Appointment.description"`)}
 	}
+	if _, ok := ac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Appointment.status"`)}
+	}
+	if v, ok := ac.mutation.Status(); ok {
+		if err := appointment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Appointment.status": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.Counter(); ok {
+		if err := appointment.CounterValidator(v); err != nil {
+			return &ValidationError{Name: "counter", err: fmt.Errorf(`ent: validator failed for field "Appointment.counter": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -209,6 +375,80 @@ func (ac *AppointmentCreate) createSpec() (*Appointment, *sqlgraph.CreateSpec) {
 		_spec.SetField(appointment.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := ac.mutation.Status(); ok {
+		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ac.mutation.CancelledAt(); ok {
+		_spec.SetField(appointment.FieldCancelledAt, field.TypeTime, value)
+		_node.CancelledAt = &value
+	}
+	if value, ok := ac.mutation.CancelReason(); ok {
+		_spec.SetField(appointment.FieldCancelReason, field.TypeString, value)
+		_node.CancelReason = value
+	}
+	if value, ok := ac.mutation.ConfirmBy(); ok {
+		_spec.SetField(appointment.FieldConfirmBy, field.TypeTime, value)
+		_node.ConfirmBy = &value
+	}
+	if value, ok := ac.mutation.Counter(); ok {
+		_spec.SetField(appointment.FieldCounter, field.TypeInt, value)
+		_node.Counter = &value
+	}
+	if value, ok := ac.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := ac.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.StaffTable,
+			Columns: []string{appointment.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StaffID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.LocationTable,
+			Columns: []string{appointment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -230,6 +470,7 @@ func (acb *AppointmentCreateBulk) Save(ctx context.Context) ([]*Appointment, err
 	for i := range acb.builders {
 		func(i int, root context.Context) {
 			builder := acb.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*AppointmentMutation)
 				if !ok {
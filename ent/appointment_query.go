@@ -4,8 +4,12 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/location"
 	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/staff"
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -18,10 +22,13 @@ import (
 // AppointmentQuery is the builder for querying Appointment entities.
 type AppointmentQuery struct {
 	config
-	ctx        *QueryContext
-	order      []appointment.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Appointment
+	ctx           *QueryContext
+	order         []appointment.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.Appointment
+	withStaff     *StaffQuery
+	withLocation  *LocationQuery
+	withReminders *ReminderQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +65,72 @@ func (aq *AppointmentQuery) Order(o ...appointment.OrderOption) *AppointmentQuer
 	return aq
 }
 
+// QueryStaff chains the current query on the "staff" edge.
+func (aq *AppointmentQuery) QueryStaff() *StaffQuery {
+	query := (&StaffClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, selector),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointment.StaffTable, appointment.StaffColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLocation chains the current query on the "location" edge.
+func (aq *AppointmentQuery) QueryLocation() *LocationQuery {
+	query := (&LocationClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointment.LocationTable, appointment.LocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReminders chains the current query on the "reminders" edge.
+func (aq *AppointmentQuery) QueryReminders() *ReminderQuery {
+	query := (&ReminderClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, selector),
+			sqlgraph.To(reminder.Table, reminder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, appointment.RemindersTable, appointment.RemindersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Appointment entity from the query.
 // Returns a *NotFoundError when no Appointment was found.
 func (aq *AppointmentQuery) First(ctx context.Context) (*Appointment, error) {
@@ -245,17 +318,53 @@ func (aq *AppointmentQuery) Clone() *AppointmentQuery {
 		return nil
 	}
 	return &AppointmentQuery{
-		config:     aq.config,
-		ctx:        aq.ctx.Clone(),
-		order:      append([]appointment.OrderOption{}, aq.order...),
-		inters:     append([]Interceptor{}, aq.inters...),
-		predicates: append([]predicate.Appointment{}, aq.predicates...),
+		config:        aq.config,
+		ctx:           aq.ctx.Clone(),
+		order:         append([]appointment.OrderOption{}, aq.order...),
+		inters:        append([]Interceptor{}, aq.inters...),
+		predicates:    append([]predicate.Appointment{}, aq.predicates...),
+		withStaff:     aq.withStaff.Clone(),
+		withLocation:  aq.withLocation.Clone(),
+		withReminders: aq.withReminders.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
 	}
 }
 
+// WithStaff tells the query-builder to eager-load the nodes that are connected to
+// the "staff" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AppointmentQuery) WithStaff(opts ...func(*StaffQuery)) *AppointmentQuery {
+	query := (&StaffClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withStaff = query
+	return aq
+}
+
+// WithLocation tells the query-builder to eager-load the nodes that are connected to
+// the "location" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AppointmentQuery) WithLocation(opts ...func(*LocationQuery)) *AppointmentQuery {
+	query := (&LocationClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withLocation = query
+	return aq
+}
+
+// WithReminders tells the query-builder to eager-load the nodes that are connected to
+// the "reminders" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *AppointmentQuery) WithReminders(opts ...func(*ReminderQuery)) *AppointmentQuery {
+	query := (&ReminderClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withReminders = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +441,13 @@ func (aq *AppointmentQuery) prepareQuery(ctx context.Context) error {
 
 func (aq *AppointmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Appointment, error) {
 	var (
-		nodes = []*Appointment{}
-		_spec = aq.querySpec()
+		nodes       = []*Appointment{}
+		_spec       = aq.querySpec()
+		loadedTypes = [3]bool{
+			aq.withStaff != nil,
+			aq.withLocation != nil,
+			aq.withReminders != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Appointment).scanValues(nil, columns)
@@ -341,6 +455,7 @@ func (aq *AppointmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Appointment{config: aq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +467,123 @@ func (aq *AppointmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := aq.withStaff; query != nil {
+		if err := aq.loadStaff(ctx, query, nodes, nil,
+			func(n *Appointment, e *Staff) { n.Edges.Staff = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withLocation; query != nil {
+		if err := aq.loadLocation(ctx, query, nodes, nil,
+			func(n *Appointment, e *Location) { n.Edges.Location = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withReminders; query != nil {
+		if err := aq.loadReminders(ctx, query, nodes,
+			func(n *Appointment) { n.Edges.Reminders = []*Reminder{} },
+			func(n *Appointment, e *Reminder) { n.Edges.Reminders = append(n.Edges.Reminders, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (aq *AppointmentQuery) loadStaff(ctx context.Context, query *StaffQuery, nodes []*Appointment, init func(*Appointment), assign func(*Appointment, *Staff)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Appointment)
+	for i := range nodes {
+		if nodes[i].StaffID == nil {
+			continue
+		}
+		fk := *nodes[i].StaffID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(staff.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "staff_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (aq *AppointmentQuery) loadLocation(ctx context.Context, query *LocationQuery, nodes []*Appointment, init func(*Appointment), assign func(*Appointment, *Location)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Appointment)
+	for i := range nodes {
+		if nodes[i].LocationID == nil {
+			continue
+		}
+		fk := *nodes[i].LocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (aq *AppointmentQuery) loadReminders(ctx context.Context, query *ReminderQuery, nodes []*Appointment, init func(*Appointment), assign func(*Appointment, *Reminder)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Appointment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(reminder.FieldAppointmentID)
+	}
+	query.Where(predicate.Reminder(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(appointment.RemindersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AppointmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "appointment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (aq *AppointmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
 	_spec.Node.Columns = aq.ctx.Fields
@@ -380,6 +609,12 @@ func (aq *AppointmentQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if aq.withStaff != nil {
+			_spec.Node.AddColumnOnce(appointment.FieldStaffID)
+		}
+		if aq.withLocation != nil {
+			_spec.Node.AddColumnOnce(appointment.FieldLocationID)
+		}
 	}
 	if ps := aq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
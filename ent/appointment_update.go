@@ -4,7 +4,10 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/location"
 	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/staff"
 	"context"
 	"errors"
 	"fmt"
@@ -140,13 +143,225 @@ func (au *AppointmentUpdate) SetNillableDescription(s *string) *AppointmentUpdat
 	return au
 }
 
+// SetStatus sets the "status" field.
+func (au *AppointmentUpdate) SetStatus(a appointment.Status) *AppointmentUpdate {
+	au.mutation.SetStatus(a)
+	return au
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableStatus(a *appointment.Status) *AppointmentUpdate {
+	if a != nil {
+		au.SetStatus(*a)
+	}
+	return au
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (au *AppointmentUpdate) SetCancelledAt(t time.Time) *AppointmentUpdate {
+	au.mutation.SetCancelledAt(t)
+	return au
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableCancelledAt(t *time.Time) *AppointmentUpdate {
+	if t != nil {
+		au.SetCancelledAt(*t)
+	}
+	return au
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (au *AppointmentUpdate) ClearCancelledAt() *AppointmentUpdate {
+	au.mutation.ClearCancelledAt()
+	return au
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (au *AppointmentUpdate) SetCancelReason(s string) *AppointmentUpdate {
+	au.mutation.SetCancelReason(s)
+	return au
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableCancelReason(s *string) *AppointmentUpdate {
+	if s != nil {
+		au.SetCancelReason(*s)
+	}
+	return au
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (au *AppointmentUpdate) ClearCancelReason() *AppointmentUpdate {
+	au.mutation.ClearCancelReason()
+	return au
+}
+
+// SetConfirmBy sets the "confirm_by" field.
+func (au *AppointmentUpdate) SetConfirmBy(t time.Time) *AppointmentUpdate {
+	au.mutation.SetConfirmBy(t)
+	return au
+}
+
+// SetNillableConfirmBy sets the "confirm_by" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableConfirmBy(t *time.Time) *AppointmentUpdate {
+	if t != nil {
+		au.SetConfirmBy(*t)
+	}
+	return au
+}
+
+// ClearConfirmBy clears the value of the "confirm_by" field.
+func (au *AppointmentUpdate) ClearConfirmBy() *AppointmentUpdate {
+	au.mutation.ClearConfirmBy()
+	return au
+}
+
+// SetCounter sets the "counter" field.
+func (au *AppointmentUpdate) SetCounter(i int) *AppointmentUpdate {
+	au.mutation.ResetCounter()
+	au.mutation.SetCounter(i)
+	return au
+}
+
+// SetNillableCounter sets the "counter" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableCounter(i *int) *AppointmentUpdate {
+	if i != nil {
+		au.SetCounter(*i)
+	}
+	return au
+}
+
+// AddCounter adds i to the "counter" field.
+func (au *AppointmentUpdate) AddCounter(i int) *AppointmentUpdate {
+	au.mutation.AddCounter(i)
+	return au
+}
+
+// ClearCounter clears the value of the "counter" field.
+func (au *AppointmentUpdate) ClearCounter() *AppointmentUpdate {
+	au.mutation.ClearCounter()
+	return au
+}
+
+// SetStaffID sets the "staff_id" field.
+func (au *AppointmentUpdate) SetStaffID(i int) *AppointmentUpdate {
+	au.mutation.SetStaffID(i)
+	return au
+}
+
+// SetNillableStaffID sets the "staff_id" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableStaffID(i *int) *AppointmentUpdate {
+	if i != nil {
+		au.SetStaffID(*i)
+	}
+	return au
+}
+
+// ClearStaffID clears the value of the "staff_id" field.
+func (au *AppointmentUpdate) ClearStaffID() *AppointmentUpdate {
+	au.mutation.ClearStaffID()
+	return au
+}
+
+// SetLocationID sets the "location_id" field.
+func (au *AppointmentUpdate) SetLocationID(i int) *AppointmentUpdate {
+	au.mutation.SetLocationID(i)
+	return au
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (au *AppointmentUpdate) SetNillableLocationID(i *int) *AppointmentUpdate {
+	if i != nil {
+		au.SetLocationID(*i)
+	}
+	return au
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (au *AppointmentUpdate) ClearLocationID() *AppointmentUpdate {
+	au.mutation.ClearLocationID()
+	return au
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (au *AppointmentUpdate) SetUpdatedAt(t time.Time) *AppointmentUpdate {
+	au.mutation.SetUpdatedAt(t)
+	return au
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (au *AppointmentUpdate) ClearUpdatedAt() *AppointmentUpdate {
+	au.mutation.ClearUpdatedAt()
+	return au
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (au *AppointmentUpdate) SetStaff(s *Staff) *AppointmentUpdate {
+	return au.SetStaffID(s.ID)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (au *AppointmentUpdate) SetLocation(l *Location) *AppointmentUpdate {
+	return au.SetLocationID(l.ID)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (au *AppointmentUpdate) AddReminderIDs(ids ...int) *AppointmentUpdate {
+	au.mutation.AddReminderIDs(ids...)
+	return au
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (au *AppointmentUpdate) AddReminders(r ...*Reminder) *AppointmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return au.AddReminderIDs(ids...)
+}
+
 // Mutation returns the AppointmentMutation object of the builder.
 func (au *AppointmentUpdate) Mutation() *AppointmentMutation {
 	return au.mutation
 }
 
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (au *AppointmentUpdate) ClearStaff() *AppointmentUpdate {
+	au.mutation.ClearStaff()
+	return au
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (au *AppointmentUpdate) ClearLocation() *AppointmentUpdate {
+	au.mutation.ClearLocation()
+	return au
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (au *AppointmentUpdate) ClearReminders() *AppointmentUpdate {
+	au.mutation.ClearReminders()
+	return au
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (au *AppointmentUpdate) RemoveReminderIDs(ids ...int) *AppointmentUpdate {
+	au.mutation.RemoveReminderIDs(ids...)
+	return au
+}
+
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (au *AppointmentUpdate) RemoveReminders(r ...*Reminder) *AppointmentUpdate {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return au.RemoveReminderIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *AppointmentUpdate) Save(ctx context.Context) (int, error) {
+	au.defaults()
 	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
 }
 
@@ -172,6 +387,14 @@ func (au *AppointmentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (au *AppointmentUpdate) defaults() {
+	if _, ok := au.mutation.UpdatedAt(); !ok && !au.mutation.UpdatedAtCleared() {
+		v := appointment.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (au *AppointmentUpdate) check() error {
 	if v, ok := au.mutation.Name(); ok {
@@ -199,6 +422,16 @@ func (au *AppointmentUpdate) check() error {
 			return &ValidationError{Name: "delkey", err: fmt.Errorf(`ent: validator failed for field "Appointment.delkey": %w`, err)}
 		}
 	}
+	if v, ok := au.mutation.Status(); ok {
+		if err := appointment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Appointment.status": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.Counter(); ok {
+		if err := appointment.CounterValidator(v); err != nil {
+			return &ValidationError{Name: "counter", err: fmt.Errorf(`ent: validator failed for field "Appointment.counter": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -238,6 +471,145 @@ func (au *AppointmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Description(); ok {
 		_spec.SetField(appointment.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := au.mutation.Status(); ok {
+		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := au.mutation.CancelledAt(); ok {
+		_spec.SetField(appointment.FieldCancelledAt, field.TypeTime, value)
+	}
+	if au.mutation.CancelledAtCleared() {
+		_spec.ClearField(appointment.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.CancelReason(); ok {
+		_spec.SetField(appointment.FieldCancelReason, field.TypeString, value)
+	}
+	if au.mutation.CancelReasonCleared() {
+		_spec.ClearField(appointment.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := au.mutation.ConfirmBy(); ok {
+		_spec.SetField(appointment.FieldConfirmBy, field.TypeTime, value)
+	}
+	if au.mutation.ConfirmByCleared() {
+		_spec.ClearField(appointment.FieldConfirmBy, field.TypeTime)
+	}
+	if value, ok := au.mutation.Counter(); ok {
+		_spec.SetField(appointment.FieldCounter, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedCounter(); ok {
+		_spec.AddField(appointment.FieldCounter, field.TypeInt, value)
+	}
+	if au.mutation.CounterCleared() {
+		_spec.ClearField(appointment.FieldCounter, field.TypeInt)
+	}
+	if value, ok := au.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if au.mutation.UpdatedAtCleared() {
+		_spec.ClearField(appointment.FieldUpdatedAt, field.TypeTime)
+	}
+	if au.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.StaffTable,
+			Columns: []string{appointment.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.StaffTable,
+			Columns: []string{appointment.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.LocationTable,
+			Columns: []string{appointment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.LocationTable,
+			Columns: []string{appointment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !au.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{appointment.Label}
@@ -370,11 +742,222 @@ func (auo *AppointmentUpdateOne) SetNillableDescription(s *string) *AppointmentU
 	return auo
 }
 
+// SetStatus sets the "status" field.
+func (auo *AppointmentUpdateOne) SetStatus(a appointment.Status) *AppointmentUpdateOne {
+	auo.mutation.SetStatus(a)
+	return auo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableStatus(a *appointment.Status) *AppointmentUpdateOne {
+	if a != nil {
+		auo.SetStatus(*a)
+	}
+	return auo
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (auo *AppointmentUpdateOne) SetCancelledAt(t time.Time) *AppointmentUpdateOne {
+	auo.mutation.SetCancelledAt(t)
+	return auo
+}
+
+// SetNillableCancelledAt sets the "cancelled_at" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableCancelledAt(t *time.Time) *AppointmentUpdateOne {
+	if t != nil {
+		auo.SetCancelledAt(*t)
+	}
+	return auo
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (auo *AppointmentUpdateOne) ClearCancelledAt() *AppointmentUpdateOne {
+	auo.mutation.ClearCancelledAt()
+	return auo
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (auo *AppointmentUpdateOne) SetCancelReason(s string) *AppointmentUpdateOne {
+	auo.mutation.SetCancelReason(s)
+	return auo
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableCancelReason(s *string) *AppointmentUpdateOne {
+	if s != nil {
+		auo.SetCancelReason(*s)
+	}
+	return auo
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (auo *AppointmentUpdateOne) ClearCancelReason() *AppointmentUpdateOne {
+	auo.mutation.ClearCancelReason()
+	return auo
+}
+
+// SetConfirmBy sets the "confirm_by" field.
+func (auo *AppointmentUpdateOne) SetConfirmBy(t time.Time) *AppointmentUpdateOne {
+	auo.mutation.SetConfirmBy(t)
+	return auo
+}
+
+// SetNillableConfirmBy sets the "confirm_by" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableConfirmBy(t *time.Time) *AppointmentUpdateOne {
+	if t != nil {
+		auo.SetConfirmBy(*t)
+	}
+	return auo
+}
+
+// ClearConfirmBy clears the value of the "confirm_by" field.
+func (auo *AppointmentUpdateOne) ClearConfirmBy() *AppointmentUpdateOne {
+	auo.mutation.ClearConfirmBy()
+	return auo
+}
+
+// SetCounter sets the "counter" field.
+func (auo *AppointmentUpdateOne) SetCounter(i int) *AppointmentUpdateOne {
+	auo.mutation.ResetCounter()
+	auo.mutation.SetCounter(i)
+	return auo
+}
+
+// SetNillableCounter sets the "counter" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableCounter(i *int) *AppointmentUpdateOne {
+	if i != nil {
+		auo.SetCounter(*i)
+	}
+	return auo
+}
+
+// AddCounter adds i to the "counter" field.
+func (auo *AppointmentUpdateOne) AddCounter(i int) *AppointmentUpdateOne {
+	auo.mutation.AddCounter(i)
+	return auo
+}
+
+// ClearCounter clears the value of the "counter" field.
+func (auo *AppointmentUpdateOne) ClearCounter() *AppointmentUpdateOne {
+	auo.mutation.ClearCounter()
+	return auo
+}
+
+// SetStaffID sets the "staff_id" field.
+func (auo *AppointmentUpdateOne) SetStaffID(i int) *AppointmentUpdateOne {
+	auo.mutation.SetStaffID(i)
+	return auo
+}
+
+// SetNillableStaffID sets the "staff_id" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableStaffID(i *int) *AppointmentUpdateOne {
+	if i != nil {
+		auo.SetStaffID(*i)
+	}
+	return auo
+}
+
+// ClearStaffID clears the value of the "staff_id" field.
+func (auo *AppointmentUpdateOne) ClearStaffID() *AppointmentUpdateOne {
+	auo.mutation.ClearStaffID()
+	return auo
+}
+
+// SetLocationID sets the "location_id" field.
+func (auo *AppointmentUpdateOne) SetLocationID(i int) *AppointmentUpdateOne {
+	auo.mutation.SetLocationID(i)
+	return auo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (auo *AppointmentUpdateOne) SetNillableLocationID(i *int) *AppointmentUpdateOne {
+	if i != nil {
+		auo.SetLocationID(*i)
+	}
+	return auo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (auo *AppointmentUpdateOne) ClearLocationID() *AppointmentUpdateOne {
+	auo.mutation.ClearLocationID()
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *AppointmentUpdateOne) SetUpdatedAt(t time.Time) *AppointmentUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (auo *AppointmentUpdateOne) ClearUpdatedAt() *AppointmentUpdateOne {
+	auo.mutation.ClearUpdatedAt()
+	return auo
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (auo *AppointmentUpdateOne) SetStaff(s *Staff) *AppointmentUpdateOne {
+	return auo.SetStaffID(s.ID)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (auo *AppointmentUpdateOne) SetLocation(l *Location) *AppointmentUpdateOne {
+	return auo.SetLocationID(l.ID)
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by IDs.
+func (auo *AppointmentUpdateOne) AddReminderIDs(ids ...int) *AppointmentUpdateOne {
+	auo.mutation.AddReminderIDs(ids...)
+	return auo
+}
+
+// AddReminders adds the "reminders" edges to the Reminder entity.
+func (auo *AppointmentUpdateOne) AddReminders(r ...*Reminder) *AppointmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return auo.AddReminderIDs(ids...)
+}
+
 // Mutation returns the AppointmentMutation object of the builder.
 func (auo *AppointmentUpdateOne) Mutation() *AppointmentMutation {
 	return auo.mutation
 }
 
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (auo *AppointmentUpdateOne) ClearStaff() *AppointmentUpdateOne {
+	auo.mutation.ClearStaff()
+	return auo
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (auo *AppointmentUpdateOne) ClearLocation() *AppointmentUpdateOne {
+	auo.mutation.ClearLocation()
+	return auo
+}
+
+// ClearReminders clears all "reminders" edges to the Reminder entity.
+func (auo *AppointmentUpdateOne) ClearReminders() *AppointmentUpdateOne {
+	auo.mutation.ClearReminders()
+	return auo
+}
+
+// RemoveReminderIDs removes the "reminders" edge to Reminder entities by IDs.
+func (auo *AppointmentUpdateOne) RemoveReminderIDs(ids ...int) *AppointmentUpdateOne {
+	auo.mutation.RemoveReminderIDs(ids...)
+	return auo
+}
+
+// RemoveReminders removes "reminders" edges to Reminder entities.
+func (auo *AppointmentUpdateOne) RemoveReminders(r ...*Reminder) *AppointmentUpdateOne {
+	ids := make([]int, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return auo.RemoveReminderIDs(ids...)
+}
+
 // Where appends a list predicates to the AppointmentUpdate builder.
 func (auo *AppointmentUpdateOne) Where(ps ...predicate.Appointment) *AppointmentUpdateOne {
 	auo.mutation.Where(ps...)
@@ -390,6 +973,7 @@ func (auo *AppointmentUpdateOne) Select(field string, fields ...string) *Appoint
 
 // Save executes the query and returns the updated Appointment entity.
 func (auo *AppointmentUpdateOne) Save(ctx context.Context) (*Appointment, error) {
+	auo.defaults()
 	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
 }
 
@@ -415,6 +999,14 @@ func (auo *AppointmentUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (auo *AppointmentUpdateOne) defaults() {
+	if _, ok := auo.mutation.UpdatedAt(); !ok && !auo.mutation.UpdatedAtCleared() {
+		v := appointment.UpdateDefaultUpdatedAt()
+		auo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (auo *AppointmentUpdateOne) check() error {
 	if v, ok := auo.mutation.Name(); ok {
@@ -442,6 +1034,16 @@ func (auo *AppointmentUpdateOne) check() error {
 			return &ValidationError{Name: "delkey", err: fmt.Errorf(`ent: validator failed for field "Appointment.delkey": %w`, err)}
 		}
 	}
+	if v, ok := auo.mutation.Status(); ok {
+		if err := appointment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Appointment.status": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.Counter(); ok {
+		if err := appointment.CounterValidator(v); err != nil {
+			return &ValidationError{Name: "counter", err: fmt.Errorf(`ent: validator failed for field "Appointment.counter": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -498,6 +1100,145 @@ func (auo *AppointmentUpdateOne) sqlSave(ctx context.Context) (_node *Appointmen
 	if value, ok := auo.mutation.Description(); ok {
 		_spec.SetField(appointment.FieldDescription, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.Status(); ok {
+		_spec.SetField(appointment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := auo.mutation.CancelledAt(); ok {
+		_spec.SetField(appointment.FieldCancelledAt, field.TypeTime, value)
+	}
+	if auo.mutation.CancelledAtCleared() {
+		_spec.ClearField(appointment.FieldCancelledAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.CancelReason(); ok {
+		_spec.SetField(appointment.FieldCancelReason, field.TypeString, value)
+	}
+	if auo.mutation.CancelReasonCleared() {
+		_spec.ClearField(appointment.FieldCancelReason, field.TypeString)
+	}
+	if value, ok := auo.mutation.ConfirmBy(); ok {
+		_spec.SetField(appointment.FieldConfirmBy, field.TypeTime, value)
+	}
+	if auo.mutation.ConfirmByCleared() {
+		_spec.ClearField(appointment.FieldConfirmBy, field.TypeTime)
+	}
+	if value, ok := auo.mutation.Counter(); ok {
+		_spec.SetField(appointment.FieldCounter, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedCounter(); ok {
+		_spec.AddField(appointment.FieldCounter, field.TypeInt, value)
+	}
+	if auo.mutation.CounterCleared() {
+		_spec.ClearField(appointment.FieldCounter, field.TypeInt)
+	}
+	if value, ok := auo.mutation.UpdatedAt(); ok {
+		_spec.SetField(appointment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if auo.mutation.UpdatedAtCleared() {
+		_spec.ClearField(appointment.FieldUpdatedAt, field.TypeTime)
+	}
+	if auo.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.StaffTable,
+			Columns: []string{appointment.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.StaffTable,
+			Columns: []string{appointment.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.LocationTable,
+			Columns: []string{appointment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   appointment.LocationTable,
+			Columns: []string{appointment.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedRemindersIDs(); len(nodes) > 0 && !auo.mutation.RemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   appointment.RemindersTable,
+			Columns: []string{appointment.RemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Appointment{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/location"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BlockedTime is the model entity for the BlockedTime schema.
+type BlockedTime struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UID holds the value of the "uid" field.
+	UID string `json:"uid,omitempty"`
+	// Summary holds the value of the "summary" field.
+	Summary string `json:"summary,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime time.Time `json:"start_time,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime time.Time `json:"end_time,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlockedTimeQuery when eager-loading is set.
+	Edges        BlockedTimeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlockedTimeEdges holds the relations/edges for other nodes in the graph.
+type BlockedTimeEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlockedTimeEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlockedTime) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blockedtime.FieldID, blockedtime.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case blockedtime.FieldName, blockedtime.FieldUID, blockedtime.FieldSummary:
+			values[i] = new(sql.NullString)
+		case blockedtime.FieldStartTime, blockedtime.FieldEndTime, blockedtime.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlockedTime fields.
+func (bt *BlockedTime) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blockedtime.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			bt.ID = int(value.Int64)
+		case blockedtime.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				bt.Name = value.String
+			}
+		case blockedtime.FieldUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uid", values[i])
+			} else if value.Valid {
+				bt.UID = value.String
+			}
+		case blockedtime.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				bt.Summary = value.String
+			}
+		case blockedtime.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				bt.StartTime = value.Time
+			}
+		case blockedtime.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				bt.EndTime = value.Time
+			}
+		case blockedtime.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				bt.LocationID = new(int)
+				*bt.LocationID = int(value.Int64)
+			}
+		case blockedtime.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				bt.UpdatedAt = value.Time
+			}
+		default:
+			bt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlockedTime.
+// This includes values selected through modifiers, order, etc.
+func (bt *BlockedTime) Value(name string) (ent.Value, error) {
+	return bt.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the BlockedTime entity.
+func (bt *BlockedTime) QueryLocation() *LocationQuery {
+	return NewBlockedTimeClient(bt.config).QueryLocation(bt)
+}
+
+// Update returns a builder for updating this BlockedTime.
+// Note that you need to call BlockedTime.Unwrap() before calling this method if this BlockedTime
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (bt *BlockedTime) Update() *BlockedTimeUpdateOne {
+	return NewBlockedTimeClient(bt.config).UpdateOne(bt)
+}
+
+// Unwrap unwraps the BlockedTime entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (bt *BlockedTime) Unwrap() *BlockedTime {
+	_tx, ok := bt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlockedTime is not a transactional entity")
+	}
+	bt.config.driver = _tx.drv
+	return bt
+}
+
+// String implements the fmt.Stringer.
+func (bt *BlockedTime) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlockedTime(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", bt.ID))
+	builder.WriteString("name=")
+	builder.WriteString(bt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("uid=")
+	builder.WriteString(bt.UID)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(bt.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(bt.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(bt.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := bt.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(bt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlockedTimes is a parsable slice of BlockedTime.
+type BlockedTimes []*BlockedTime
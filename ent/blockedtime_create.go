@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/location"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlockedTimeCreate is the builder for creating a BlockedTime entity.
+type BlockedTimeCreate struct {
+	config
+	mutation *BlockedTimeMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (btc *BlockedTimeCreate) SetName(s string) *BlockedTimeCreate {
+	btc.mutation.SetName(s)
+	return btc
+}
+
+// SetUID sets the "uid" field.
+func (btc *BlockedTimeCreate) SetUID(s string) *BlockedTimeCreate {
+	btc.mutation.SetUID(s)
+	return btc
+}
+
+// SetSummary sets the "summary" field.
+func (btc *BlockedTimeCreate) SetSummary(s string) *BlockedTimeCreate {
+	btc.mutation.SetSummary(s)
+	return btc
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (btc *BlockedTimeCreate) SetNillableSummary(s *string) *BlockedTimeCreate {
+	if s != nil {
+		btc.SetSummary(*s)
+	}
+	return btc
+}
+
+// SetStartTime sets the "start_time" field.
+func (btc *BlockedTimeCreate) SetStartTime(t time.Time) *BlockedTimeCreate {
+	btc.mutation.SetStartTime(t)
+	return btc
+}
+
+// SetEndTime sets the "end_time" field.
+func (btc *BlockedTimeCreate) SetEndTime(t time.Time) *BlockedTimeCreate {
+	btc.mutation.SetEndTime(t)
+	return btc
+}
+
+// SetLocationID sets the "location_id" field.
+func (btc *BlockedTimeCreate) SetLocationID(i int) *BlockedTimeCreate {
+	btc.mutation.SetLocationID(i)
+	return btc
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (btc *BlockedTimeCreate) SetNillableLocationID(i *int) *BlockedTimeCreate {
+	if i != nil {
+		btc.SetLocationID(*i)
+	}
+	return btc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (btc *BlockedTimeCreate) SetUpdatedAt(t time.Time) *BlockedTimeCreate {
+	btc.mutation.SetUpdatedAt(t)
+	return btc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (btc *BlockedTimeCreate) SetNillableUpdatedAt(t *time.Time) *BlockedTimeCreate {
+	if t != nil {
+		btc.SetUpdatedAt(*t)
+	}
+	return btc
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (btc *BlockedTimeCreate) SetLocation(l *Location) *BlockedTimeCreate {
+	return btc.SetLocationID(l.ID)
+}
+
+// Mutation returns the BlockedTimeMutation object of the builder.
+func (btc *BlockedTimeCreate) Mutation() *BlockedTimeMutation {
+	return btc.mutation
+}
+
+// Save creates the BlockedTime in the database.
+func (btc *BlockedTimeCreate) Save(ctx context.Context) (*BlockedTime, error) {
+	btc.defaults()
+	return withHooks(ctx, btc.sqlSave, btc.mutation, btc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (btc *BlockedTimeCreate) SaveX(ctx context.Context) *BlockedTime {
+	v, err := btc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (btc *BlockedTimeCreate) Exec(ctx context.Context) error {
+	_, err := btc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btc *BlockedTimeCreate) ExecX(ctx context.Context) {
+	if err := btc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btc *BlockedTimeCreate) defaults() {
+	if _, ok := btc.mutation.UpdatedAt(); !ok {
+		v := blockedtime.DefaultUpdatedAt()
+		btc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btc *BlockedTimeCreate) check() error {
+	if _, ok := btc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "BlockedTime.name"`)}
+	}
+	if v, ok := btc.mutation.Name(); ok {
+		if err := blockedtime.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlockedTime.name": %w`, err)}
+		}
+	}
+	if _, ok := btc.mutation.UID(); !ok {
+		return &ValidationError{Name: "uid", err: errors.New(`ent: missing required field "BlockedTime.uid"`)}
+	}
+	if v, ok := btc.mutation.UID(); ok {
+		if err := blockedtime.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "BlockedTime.uid": %w`, err)}
+		}
+	}
+	if _, ok := btc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "BlockedTime.start_time"`)}
+	}
+	if _, ok := btc.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "BlockedTime.end_time"`)}
+	}
+	if _, ok := btc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BlockedTime.updated_at"`)}
+	}
+	return nil
+}
+
+func (btc *BlockedTimeCreate) sqlSave(ctx context.Context) (*BlockedTime, error) {
+	if err := btc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := btc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, btc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	btc.mutation.id = &_node.ID
+	btc.mutation.done = true
+	return _node, nil
+}
+
+func (btc *BlockedTimeCreate) createSpec() (*BlockedTime, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlockedTime{config: btc.config}
+		_spec = sqlgraph.NewCreateSpec(blockedtime.Table, sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt))
+	)
+	if value, ok := btc.mutation.Name(); ok {
+		_spec.SetField(blockedtime.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := btc.mutation.UID(); ok {
+		_spec.SetField(blockedtime.FieldUID, field.TypeString, value)
+		_node.UID = value
+	}
+	if value, ok := btc.mutation.Summary(); ok {
+		_spec.SetField(blockedtime.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := btc.mutation.StartTime(); ok {
+		_spec.SetField(blockedtime.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := btc.mutation.EndTime(); ok {
+		_spec.SetField(blockedtime.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := btc.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockedtime.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := btc.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockedtime.LocationTable,
+			Columns: []string{blockedtime.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlockedTimeCreateBulk is the builder for creating many BlockedTime entities in bulk.
+type BlockedTimeCreateBulk struct {
+	config
+	err      error
+	builders []*BlockedTimeCreate
+}
+
+// Save creates the BlockedTime entities in the database.
+func (btcb *BlockedTimeCreateBulk) Save(ctx context.Context) ([]*BlockedTime, error) {
+	if btcb.err != nil {
+		return nil, btcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(btcb.builders))
+	nodes := make([]*BlockedTime, len(btcb.builders))
+	mutators := make([]Mutator, len(btcb.builders))
+	for i := range btcb.builders {
+		func(i int, root context.Context) {
+			builder := btcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlockedTimeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, btcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, btcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, btcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btcb *BlockedTimeCreateBulk) SaveX(ctx context.Context) []*BlockedTime {
+	v, err := btcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (btcb *BlockedTimeCreateBulk) Exec(ctx context.Context) error {
+	_, err := btcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btcb *BlockedTimeCreateBulk) ExecX(ctx context.Context) {
+	if err := btcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlockedTimeDelete is the builder for deleting a BlockedTime entity.
+type BlockedTimeDelete struct {
+	config
+	hooks    []Hook
+	mutation *BlockedTimeMutation
+}
+
+// Where appends a list predicates to the BlockedTimeDelete builder.
+func (btd *BlockedTimeDelete) Where(ps ...predicate.BlockedTime) *BlockedTimeDelete {
+	btd.mutation.Where(ps...)
+	return btd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (btd *BlockedTimeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, btd.sqlExec, btd.mutation, btd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btd *BlockedTimeDelete) ExecX(ctx context.Context) int {
+	n, err := btd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (btd *BlockedTimeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(blockedtime.Table, sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt))
+	if ps := btd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, btd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	btd.mutation.done = true
+	return affected, err
+}
+
+// BlockedTimeDeleteOne is the builder for deleting a single BlockedTime entity.
+type BlockedTimeDeleteOne struct {
+	btd *BlockedTimeDelete
+}
+
+// Where appends a list predicates to the BlockedTimeDelete builder.
+func (btdo *BlockedTimeDeleteOne) Where(ps ...predicate.BlockedTime) *BlockedTimeDeleteOne {
+	btdo.btd.mutation.Where(ps...)
+	return btdo
+}
+
+// Exec executes the deletion query.
+func (btdo *BlockedTimeDeleteOne) Exec(ctx context.Context) error {
+	n, err := btdo.btd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{blockedtime.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btdo *BlockedTimeDeleteOne) ExecX(ctx context.Context) {
+	if err := btdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlockedTimeQuery is the builder for querying BlockedTime entities.
+type BlockedTimeQuery struct {
+	config
+	ctx          *QueryContext
+	order        []blockedtime.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.BlockedTime
+	withLocation *LocationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BlockedTimeQuery builder.
+func (btq *BlockedTimeQuery) Where(ps ...predicate.BlockedTime) *BlockedTimeQuery {
+	btq.predicates = append(btq.predicates, ps...)
+	return btq
+}
+
+// Limit the number of records to be returned by this query.
+func (btq *BlockedTimeQuery) Limit(limit int) *BlockedTimeQuery {
+	btq.ctx.Limit = &limit
+	return btq
+}
+
+// Offset to start from.
+func (btq *BlockedTimeQuery) Offset(offset int) *BlockedTimeQuery {
+	btq.ctx.Offset = &offset
+	return btq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (btq *BlockedTimeQuery) Unique(unique bool) *BlockedTimeQuery {
+	btq.ctx.Unique = &unique
+	return btq
+}
+
+// Order specifies how the records should be ordered.
+func (btq *BlockedTimeQuery) Order(o ...blockedtime.OrderOption) *BlockedTimeQuery {
+	btq.order = append(btq.order, o...)
+	return btq
+}
+
+// QueryLocation chains the current query on the "location" edge.
+func (btq *BlockedTimeQuery) QueryLocation() *LocationQuery {
+	query := (&LocationClient{config: btq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := btq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := btq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blockedtime.Table, blockedtime.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blockedtime.LocationTable, blockedtime.LocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(btq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first BlockedTime entity from the query.
+// Returns a *NotFoundError when no BlockedTime was found.
+func (btq *BlockedTimeQuery) First(ctx context.Context) (*BlockedTime, error) {
+	nodes, err := btq.Limit(1).All(setContextOp(ctx, btq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{blockedtime.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (btq *BlockedTimeQuery) FirstX(ctx context.Context) *BlockedTime {
+	node, err := btq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BlockedTime ID from the query.
+// Returns a *NotFoundError when no BlockedTime ID was found.
+func (btq *BlockedTimeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = btq.Limit(1).IDs(setContextOp(ctx, btq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{blockedtime.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (btq *BlockedTimeQuery) FirstIDX(ctx context.Context) int {
+	id, err := btq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BlockedTime entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BlockedTime entity is found.
+// Returns a *NotFoundError when no BlockedTime entities are found.
+func (btq *BlockedTimeQuery) Only(ctx context.Context) (*BlockedTime, error) {
+	nodes, err := btq.Limit(2).All(setContextOp(ctx, btq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{blockedtime.Label}
+	default:
+		return nil, &NotSingularError{blockedtime.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (btq *BlockedTimeQuery) OnlyX(ctx context.Context) *BlockedTime {
+	node, err := btq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BlockedTime ID in the query.
+// Returns a *NotSingularError when more than one BlockedTime ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (btq *BlockedTimeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = btq.Limit(2).IDs(setContextOp(ctx, btq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{blockedtime.Label}
+	default:
+		err = &NotSingularError{blockedtime.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (btq *BlockedTimeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := btq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BlockedTimes.
+func (btq *BlockedTimeQuery) All(ctx context.Context) ([]*BlockedTime, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryAll)
+	if err := btq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BlockedTime, *BlockedTimeQuery]()
+	return withInterceptors[[]*BlockedTime](ctx, btq, qr, btq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (btq *BlockedTimeQuery) AllX(ctx context.Context) []*BlockedTime {
+	nodes, err := btq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BlockedTime IDs.
+func (btq *BlockedTimeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if btq.ctx.Unique == nil && btq.path != nil {
+		btq.Unique(true)
+	}
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryIDs)
+	if err = btq.Select(blockedtime.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (btq *BlockedTimeQuery) IDsX(ctx context.Context) []int {
+	ids, err := btq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (btq *BlockedTimeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryCount)
+	if err := btq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, btq, querierCount[*BlockedTimeQuery](), btq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (btq *BlockedTimeQuery) CountX(ctx context.Context) int {
+	count, err := btq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (btq *BlockedTimeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, btq.ctx, ent.OpQueryExist)
+	switch _, err := btq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (btq *BlockedTimeQuery) ExistX(ctx context.Context) bool {
+	exist, err := btq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BlockedTimeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (btq *BlockedTimeQuery) Clone() *BlockedTimeQuery {
+	if btq == nil {
+		return nil
+	}
+	return &BlockedTimeQuery{
+		config:       btq.config,
+		ctx:          btq.ctx.Clone(),
+		order:        append([]blockedtime.OrderOption{}, btq.order...),
+		inters:       append([]Interceptor{}, btq.inters...),
+		predicates:   append([]predicate.BlockedTime{}, btq.predicates...),
+		withLocation: btq.withLocation.Clone(),
+		// clone intermediate query.
+		sql:  btq.sql.Clone(),
+		path: btq.path,
+	}
+}
+
+// WithLocation tells the query-builder to eager-load the nodes that are connected to
+// the "location" edge. The optional arguments are used to configure the query builder of the edge.
+func (btq *BlockedTimeQuery) WithLocation(opts ...func(*LocationQuery)) *BlockedTimeQuery {
+	query := (&LocationClient{config: btq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	btq.withLocation = query
+	return btq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BlockedTime.Query().
+//		GroupBy(blockedtime.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (btq *BlockedTimeQuery) GroupBy(field string, fields ...string) *BlockedTimeGroupBy {
+	btq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BlockedTimeGroupBy{build: btq}
+	grbuild.flds = &btq.ctx.Fields
+	grbuild.label = blockedtime.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.BlockedTime.Query().
+//		Select(blockedtime.FieldName).
+//		Scan(ctx, &v)
+func (btq *BlockedTimeQuery) Select(fields ...string) *BlockedTimeSelect {
+	btq.ctx.Fields = append(btq.ctx.Fields, fields...)
+	sbuild := &BlockedTimeSelect{BlockedTimeQuery: btq}
+	sbuild.label = blockedtime.Label
+	sbuild.flds, sbuild.scan = &btq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BlockedTimeSelect configured with the given aggregations.
+func (btq *BlockedTimeQuery) Aggregate(fns ...AggregateFunc) *BlockedTimeSelect {
+	return btq.Select().Aggregate(fns...)
+}
+
+func (btq *BlockedTimeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range btq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, btq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range btq.ctx.Fields {
+		if !blockedtime.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if btq.path != nil {
+		prev, err := btq.path(ctx)
+		if err != nil {
+			return err
+		}
+		btq.sql = prev
+	}
+	return nil
+}
+
+func (btq *BlockedTimeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BlockedTime, error) {
+	var (
+		nodes       = []*BlockedTime{}
+		_spec       = btq.querySpec()
+		loadedTypes = [1]bool{
+			btq.withLocation != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BlockedTime).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BlockedTime{config: btq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, btq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := btq.withLocation; query != nil {
+		if err := btq.loadLocation(ctx, query, nodes, nil,
+			func(n *BlockedTime, e *Location) { n.Edges.Location = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (btq *BlockedTimeQuery) loadLocation(ctx context.Context, query *LocationQuery, nodes []*BlockedTime, init func(*BlockedTime), assign func(*BlockedTime, *Location)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*BlockedTime)
+	for i := range nodes {
+		if nodes[i].LocationID == nil {
+			continue
+		}
+		fk := *nodes[i].LocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (btq *BlockedTimeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := btq.querySpec()
+	_spec.Node.Columns = btq.ctx.Fields
+	if len(btq.ctx.Fields) > 0 {
+		_spec.Unique = btq.ctx.Unique != nil && *btq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, btq.driver, _spec)
+}
+
+func (btq *BlockedTimeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(blockedtime.Table, blockedtime.Columns, sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt))
+	_spec.From = btq.sql
+	if unique := btq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if btq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := btq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockedtime.FieldID)
+		for i := range fields {
+			if fields[i] != blockedtime.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if btq.withLocation != nil {
+			_spec.Node.AddColumnOnce(blockedtime.FieldLocationID)
+		}
+	}
+	if ps := btq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := btq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := btq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := btq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (btq *BlockedTimeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(btq.driver.Dialect())
+	t1 := builder.Table(blockedtime.Table)
+	columns := btq.ctx.Fields
+	if len(columns) == 0 {
+		columns = blockedtime.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if btq.sql != nil {
+		selector = btq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if btq.ctx.Unique != nil && *btq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range btq.predicates {
+		p(selector)
+	}
+	for _, p := range btq.order {
+		p(selector)
+	}
+	if offset := btq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := btq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BlockedTimeGroupBy is the group-by builder for BlockedTime entities.
+type BlockedTimeGroupBy struct {
+	selector
+	build *BlockedTimeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (btgb *BlockedTimeGroupBy) Aggregate(fns ...AggregateFunc) *BlockedTimeGroupBy {
+	btgb.fns = append(btgb.fns, fns...)
+	return btgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (btgb *BlockedTimeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, btgb.build.ctx, ent.OpQueryGroupBy)
+	if err := btgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockedTimeQuery, *BlockedTimeGroupBy](ctx, btgb.build, btgb, btgb.build.inters, v)
+}
+
+func (btgb *BlockedTimeGroupBy) sqlScan(ctx context.Context, root *BlockedTimeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(btgb.fns))
+	for _, fn := range btgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*btgb.flds)+len(btgb.fns))
+		for _, f := range *btgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*btgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := btgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BlockedTimeSelect is the builder for selecting fields of BlockedTime entities.
+type BlockedTimeSelect struct {
+	*BlockedTimeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bts *BlockedTimeSelect) Aggregate(fns ...AggregateFunc) *BlockedTimeSelect {
+	bts.fns = append(bts.fns, fns...)
+	return bts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bts *BlockedTimeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bts.ctx, ent.OpQuerySelect)
+	if err := bts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BlockedTimeQuery, *BlockedTimeSelect](ctx, bts.BlockedTimeQuery, bts, bts.inters, v)
+}
+
+func (bts *BlockedTimeSelect) sqlScan(ctx context.Context, root *BlockedTimeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bts.fns))
+	for _, fn := range bts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
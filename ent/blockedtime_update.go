@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlockedTimeUpdate is the builder for updating BlockedTime entities.
+type BlockedTimeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlockedTimeMutation
+}
+
+// Where appends a list predicates to the BlockedTimeUpdate builder.
+func (btu *BlockedTimeUpdate) Where(ps ...predicate.BlockedTime) *BlockedTimeUpdate {
+	btu.mutation.Where(ps...)
+	return btu
+}
+
+// SetName sets the "name" field.
+func (btu *BlockedTimeUpdate) SetName(s string) *BlockedTimeUpdate {
+	btu.mutation.SetName(s)
+	return btu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (btu *BlockedTimeUpdate) SetNillableName(s *string) *BlockedTimeUpdate {
+	if s != nil {
+		btu.SetName(*s)
+	}
+	return btu
+}
+
+// SetUID sets the "uid" field.
+func (btu *BlockedTimeUpdate) SetUID(s string) *BlockedTimeUpdate {
+	btu.mutation.SetUID(s)
+	return btu
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (btu *BlockedTimeUpdate) SetNillableUID(s *string) *BlockedTimeUpdate {
+	if s != nil {
+		btu.SetUID(*s)
+	}
+	return btu
+}
+
+// SetSummary sets the "summary" field.
+func (btu *BlockedTimeUpdate) SetSummary(s string) *BlockedTimeUpdate {
+	btu.mutation.SetSummary(s)
+	return btu
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (btu *BlockedTimeUpdate) SetNillableSummary(s *string) *BlockedTimeUpdate {
+	if s != nil {
+		btu.SetSummary(*s)
+	}
+	return btu
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (btu *BlockedTimeUpdate) ClearSummary() *BlockedTimeUpdate {
+	btu.mutation.ClearSummary()
+	return btu
+}
+
+// SetStartTime sets the "start_time" field.
+func (btu *BlockedTimeUpdate) SetStartTime(t time.Time) *BlockedTimeUpdate {
+	btu.mutation.SetStartTime(t)
+	return btu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (btu *BlockedTimeUpdate) SetNillableStartTime(t *time.Time) *BlockedTimeUpdate {
+	if t != nil {
+		btu.SetStartTime(*t)
+	}
+	return btu
+}
+
+// SetEndTime sets the "end_time" field.
+func (btu *BlockedTimeUpdate) SetEndTime(t time.Time) *BlockedTimeUpdate {
+	btu.mutation.SetEndTime(t)
+	return btu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (btu *BlockedTimeUpdate) SetNillableEndTime(t *time.Time) *BlockedTimeUpdate {
+	if t != nil {
+		btu.SetEndTime(*t)
+	}
+	return btu
+}
+
+// SetLocationID sets the "location_id" field.
+func (btu *BlockedTimeUpdate) SetLocationID(i int) *BlockedTimeUpdate {
+	btu.mutation.SetLocationID(i)
+	return btu
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (btu *BlockedTimeUpdate) SetNillableLocationID(i *int) *BlockedTimeUpdate {
+	if i != nil {
+		btu.SetLocationID(*i)
+	}
+	return btu
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (btu *BlockedTimeUpdate) ClearLocationID() *BlockedTimeUpdate {
+	btu.mutation.ClearLocationID()
+	return btu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (btu *BlockedTimeUpdate) SetUpdatedAt(t time.Time) *BlockedTimeUpdate {
+	btu.mutation.SetUpdatedAt(t)
+	return btu
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (btu *BlockedTimeUpdate) SetLocation(l *Location) *BlockedTimeUpdate {
+	return btu.SetLocationID(l.ID)
+}
+
+// Mutation returns the BlockedTimeMutation object of the builder.
+func (btu *BlockedTimeUpdate) Mutation() *BlockedTimeMutation {
+	return btu.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (btu *BlockedTimeUpdate) ClearLocation() *BlockedTimeUpdate {
+	btu.mutation.ClearLocation()
+	return btu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (btu *BlockedTimeUpdate) Save(ctx context.Context) (int, error) {
+	btu.defaults()
+	return withHooks(ctx, btu.sqlSave, btu.mutation, btu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btu *BlockedTimeUpdate) SaveX(ctx context.Context) int {
+	affected, err := btu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (btu *BlockedTimeUpdate) Exec(ctx context.Context) error {
+	_, err := btu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btu *BlockedTimeUpdate) ExecX(ctx context.Context) {
+	if err := btu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btu *BlockedTimeUpdate) defaults() {
+	if _, ok := btu.mutation.UpdatedAt(); !ok {
+		v := blockedtime.UpdateDefaultUpdatedAt()
+		btu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btu *BlockedTimeUpdate) check() error {
+	if v, ok := btu.mutation.Name(); ok {
+		if err := blockedtime.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlockedTime.name": %w`, err)}
+		}
+	}
+	if v, ok := btu.mutation.UID(); ok {
+		if err := blockedtime.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "BlockedTime.uid": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (btu *BlockedTimeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := btu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockedtime.Table, blockedtime.Columns, sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt))
+	if ps := btu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := btu.mutation.Name(); ok {
+		_spec.SetField(blockedtime.FieldName, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.UID(); ok {
+		_spec.SetField(blockedtime.FieldUID, field.TypeString, value)
+	}
+	if value, ok := btu.mutation.Summary(); ok {
+		_spec.SetField(blockedtime.FieldSummary, field.TypeString, value)
+	}
+	if btu.mutation.SummaryCleared() {
+		_spec.ClearField(blockedtime.FieldSummary, field.TypeString)
+	}
+	if value, ok := btu.mutation.StartTime(); ok {
+		_spec.SetField(blockedtime.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := btu.mutation.EndTime(); ok {
+		_spec.SetField(blockedtime.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := btu.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockedtime.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if btu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockedtime.LocationTable,
+			Columns: []string{blockedtime.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockedtime.LocationTable,
+			Columns: []string{blockedtime.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, btu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockedtime.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	btu.mutation.done = true
+	return n, nil
+}
+
+// BlockedTimeUpdateOne is the builder for updating a single BlockedTime entity.
+type BlockedTimeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlockedTimeMutation
+}
+
+// SetName sets the "name" field.
+func (btuo *BlockedTimeUpdateOne) SetName(s string) *BlockedTimeUpdateOne {
+	btuo.mutation.SetName(s)
+	return btuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (btuo *BlockedTimeUpdateOne) SetNillableName(s *string) *BlockedTimeUpdateOne {
+	if s != nil {
+		btuo.SetName(*s)
+	}
+	return btuo
+}
+
+// SetUID sets the "uid" field.
+func (btuo *BlockedTimeUpdateOne) SetUID(s string) *BlockedTimeUpdateOne {
+	btuo.mutation.SetUID(s)
+	return btuo
+}
+
+// SetNillableUID sets the "uid" field if the given value is not nil.
+func (btuo *BlockedTimeUpdateOne) SetNillableUID(s *string) *BlockedTimeUpdateOne {
+	if s != nil {
+		btuo.SetUID(*s)
+	}
+	return btuo
+}
+
+// SetSummary sets the "summary" field.
+func (btuo *BlockedTimeUpdateOne) SetSummary(s string) *BlockedTimeUpdateOne {
+	btuo.mutation.SetSummary(s)
+	return btuo
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (btuo *BlockedTimeUpdateOne) SetNillableSummary(s *string) *BlockedTimeUpdateOne {
+	if s != nil {
+		btuo.SetSummary(*s)
+	}
+	return btuo
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (btuo *BlockedTimeUpdateOne) ClearSummary() *BlockedTimeUpdateOne {
+	btuo.mutation.ClearSummary()
+	return btuo
+}
+
+// SetStartTime sets the "start_time" field.
+func (btuo *BlockedTimeUpdateOne) SetStartTime(t time.Time) *BlockedTimeUpdateOne {
+	btuo.mutation.SetStartTime(t)
+	return btuo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (btuo *BlockedTimeUpdateOne) SetNillableStartTime(t *time.Time) *BlockedTimeUpdateOne {
+	if t != nil {
+		btuo.SetStartTime(*t)
+	}
+	return btuo
+}
+
+// SetEndTime sets the "end_time" field.
+func (btuo *BlockedTimeUpdateOne) SetEndTime(t time.Time) *BlockedTimeUpdateOne {
+	btuo.mutation.SetEndTime(t)
+	return btuo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (btuo *BlockedTimeUpdateOne) SetNillableEndTime(t *time.Time) *BlockedTimeUpdateOne {
+	if t != nil {
+		btuo.SetEndTime(*t)
+	}
+	return btuo
+}
+
+// SetLocationID sets the "location_id" field.
+func (btuo *BlockedTimeUpdateOne) SetLocationID(i int) *BlockedTimeUpdateOne {
+	btuo.mutation.SetLocationID(i)
+	return btuo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (btuo *BlockedTimeUpdateOne) SetNillableLocationID(i *int) *BlockedTimeUpdateOne {
+	if i != nil {
+		btuo.SetLocationID(*i)
+	}
+	return btuo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (btuo *BlockedTimeUpdateOne) ClearLocationID() *BlockedTimeUpdateOne {
+	btuo.mutation.ClearLocationID()
+	return btuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (btuo *BlockedTimeUpdateOne) SetUpdatedAt(t time.Time) *BlockedTimeUpdateOne {
+	btuo.mutation.SetUpdatedAt(t)
+	return btuo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (btuo *BlockedTimeUpdateOne) SetLocation(l *Location) *BlockedTimeUpdateOne {
+	return btuo.SetLocationID(l.ID)
+}
+
+// Mutation returns the BlockedTimeMutation object of the builder.
+func (btuo *BlockedTimeUpdateOne) Mutation() *BlockedTimeMutation {
+	return btuo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (btuo *BlockedTimeUpdateOne) ClearLocation() *BlockedTimeUpdateOne {
+	btuo.mutation.ClearLocation()
+	return btuo
+}
+
+// Where appends a list predicates to the BlockedTimeUpdate builder.
+func (btuo *BlockedTimeUpdateOne) Where(ps ...predicate.BlockedTime) *BlockedTimeUpdateOne {
+	btuo.mutation.Where(ps...)
+	return btuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (btuo *BlockedTimeUpdateOne) Select(field string, fields ...string) *BlockedTimeUpdateOne {
+	btuo.fields = append([]string{field}, fields...)
+	return btuo
+}
+
+// Save executes the query and returns the updated BlockedTime entity.
+func (btuo *BlockedTimeUpdateOne) Save(ctx context.Context) (*BlockedTime, error) {
+	btuo.defaults()
+	return withHooks(ctx, btuo.sqlSave, btuo.mutation, btuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (btuo *BlockedTimeUpdateOne) SaveX(ctx context.Context) *BlockedTime {
+	node, err := btuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (btuo *BlockedTimeUpdateOne) Exec(ctx context.Context) error {
+	_, err := btuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (btuo *BlockedTimeUpdateOne) ExecX(ctx context.Context) {
+	if err := btuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (btuo *BlockedTimeUpdateOne) defaults() {
+	if _, ok := btuo.mutation.UpdatedAt(); !ok {
+		v := blockedtime.UpdateDefaultUpdatedAt()
+		btuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (btuo *BlockedTimeUpdateOne) check() error {
+	if v, ok := btuo.mutation.Name(); ok {
+		if err := blockedtime.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "BlockedTime.name": %w`, err)}
+		}
+	}
+	if v, ok := btuo.mutation.UID(); ok {
+		if err := blockedtime.UIDValidator(v); err != nil {
+			return &ValidationError{Name: "uid", err: fmt.Errorf(`ent: validator failed for field "BlockedTime.uid": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (btuo *BlockedTimeUpdateOne) sqlSave(ctx context.Context) (_node *BlockedTime, err error) {
+	if err := btuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blockedtime.Table, blockedtime.Columns, sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt))
+	id, ok := btuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlockedTime.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := btuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blockedtime.FieldID)
+		for _, f := range fields {
+			if !blockedtime.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blockedtime.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := btuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := btuo.mutation.Name(); ok {
+		_spec.SetField(blockedtime.FieldName, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.UID(); ok {
+		_spec.SetField(blockedtime.FieldUID, field.TypeString, value)
+	}
+	if value, ok := btuo.mutation.Summary(); ok {
+		_spec.SetField(blockedtime.FieldSummary, field.TypeString, value)
+	}
+	if btuo.mutation.SummaryCleared() {
+		_spec.ClearField(blockedtime.FieldSummary, field.TypeString)
+	}
+	if value, ok := btuo.mutation.StartTime(); ok {
+		_spec.SetField(blockedtime.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := btuo.mutation.EndTime(); ok {
+		_spec.SetField(blockedtime.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := btuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(blockedtime.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if btuo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockedtime.LocationTable,
+			Columns: []string{blockedtime.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := btuo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blockedtime.LocationTable,
+			Columns: []string{blockedtime.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlockedTime{config: btuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, btuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blockedtime.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	btuo.mutation.done = true
+	return _node, nil
+}
@@ -12,10 +12,21 @@ import (
 	"TerminSystem/ent/migrate"
 
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"TerminSystem/ent/workinghours"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -25,6 +36,26 @@ type Client struct {
 	Schema *migrate.Schema
 	// Appointment is the client for interacting with the Appointment builders.
 	Appointment *AppointmentClient
+	// BlockedTime is the client for interacting with the BlockedTime builders.
+	BlockedTime *BlockedTimeClient
+	// ClosureDay is the client for interacting with the ClosureDay builders.
+	ClosureDay *ClosureDayClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// OpeningHours is the client for interacting with the OpeningHours builders.
+	OpeningHours *OpeningHoursClient
+	// OpeningHoursException is the client for interacting with the OpeningHoursException builders.
+	OpeningHoursException *OpeningHoursExceptionClient
+	// Reminder is the client for interacting with the Reminder builders.
+	Reminder *ReminderClient
+	// SlotHold is the client for interacting with the SlotHold builders.
+	SlotHold *SlotHoldClient
+	// Staff is the client for interacting with the Staff builders.
+	Staff *StaffClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// WorkingHours is the client for interacting with the WorkingHours builders.
+	WorkingHours *WorkingHoursClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -37,6 +68,16 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Appointment = NewAppointmentClient(c.config)
+	c.BlockedTime = NewBlockedTimeClient(c.config)
+	c.ClosureDay = NewClosureDayClient(c.config)
+	c.Location = NewLocationClient(c.config)
+	c.OpeningHours = NewOpeningHoursClient(c.config)
+	c.OpeningHoursException = NewOpeningHoursExceptionClient(c.config)
+	c.Reminder = NewReminderClient(c.config)
+	c.SlotHold = NewSlotHoldClient(c.config)
+	c.Staff = NewStaffClient(c.config)
+	c.WaitlistEntry = NewWaitlistEntryClient(c.config)
+	c.WorkingHours = NewWorkingHoursClient(c.config)
 }
 
 type (
@@ -127,9 +168,19 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Appointment: NewAppointmentClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Appointment:           NewAppointmentClient(cfg),
+		BlockedTime:           NewBlockedTimeClient(cfg),
+		ClosureDay:            NewClosureDayClient(cfg),
+		Location:              NewLocationClient(cfg),
+		OpeningHours:          NewOpeningHoursClient(cfg),
+		OpeningHoursException: NewOpeningHoursExceptionClient(cfg),
+		Reminder:              NewReminderClient(cfg),
+		SlotHold:              NewSlotHoldClient(cfg),
+		Staff:                 NewStaffClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		WorkingHours:          NewWorkingHoursClient(cfg),
 	}, nil
 }
 
@@ -147,9 +198,19 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Appointment: NewAppointmentClient(cfg),
+		ctx:                   ctx,
+		config:                cfg,
+		Appointment:           NewAppointmentClient(cfg),
+		BlockedTime:           NewBlockedTimeClient(cfg),
+		ClosureDay:            NewClosureDayClient(cfg),
+		Location:              NewLocationClient(cfg),
+		OpeningHours:          NewOpeningHoursClient(cfg),
+		OpeningHoursException: NewOpeningHoursExceptionClient(cfg),
+		Reminder:              NewReminderClient(cfg),
+		SlotHold:              NewSlotHoldClient(cfg),
+		Staff:                 NewStaffClient(cfg),
+		WaitlistEntry:         NewWaitlistEntryClient(cfg),
+		WorkingHours:          NewWorkingHoursClient(cfg),
 	}, nil
 }
 
@@ -178,13 +239,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Appointment.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Appointment, c.BlockedTime, c.ClosureDay, c.Location, c.OpeningHours,
+		c.OpeningHoursException, c.Reminder, c.SlotHold, c.Staff, c.WaitlistEntry,
+		c.WorkingHours,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Appointment.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Appointment, c.BlockedTime, c.ClosureDay, c.Location, c.OpeningHours,
+		c.OpeningHoursException, c.Reminder, c.SlotHold, c.Staff, c.WaitlistEntry,
+		c.WorkingHours,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -192,6 +265,26 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AppointmentMutation:
 		return c.Appointment.mutate(ctx, m)
+	case *BlockedTimeMutation:
+		return c.BlockedTime.mutate(ctx, m)
+	case *ClosureDayMutation:
+		return c.ClosureDay.mutate(ctx, m)
+	case *LocationMutation:
+		return c.Location.mutate(ctx, m)
+	case *OpeningHoursMutation:
+		return c.OpeningHours.mutate(ctx, m)
+	case *OpeningHoursExceptionMutation:
+		return c.OpeningHoursException.mutate(ctx, m)
+	case *ReminderMutation:
+		return c.Reminder.mutate(ctx, m)
+	case *SlotHoldMutation:
+		return c.SlotHold.mutate(ctx, m)
+	case *StaffMutation:
+		return c.Staff.mutate(ctx, m)
+	case *WaitlistEntryMutation:
+		return c.WaitlistEntry.mutate(ctx, m)
+	case *WorkingHoursMutation:
+		return c.WorkingHours.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -305,6 +398,54 @@ func (c *AppointmentClient) GetX(ctx context.Context, id int) *Appointment {
 	return obj
 }
 
+// QueryStaff queries the staff edge of a Appointment.
+func (c *AppointmentClient) QueryStaff(a *Appointment) *StaffQuery {
+	query := (&StaffClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, id),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointment.StaffTable, appointment.StaffColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a Appointment.
+func (c *AppointmentClient) QueryLocation(a *Appointment) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, appointment.LocationTable, appointment.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReminders queries the reminders edge of a Appointment.
+func (c *AppointmentClient) QueryReminders(a *Appointment) *ReminderQuery {
+	query := (&ReminderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := a.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(appointment.Table, appointment.FieldID, id),
+			sqlgraph.To(reminder.Table, reminder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, appointment.RemindersTable, appointment.RemindersColumn),
+		)
+		fromV = sqlgraph.Neighbors(a.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AppointmentClient) Hooks() []Hook {
 	return c.hooks.Appointment
@@ -330,12 +471,1682 @@ func (c *AppointmentClient) mutate(ctx context.Context, m *AppointmentMutation)
 	}
 }
 
+// BlockedTimeClient is a client for the BlockedTime schema.
+type BlockedTimeClient struct {
+	config
+}
+
+// NewBlockedTimeClient returns a client for the BlockedTime from the given config.
+func NewBlockedTimeClient(c config) *BlockedTimeClient {
+	return &BlockedTimeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `blockedtime.Hooks(f(g(h())))`.
+func (c *BlockedTimeClient) Use(hooks ...Hook) {
+	c.hooks.BlockedTime = append(c.hooks.BlockedTime, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `blockedtime.Intercept(f(g(h())))`.
+func (c *BlockedTimeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BlockedTime = append(c.inters.BlockedTime, interceptors...)
+}
+
+// Create returns a builder for creating a BlockedTime entity.
+func (c *BlockedTimeClient) Create() *BlockedTimeCreate {
+	mutation := newBlockedTimeMutation(c.config, OpCreate)
+	return &BlockedTimeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BlockedTime entities.
+func (c *BlockedTimeClient) CreateBulk(builders ...*BlockedTimeCreate) *BlockedTimeCreateBulk {
+	return &BlockedTimeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BlockedTimeClient) MapCreateBulk(slice any, setFunc func(*BlockedTimeCreate, int)) *BlockedTimeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BlockedTimeCreateBulk{err: fmt.Errorf("calling to BlockedTimeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BlockedTimeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BlockedTimeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BlockedTime.
+func (c *BlockedTimeClient) Update() *BlockedTimeUpdate {
+	mutation := newBlockedTimeMutation(c.config, OpUpdate)
+	return &BlockedTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BlockedTimeClient) UpdateOne(bt *BlockedTime) *BlockedTimeUpdateOne {
+	mutation := newBlockedTimeMutation(c.config, OpUpdateOne, withBlockedTime(bt))
+	return &BlockedTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BlockedTimeClient) UpdateOneID(id int) *BlockedTimeUpdateOne {
+	mutation := newBlockedTimeMutation(c.config, OpUpdateOne, withBlockedTimeID(id))
+	return &BlockedTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BlockedTime.
+func (c *BlockedTimeClient) Delete() *BlockedTimeDelete {
+	mutation := newBlockedTimeMutation(c.config, OpDelete)
+	return &BlockedTimeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BlockedTimeClient) DeleteOne(bt *BlockedTime) *BlockedTimeDeleteOne {
+	return c.DeleteOneID(bt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BlockedTimeClient) DeleteOneID(id int) *BlockedTimeDeleteOne {
+	builder := c.Delete().Where(blockedtime.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BlockedTimeDeleteOne{builder}
+}
+
+// Query returns a query builder for BlockedTime.
+func (c *BlockedTimeClient) Query() *BlockedTimeQuery {
+	return &BlockedTimeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBlockedTime},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BlockedTime entity by its id.
+func (c *BlockedTimeClient) Get(ctx context.Context, id int) (*BlockedTime, error) {
+	return c.Query().Where(blockedtime.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BlockedTimeClient) GetX(ctx context.Context, id int) *BlockedTime {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a BlockedTime.
+func (c *BlockedTimeClient) QueryLocation(bt *BlockedTime) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := bt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blockedtime.Table, blockedtime.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blockedtime.LocationTable, blockedtime.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(bt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BlockedTimeClient) Hooks() []Hook {
+	return c.hooks.BlockedTime
+}
+
+// Interceptors returns the client interceptors.
+func (c *BlockedTimeClient) Interceptors() []Interceptor {
+	return c.inters.BlockedTime
+}
+
+func (c *BlockedTimeClient) mutate(ctx context.Context, m *BlockedTimeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BlockedTimeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BlockedTimeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BlockedTimeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BlockedTimeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BlockedTime mutation op: %q", m.Op())
+	}
+}
+
+// ClosureDayClient is a client for the ClosureDay schema.
+type ClosureDayClient struct {
+	config
+}
+
+// NewClosureDayClient returns a client for the ClosureDay from the given config.
+func NewClosureDayClient(c config) *ClosureDayClient {
+	return &ClosureDayClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `closureday.Hooks(f(g(h())))`.
+func (c *ClosureDayClient) Use(hooks ...Hook) {
+	c.hooks.ClosureDay = append(c.hooks.ClosureDay, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `closureday.Intercept(f(g(h())))`.
+func (c *ClosureDayClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClosureDay = append(c.inters.ClosureDay, interceptors...)
+}
+
+// Create returns a builder for creating a ClosureDay entity.
+func (c *ClosureDayClient) Create() *ClosureDayCreate {
+	mutation := newClosureDayMutation(c.config, OpCreate)
+	return &ClosureDayCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ClosureDay entities.
+func (c *ClosureDayClient) CreateBulk(builders ...*ClosureDayCreate) *ClosureDayCreateBulk {
+	return &ClosureDayCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ClosureDayClient) MapCreateBulk(slice any, setFunc func(*ClosureDayCreate, int)) *ClosureDayCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ClosureDayCreateBulk{err: fmt.Errorf("calling to ClosureDayClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ClosureDayCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ClosureDayCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ClosureDay.
+func (c *ClosureDayClient) Update() *ClosureDayUpdate {
+	mutation := newClosureDayMutation(c.config, OpUpdate)
+	return &ClosureDayUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ClosureDayClient) UpdateOne(cd *ClosureDay) *ClosureDayUpdateOne {
+	mutation := newClosureDayMutation(c.config, OpUpdateOne, withClosureDay(cd))
+	return &ClosureDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ClosureDayClient) UpdateOneID(id int) *ClosureDayUpdateOne {
+	mutation := newClosureDayMutation(c.config, OpUpdateOne, withClosureDayID(id))
+	return &ClosureDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ClosureDay.
+func (c *ClosureDayClient) Delete() *ClosureDayDelete {
+	mutation := newClosureDayMutation(c.config, OpDelete)
+	return &ClosureDayDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ClosureDayClient) DeleteOne(cd *ClosureDay) *ClosureDayDeleteOne {
+	return c.DeleteOneID(cd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ClosureDayClient) DeleteOneID(id int) *ClosureDayDeleteOne {
+	builder := c.Delete().Where(closureday.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ClosureDayDeleteOne{builder}
+}
+
+// Query returns a query builder for ClosureDay.
+func (c *ClosureDayClient) Query() *ClosureDayQuery {
+	return &ClosureDayQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeClosureDay},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ClosureDay entity by its id.
+func (c *ClosureDayClient) Get(ctx context.Context, id int) (*ClosureDay, error) {
+	return c.Query().Where(closureday.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ClosureDayClient) GetX(ctx context.Context, id int) *ClosureDay {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a ClosureDay.
+func (c *ClosureDayClient) QueryLocation(cd *ClosureDay) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := cd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(closureday.Table, closureday.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, closureday.LocationTable, closureday.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(cd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ClosureDayClient) Hooks() []Hook {
+	return c.hooks.ClosureDay
+}
+
+// Interceptors returns the client interceptors.
+func (c *ClosureDayClient) Interceptors() []Interceptor {
+	return c.inters.ClosureDay
+}
+
+func (c *ClosureDayClient) mutate(ctx context.Context, m *ClosureDayMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ClosureDayCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ClosureDayUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ClosureDayUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ClosureDayDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ClosureDay mutation op: %q", m.Op())
+	}
+}
+
+// LocationClient is a client for the Location schema.
+type LocationClient struct {
+	config
+}
+
+// NewLocationClient returns a client for the Location from the given config.
+func NewLocationClient(c config) *LocationClient {
+	return &LocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
+func (c *LocationClient) Use(hooks ...Hook) {
+	c.hooks.Location = append(c.hooks.Location, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
+func (c *LocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Location = append(c.inters.Location, interceptors...)
+}
+
+// Create returns a builder for creating a Location entity.
+func (c *LocationClient) Create() *LocationCreate {
+	mutation := newLocationMutation(c.config, OpCreate)
+	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Location entities.
+func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Location.
+func (c *LocationClient) Update() *LocationUpdate {
+	mutation := newLocationMutation(c.config, OpUpdate)
+	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocationClient) UpdateOne(l *Location) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(l))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocationClient) UpdateOneID(id int) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Location.
+func (c *LocationClient) Delete() *LocationDelete {
+	mutation := newLocationMutation(c.config, OpDelete)
+	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocationClient) DeleteOne(l *Location) *LocationDeleteOne {
+	return c.DeleteOneID(l.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocationClient) DeleteOneID(id int) *LocationDeleteOne {
+	builder := c.Delete().Where(location.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocationDeleteOne{builder}
+}
+
+// Query returns a query builder for Location.
+func (c *LocationClient) Query() *LocationQuery {
+	return &LocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Location entity by its id.
+func (c *LocationClient) Get(ctx context.Context, id int) (*Location, error) {
+	return c.Query().Where(location.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocationClient) GetX(ctx context.Context, id int) *Location {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAppointments queries the appointments edge of a Location.
+func (c *LocationClient) QueryAppointments(l *Location) *AppointmentQuery {
+	query := (&AppointmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.AppointmentsTable, location.AppointmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOpeningHours queries the opening_hours edge of a Location.
+func (c *LocationClient) QueryOpeningHours(l *Location) *OpeningHoursQuery {
+	query := (&OpeningHoursClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(openinghours.Table, openinghours.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.OpeningHoursTable, location.OpeningHoursColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOpeningHoursExceptions queries the opening_hours_exceptions edge of a Location.
+func (c *LocationClient) QueryOpeningHoursExceptions(l *Location) *OpeningHoursExceptionQuery {
+	query := (&OpeningHoursExceptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(openinghoursexception.Table, openinghoursexception.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.OpeningHoursExceptionsTable, location.OpeningHoursExceptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStaff queries the staff edge of a Location.
+func (c *LocationClient) QueryStaff(l *Location) *StaffQuery {
+	query := (&StaffClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.StaffTable, location.StaffColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries queries the waitlist_entries edge of a Location.
+func (c *LocationClient) QueryWaitlistEntries(l *Location) *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.WaitlistEntriesTable, location.WaitlistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySlotHolds queries the slot_holds edge of a Location.
+func (c *LocationClient) QuerySlotHolds(l *Location) *SlotHoldQuery {
+	query := (&SlotHoldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(slothold.Table, slothold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.SlotHoldsTable, location.SlotHoldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBlockedTimes queries the blocked_times edge of a Location.
+func (c *LocationClient) QueryBlockedTimes(l *Location) *BlockedTimeQuery {
+	query := (&BlockedTimeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(blockedtime.Table, blockedtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.BlockedTimesTable, location.BlockedTimesColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClosureDays queries the closure_days edge of a Location.
+func (c *LocationClient) QueryClosureDays(l *Location) *ClosureDayQuery {
+	query := (&ClosureDayClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := l.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(closureday.Table, closureday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ClosureDaysTable, location.ClosureDaysColumn),
+		)
+		fromV = sqlgraph.Neighbors(l.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocationClient) Hooks() []Hook {
+	return c.hooks.Location
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationClient) Interceptors() []Interceptor {
+	return c.inters.Location
+}
+
+func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+	}
+}
+
+// OpeningHoursClient is a client for the OpeningHours schema.
+type OpeningHoursClient struct {
+	config
+}
+
+// NewOpeningHoursClient returns a client for the OpeningHours from the given config.
+func NewOpeningHoursClient(c config) *OpeningHoursClient {
+	return &OpeningHoursClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `openinghours.Hooks(f(g(h())))`.
+func (c *OpeningHoursClient) Use(hooks ...Hook) {
+	c.hooks.OpeningHours = append(c.hooks.OpeningHours, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `openinghours.Intercept(f(g(h())))`.
+func (c *OpeningHoursClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OpeningHours = append(c.inters.OpeningHours, interceptors...)
+}
+
+// Create returns a builder for creating a OpeningHours entity.
+func (c *OpeningHoursClient) Create() *OpeningHoursCreate {
+	mutation := newOpeningHoursMutation(c.config, OpCreate)
+	return &OpeningHoursCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OpeningHours entities.
+func (c *OpeningHoursClient) CreateBulk(builders ...*OpeningHoursCreate) *OpeningHoursCreateBulk {
+	return &OpeningHoursCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OpeningHoursClient) MapCreateBulk(slice any, setFunc func(*OpeningHoursCreate, int)) *OpeningHoursCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OpeningHoursCreateBulk{err: fmt.Errorf("calling to OpeningHoursClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OpeningHoursCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OpeningHoursCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OpeningHours.
+func (c *OpeningHoursClient) Update() *OpeningHoursUpdate {
+	mutation := newOpeningHoursMutation(c.config, OpUpdate)
+	return &OpeningHoursUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OpeningHoursClient) UpdateOne(oh *OpeningHours) *OpeningHoursUpdateOne {
+	mutation := newOpeningHoursMutation(c.config, OpUpdateOne, withOpeningHours(oh))
+	return &OpeningHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OpeningHoursClient) UpdateOneID(id int) *OpeningHoursUpdateOne {
+	mutation := newOpeningHoursMutation(c.config, OpUpdateOne, withOpeningHoursID(id))
+	return &OpeningHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OpeningHours.
+func (c *OpeningHoursClient) Delete() *OpeningHoursDelete {
+	mutation := newOpeningHoursMutation(c.config, OpDelete)
+	return &OpeningHoursDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OpeningHoursClient) DeleteOne(oh *OpeningHours) *OpeningHoursDeleteOne {
+	return c.DeleteOneID(oh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OpeningHoursClient) DeleteOneID(id int) *OpeningHoursDeleteOne {
+	builder := c.Delete().Where(openinghours.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OpeningHoursDeleteOne{builder}
+}
+
+// Query returns a query builder for OpeningHours.
+func (c *OpeningHoursClient) Query() *OpeningHoursQuery {
+	return &OpeningHoursQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOpeningHours},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OpeningHours entity by its id.
+func (c *OpeningHoursClient) Get(ctx context.Context, id int) (*OpeningHours, error) {
+	return c.Query().Where(openinghours.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OpeningHoursClient) GetX(ctx context.Context, id int) *OpeningHours {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a OpeningHours.
+func (c *OpeningHoursClient) QueryLocation(oh *OpeningHours) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := oh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(openinghours.Table, openinghours.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, openinghours.LocationTable, openinghours.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(oh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OpeningHoursClient) Hooks() []Hook {
+	return c.hooks.OpeningHours
+}
+
+// Interceptors returns the client interceptors.
+func (c *OpeningHoursClient) Interceptors() []Interceptor {
+	return c.inters.OpeningHours
+}
+
+func (c *OpeningHoursClient) mutate(ctx context.Context, m *OpeningHoursMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OpeningHoursCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OpeningHoursUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OpeningHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OpeningHoursDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OpeningHours mutation op: %q", m.Op())
+	}
+}
+
+// OpeningHoursExceptionClient is a client for the OpeningHoursException schema.
+type OpeningHoursExceptionClient struct {
+	config
+}
+
+// NewOpeningHoursExceptionClient returns a client for the OpeningHoursException from the given config.
+func NewOpeningHoursExceptionClient(c config) *OpeningHoursExceptionClient {
+	return &OpeningHoursExceptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `openinghoursexception.Hooks(f(g(h())))`.
+func (c *OpeningHoursExceptionClient) Use(hooks ...Hook) {
+	c.hooks.OpeningHoursException = append(c.hooks.OpeningHoursException, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `openinghoursexception.Intercept(f(g(h())))`.
+func (c *OpeningHoursExceptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OpeningHoursException = append(c.inters.OpeningHoursException, interceptors...)
+}
+
+// Create returns a builder for creating a OpeningHoursException entity.
+func (c *OpeningHoursExceptionClient) Create() *OpeningHoursExceptionCreate {
+	mutation := newOpeningHoursExceptionMutation(c.config, OpCreate)
+	return &OpeningHoursExceptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OpeningHoursException entities.
+func (c *OpeningHoursExceptionClient) CreateBulk(builders ...*OpeningHoursExceptionCreate) *OpeningHoursExceptionCreateBulk {
+	return &OpeningHoursExceptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OpeningHoursExceptionClient) MapCreateBulk(slice any, setFunc func(*OpeningHoursExceptionCreate, int)) *OpeningHoursExceptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OpeningHoursExceptionCreateBulk{err: fmt.Errorf("calling to OpeningHoursExceptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OpeningHoursExceptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OpeningHoursExceptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OpeningHoursException.
+func (c *OpeningHoursExceptionClient) Update() *OpeningHoursExceptionUpdate {
+	mutation := newOpeningHoursExceptionMutation(c.config, OpUpdate)
+	return &OpeningHoursExceptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OpeningHoursExceptionClient) UpdateOne(ohe *OpeningHoursException) *OpeningHoursExceptionUpdateOne {
+	mutation := newOpeningHoursExceptionMutation(c.config, OpUpdateOne, withOpeningHoursException(ohe))
+	return &OpeningHoursExceptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OpeningHoursExceptionClient) UpdateOneID(id int) *OpeningHoursExceptionUpdateOne {
+	mutation := newOpeningHoursExceptionMutation(c.config, OpUpdateOne, withOpeningHoursExceptionID(id))
+	return &OpeningHoursExceptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OpeningHoursException.
+func (c *OpeningHoursExceptionClient) Delete() *OpeningHoursExceptionDelete {
+	mutation := newOpeningHoursExceptionMutation(c.config, OpDelete)
+	return &OpeningHoursExceptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OpeningHoursExceptionClient) DeleteOne(ohe *OpeningHoursException) *OpeningHoursExceptionDeleteOne {
+	return c.DeleteOneID(ohe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OpeningHoursExceptionClient) DeleteOneID(id int) *OpeningHoursExceptionDeleteOne {
+	builder := c.Delete().Where(openinghoursexception.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OpeningHoursExceptionDeleteOne{builder}
+}
+
+// Query returns a query builder for OpeningHoursException.
+func (c *OpeningHoursExceptionClient) Query() *OpeningHoursExceptionQuery {
+	return &OpeningHoursExceptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOpeningHoursException},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OpeningHoursException entity by its id.
+func (c *OpeningHoursExceptionClient) Get(ctx context.Context, id int) (*OpeningHoursException, error) {
+	return c.Query().Where(openinghoursexception.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OpeningHoursExceptionClient) GetX(ctx context.Context, id int) *OpeningHoursException {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a OpeningHoursException.
+func (c *OpeningHoursExceptionClient) QueryLocation(ohe *OpeningHoursException) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ohe.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(openinghoursexception.Table, openinghoursexception.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, openinghoursexception.LocationTable, openinghoursexception.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(ohe.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OpeningHoursExceptionClient) Hooks() []Hook {
+	return c.hooks.OpeningHoursException
+}
+
+// Interceptors returns the client interceptors.
+func (c *OpeningHoursExceptionClient) Interceptors() []Interceptor {
+	return c.inters.OpeningHoursException
+}
+
+func (c *OpeningHoursExceptionClient) mutate(ctx context.Context, m *OpeningHoursExceptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OpeningHoursExceptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OpeningHoursExceptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OpeningHoursExceptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OpeningHoursExceptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OpeningHoursException mutation op: %q", m.Op())
+	}
+}
+
+// ReminderClient is a client for the Reminder schema.
+type ReminderClient struct {
+	config
+}
+
+// NewReminderClient returns a client for the Reminder from the given config.
+func NewReminderClient(c config) *ReminderClient {
+	return &ReminderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reminder.Hooks(f(g(h())))`.
+func (c *ReminderClient) Use(hooks ...Hook) {
+	c.hooks.Reminder = append(c.hooks.Reminder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reminder.Intercept(f(g(h())))`.
+func (c *ReminderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reminder = append(c.inters.Reminder, interceptors...)
+}
+
+// Create returns a builder for creating a Reminder entity.
+func (c *ReminderClient) Create() *ReminderCreate {
+	mutation := newReminderMutation(c.config, OpCreate)
+	return &ReminderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reminder entities.
+func (c *ReminderClient) CreateBulk(builders ...*ReminderCreate) *ReminderCreateBulk {
+	return &ReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReminderClient) MapCreateBulk(slice any, setFunc func(*ReminderCreate, int)) *ReminderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReminderCreateBulk{err: fmt.Errorf("calling to ReminderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReminderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reminder.
+func (c *ReminderClient) Update() *ReminderUpdate {
+	mutation := newReminderMutation(c.config, OpUpdate)
+	return &ReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReminderClient) UpdateOne(r *Reminder) *ReminderUpdateOne {
+	mutation := newReminderMutation(c.config, OpUpdateOne, withReminder(r))
+	return &ReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReminderClient) UpdateOneID(id int) *ReminderUpdateOne {
+	mutation := newReminderMutation(c.config, OpUpdateOne, withReminderID(id))
+	return &ReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reminder.
+func (c *ReminderClient) Delete() *ReminderDelete {
+	mutation := newReminderMutation(c.config, OpDelete)
+	return &ReminderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReminderClient) DeleteOne(r *Reminder) *ReminderDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReminderClient) DeleteOneID(id int) *ReminderDeleteOne {
+	builder := c.Delete().Where(reminder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReminderDeleteOne{builder}
+}
+
+// Query returns a query builder for Reminder.
+func (c *ReminderClient) Query() *ReminderQuery {
+	return &ReminderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReminder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reminder entity by its id.
+func (c *ReminderClient) Get(ctx context.Context, id int) (*Reminder, error) {
+	return c.Query().Where(reminder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReminderClient) GetX(ctx context.Context, id int) *Reminder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAppointment queries the appointment edge of a Reminder.
+func (c *ReminderClient) QueryAppointment(r *Reminder) *AppointmentQuery {
+	query := (&AppointmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := r.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reminder.Table, reminder.FieldID, id),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, reminder.AppointmentTable, reminder.AppointmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(r.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReminderClient) Hooks() []Hook {
+	return c.hooks.Reminder
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReminderClient) Interceptors() []Interceptor {
+	return c.inters.Reminder
+}
+
+func (c *ReminderClient) mutate(ctx context.Context, m *ReminderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReminderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReminderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reminder mutation op: %q", m.Op())
+	}
+}
+
+// SlotHoldClient is a client for the SlotHold schema.
+type SlotHoldClient struct {
+	config
+}
+
+// NewSlotHoldClient returns a client for the SlotHold from the given config.
+func NewSlotHoldClient(c config) *SlotHoldClient {
+	return &SlotHoldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `slothold.Hooks(f(g(h())))`.
+func (c *SlotHoldClient) Use(hooks ...Hook) {
+	c.hooks.SlotHold = append(c.hooks.SlotHold, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `slothold.Intercept(f(g(h())))`.
+func (c *SlotHoldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SlotHold = append(c.inters.SlotHold, interceptors...)
+}
+
+// Create returns a builder for creating a SlotHold entity.
+func (c *SlotHoldClient) Create() *SlotHoldCreate {
+	mutation := newSlotHoldMutation(c.config, OpCreate)
+	return &SlotHoldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SlotHold entities.
+func (c *SlotHoldClient) CreateBulk(builders ...*SlotHoldCreate) *SlotHoldCreateBulk {
+	return &SlotHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SlotHoldClient) MapCreateBulk(slice any, setFunc func(*SlotHoldCreate, int)) *SlotHoldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SlotHoldCreateBulk{err: fmt.Errorf("calling to SlotHoldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SlotHoldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SlotHoldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SlotHold.
+func (c *SlotHoldClient) Update() *SlotHoldUpdate {
+	mutation := newSlotHoldMutation(c.config, OpUpdate)
+	return &SlotHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SlotHoldClient) UpdateOne(sh *SlotHold) *SlotHoldUpdateOne {
+	mutation := newSlotHoldMutation(c.config, OpUpdateOne, withSlotHold(sh))
+	return &SlotHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SlotHoldClient) UpdateOneID(id int) *SlotHoldUpdateOne {
+	mutation := newSlotHoldMutation(c.config, OpUpdateOne, withSlotHoldID(id))
+	return &SlotHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SlotHold.
+func (c *SlotHoldClient) Delete() *SlotHoldDelete {
+	mutation := newSlotHoldMutation(c.config, OpDelete)
+	return &SlotHoldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SlotHoldClient) DeleteOne(sh *SlotHold) *SlotHoldDeleteOne {
+	return c.DeleteOneID(sh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SlotHoldClient) DeleteOneID(id int) *SlotHoldDeleteOne {
+	builder := c.Delete().Where(slothold.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SlotHoldDeleteOne{builder}
+}
+
+// Query returns a query builder for SlotHold.
+func (c *SlotHoldClient) Query() *SlotHoldQuery {
+	return &SlotHoldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSlotHold},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SlotHold entity by its id.
+func (c *SlotHoldClient) Get(ctx context.Context, id int) (*SlotHold, error) {
+	return c.Query().Where(slothold.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SlotHoldClient) GetX(ctx context.Context, id int) *SlotHold {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a SlotHold.
+func (c *SlotHoldClient) QueryLocation(sh *SlotHold) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slothold.Table, slothold.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slothold.LocationTable, slothold.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(sh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStaff queries the staff edge of a SlotHold.
+func (c *SlotHoldClient) QueryStaff(sh *SlotHold) *StaffQuery {
+	query := (&StaffClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := sh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slothold.Table, slothold.FieldID, id),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slothold.StaffTable, slothold.StaffColumn),
+		)
+		fromV = sqlgraph.Neighbors(sh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SlotHoldClient) Hooks() []Hook {
+	return c.hooks.SlotHold
+}
+
+// Interceptors returns the client interceptors.
+func (c *SlotHoldClient) Interceptors() []Interceptor {
+	return c.inters.SlotHold
+}
+
+func (c *SlotHoldClient) mutate(ctx context.Context, m *SlotHoldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SlotHoldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SlotHoldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SlotHoldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SlotHoldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SlotHold mutation op: %q", m.Op())
+	}
+}
+
+// StaffClient is a client for the Staff schema.
+type StaffClient struct {
+	config
+}
+
+// NewStaffClient returns a client for the Staff from the given config.
+func NewStaffClient(c config) *StaffClient {
+	return &StaffClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `staff.Hooks(f(g(h())))`.
+func (c *StaffClient) Use(hooks ...Hook) {
+	c.hooks.Staff = append(c.hooks.Staff, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `staff.Intercept(f(g(h())))`.
+func (c *StaffClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Staff = append(c.inters.Staff, interceptors...)
+}
+
+// Create returns a builder for creating a Staff entity.
+func (c *StaffClient) Create() *StaffCreate {
+	mutation := newStaffMutation(c.config, OpCreate)
+	return &StaffCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Staff entities.
+func (c *StaffClient) CreateBulk(builders ...*StaffCreate) *StaffCreateBulk {
+	return &StaffCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StaffClient) MapCreateBulk(slice any, setFunc func(*StaffCreate, int)) *StaffCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StaffCreateBulk{err: fmt.Errorf("calling to StaffClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StaffCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StaffCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Staff.
+func (c *StaffClient) Update() *StaffUpdate {
+	mutation := newStaffMutation(c.config, OpUpdate)
+	return &StaffUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StaffClient) UpdateOne(s *Staff) *StaffUpdateOne {
+	mutation := newStaffMutation(c.config, OpUpdateOne, withStaff(s))
+	return &StaffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StaffClient) UpdateOneID(id int) *StaffUpdateOne {
+	mutation := newStaffMutation(c.config, OpUpdateOne, withStaffID(id))
+	return &StaffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Staff.
+func (c *StaffClient) Delete() *StaffDelete {
+	mutation := newStaffMutation(c.config, OpDelete)
+	return &StaffDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StaffClient) DeleteOne(s *Staff) *StaffDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StaffClient) DeleteOneID(id int) *StaffDeleteOne {
+	builder := c.Delete().Where(staff.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StaffDeleteOne{builder}
+}
+
+// Query returns a query builder for Staff.
+func (c *StaffClient) Query() *StaffQuery {
+	return &StaffQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStaff},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Staff entity by its id.
+func (c *StaffClient) Get(ctx context.Context, id int) (*Staff, error) {
+	return c.Query().Where(staff.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StaffClient) GetX(ctx context.Context, id int) *Staff {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkingHours queries the working_hours edge of a Staff.
+func (c *StaffClient) QueryWorkingHours(s *Staff) *WorkingHoursQuery {
+	query := (&WorkingHoursClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(staff.Table, staff.FieldID, id),
+			sqlgraph.To(workinghours.Table, workinghours.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, staff.WorkingHoursTable, staff.WorkingHoursColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAppointments queries the appointments edge of a Staff.
+func (c *StaffClient) QueryAppointments(s *Staff) *AppointmentQuery {
+	query := (&AppointmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(staff.Table, staff.FieldID, id),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, staff.AppointmentsTable, staff.AppointmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySlotHolds queries the slot_holds edge of a Staff.
+func (c *StaffClient) QuerySlotHolds(s *Staff) *SlotHoldQuery {
+	query := (&SlotHoldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(staff.Table, staff.FieldID, id),
+			sqlgraph.To(slothold.Table, slothold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, staff.SlotHoldsTable, staff.SlotHoldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a Staff.
+func (c *StaffClient) QueryLocation(s *Staff) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(staff.Table, staff.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, staff.LocationTable, staff.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StaffClient) Hooks() []Hook {
+	return c.hooks.Staff
+}
+
+// Interceptors returns the client interceptors.
+func (c *StaffClient) Interceptors() []Interceptor {
+	return c.inters.Staff
+}
+
+func (c *StaffClient) mutate(ctx context.Context, m *StaffMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StaffCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StaffUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StaffUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StaffDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Staff mutation op: %q", m.Op())
+	}
+}
+
+// WaitlistEntryClient is a client for the WaitlistEntry schema.
+type WaitlistEntryClient struct {
+	config
+}
+
+// NewWaitlistEntryClient returns a client for the WaitlistEntry from the given config.
+func NewWaitlistEntryClient(c config) *WaitlistEntryClient {
+	return &WaitlistEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `waitlistentry.Hooks(f(g(h())))`.
+func (c *WaitlistEntryClient) Use(hooks ...Hook) {
+	c.hooks.WaitlistEntry = append(c.hooks.WaitlistEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `waitlistentry.Intercept(f(g(h())))`.
+func (c *WaitlistEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaitlistEntry = append(c.inters.WaitlistEntry, interceptors...)
+}
+
+// Create returns a builder for creating a WaitlistEntry entity.
+func (c *WaitlistEntryClient) Create() *WaitlistEntryCreate {
+	mutation := newWaitlistEntryMutation(c.config, OpCreate)
+	return &WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WaitlistEntry entities.
+func (c *WaitlistEntryClient) CreateBulk(builders ...*WaitlistEntryCreate) *WaitlistEntryCreateBulk {
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WaitlistEntryClient) MapCreateBulk(slice any, setFunc func(*WaitlistEntryCreate, int)) *WaitlistEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WaitlistEntryCreateBulk{err: fmt.Errorf("calling to WaitlistEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WaitlistEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WaitlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Update() *WaitlistEntryUpdate {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdate)
+	return &WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WaitlistEntryClient) UpdateOne(we *WaitlistEntry) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntry(we))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WaitlistEntryClient) UpdateOneID(id int) *WaitlistEntryUpdateOne {
+	mutation := newWaitlistEntryMutation(c.config, OpUpdateOne, withWaitlistEntryID(id))
+	return &WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Delete() *WaitlistEntryDelete {
+	mutation := newWaitlistEntryMutation(c.config, OpDelete)
+	return &WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WaitlistEntryClient) DeleteOne(we *WaitlistEntry) *WaitlistEntryDeleteOne {
+	return c.DeleteOneID(we.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WaitlistEntryClient) DeleteOneID(id int) *WaitlistEntryDeleteOne {
+	builder := c.Delete().Where(waitlistentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WaitlistEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for WaitlistEntry.
+func (c *WaitlistEntryClient) Query() *WaitlistEntryQuery {
+	return &WaitlistEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWaitlistEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WaitlistEntry entity by its id.
+func (c *WaitlistEntryClient) Get(ctx context.Context, id int) (*WaitlistEntry, error) {
+	return c.Query().Where(waitlistentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WaitlistEntryClient) GetX(ctx context.Context, id int) *WaitlistEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLocation queries the location edge of a WaitlistEntry.
+func (c *WaitlistEntryClient) QueryLocation(we *WaitlistEntry) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(waitlistentry.Table, waitlistentry.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, waitlistentry.LocationTable, waitlistentry.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WaitlistEntryClient) Hooks() []Hook {
+	return c.hooks.WaitlistEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *WaitlistEntryClient) Interceptors() []Interceptor {
+	return c.inters.WaitlistEntry
+}
+
+func (c *WaitlistEntryClient) mutate(ctx context.Context, m *WaitlistEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WaitlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WaitlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WaitlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WaitlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WaitlistEntry mutation op: %q", m.Op())
+	}
+}
+
+// WorkingHoursClient is a client for the WorkingHours schema.
+type WorkingHoursClient struct {
+	config
+}
+
+// NewWorkingHoursClient returns a client for the WorkingHours from the given config.
+func NewWorkingHoursClient(c config) *WorkingHoursClient {
+	return &WorkingHoursClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workinghours.Hooks(f(g(h())))`.
+func (c *WorkingHoursClient) Use(hooks ...Hook) {
+	c.hooks.WorkingHours = append(c.hooks.WorkingHours, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workinghours.Intercept(f(g(h())))`.
+func (c *WorkingHoursClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkingHours = append(c.inters.WorkingHours, interceptors...)
+}
+
+// Create returns a builder for creating a WorkingHours entity.
+func (c *WorkingHoursClient) Create() *WorkingHoursCreate {
+	mutation := newWorkingHoursMutation(c.config, OpCreate)
+	return &WorkingHoursCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkingHours entities.
+func (c *WorkingHoursClient) CreateBulk(builders ...*WorkingHoursCreate) *WorkingHoursCreateBulk {
+	return &WorkingHoursCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkingHoursClient) MapCreateBulk(slice any, setFunc func(*WorkingHoursCreate, int)) *WorkingHoursCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkingHoursCreateBulk{err: fmt.Errorf("calling to WorkingHoursClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkingHoursCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkingHoursCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkingHours.
+func (c *WorkingHoursClient) Update() *WorkingHoursUpdate {
+	mutation := newWorkingHoursMutation(c.config, OpUpdate)
+	return &WorkingHoursUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkingHoursClient) UpdateOne(wh *WorkingHours) *WorkingHoursUpdateOne {
+	mutation := newWorkingHoursMutation(c.config, OpUpdateOne, withWorkingHours(wh))
+	return &WorkingHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkingHoursClient) UpdateOneID(id int) *WorkingHoursUpdateOne {
+	mutation := newWorkingHoursMutation(c.config, OpUpdateOne, withWorkingHoursID(id))
+	return &WorkingHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkingHours.
+func (c *WorkingHoursClient) Delete() *WorkingHoursDelete {
+	mutation := newWorkingHoursMutation(c.config, OpDelete)
+	return &WorkingHoursDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkingHoursClient) DeleteOne(wh *WorkingHours) *WorkingHoursDeleteOne {
+	return c.DeleteOneID(wh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkingHoursClient) DeleteOneID(id int) *WorkingHoursDeleteOne {
+	builder := c.Delete().Where(workinghours.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkingHoursDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkingHours.
+func (c *WorkingHoursClient) Query() *WorkingHoursQuery {
+	return &WorkingHoursQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkingHours},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkingHours entity by its id.
+func (c *WorkingHoursClient) Get(ctx context.Context, id int) (*WorkingHours, error) {
+	return c.Query().Where(workinghours.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkingHoursClient) GetX(ctx context.Context, id int) *WorkingHours {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStaff queries the staff edge of a WorkingHours.
+func (c *WorkingHoursClient) QueryStaff(wh *WorkingHours) *StaffQuery {
+	query := (&StaffClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wh.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workinghours.Table, workinghours.FieldID, id),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workinghours.StaffTable, workinghours.StaffColumn),
+		)
+		fromV = sqlgraph.Neighbors(wh.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkingHoursClient) Hooks() []Hook {
+	return c.hooks.WorkingHours
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkingHoursClient) Interceptors() []Interceptor {
+	return c.inters.WorkingHours
+}
+
+func (c *WorkingHoursClient) mutate(ctx context.Context, m *WorkingHoursMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkingHoursCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkingHoursUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkingHoursUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkingHoursDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkingHours mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Appointment []ent.Hook
+		Appointment, BlockedTime, ClosureDay, Location, OpeningHours,
+		OpeningHoursException, Reminder, SlotHold, Staff, WaitlistEntry,
+		WorkingHours []ent.Hook
 	}
 	inters struct {
-		Appointment []ent.Interceptor
+		Appointment, BlockedTime, ClosureDay, Location, OpeningHours,
+		OpeningHoursException, Reminder, SlotHold, Staff, WaitlistEntry,
+		WorkingHours []ent.Interceptor
 	}
 )
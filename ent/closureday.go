@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ClosureDay is the model entity for the ClosureDay schema.
+type ClosureDay struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date string `json:"date,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ClosureDayQuery when eager-loading is set.
+	Edges        ClosureDayEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ClosureDayEdges holds the relations/edges for other nodes in the graph.
+type ClosureDayEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ClosureDayEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ClosureDay) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case closureday.FieldID, closureday.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case closureday.FieldDate, closureday.FieldReason:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ClosureDay fields.
+func (cd *ClosureDay) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case closureday.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			cd.ID = int(value.Int64)
+		case closureday.FieldDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				cd.Date = value.String
+			}
+		case closureday.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				cd.Reason = value.String
+			}
+		case closureday.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				cd.LocationID = new(int)
+				*cd.LocationID = int(value.Int64)
+			}
+		default:
+			cd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ClosureDay.
+// This includes values selected through modifiers, order, etc.
+func (cd *ClosureDay) Value(name string) (ent.Value, error) {
+	return cd.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the ClosureDay entity.
+func (cd *ClosureDay) QueryLocation() *LocationQuery {
+	return NewClosureDayClient(cd.config).QueryLocation(cd)
+}
+
+// Update returns a builder for updating this ClosureDay.
+// Note that you need to call ClosureDay.Unwrap() before calling this method if this ClosureDay
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cd *ClosureDay) Update() *ClosureDayUpdateOne {
+	return NewClosureDayClient(cd.config).UpdateOne(cd)
+}
+
+// Unwrap unwraps the ClosureDay entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cd *ClosureDay) Unwrap() *ClosureDay {
+	_tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ClosureDay is not a transactional entity")
+	}
+	cd.config.driver = _tx.drv
+	return cd
+}
+
+// String implements the fmt.Stringer.
+func (cd *ClosureDay) String() string {
+	var builder strings.Builder
+	builder.WriteString("ClosureDay(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cd.ID))
+	builder.WriteString("date=")
+	builder.WriteString(cd.Date)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(cd.Reason)
+	builder.WriteString(", ")
+	if v := cd.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ClosureDays is a parsable slice of ClosureDay.
+type ClosureDays []*ClosureDay
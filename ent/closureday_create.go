@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClosureDayCreate is the builder for creating a ClosureDay entity.
+type ClosureDayCreate struct {
+	config
+	mutation *ClosureDayMutation
+	hooks    []Hook
+}
+
+// SetDate sets the "date" field.
+func (cdc *ClosureDayCreate) SetDate(s string) *ClosureDayCreate {
+	cdc.mutation.SetDate(s)
+	return cdc
+}
+
+// SetReason sets the "reason" field.
+func (cdc *ClosureDayCreate) SetReason(s string) *ClosureDayCreate {
+	cdc.mutation.SetReason(s)
+	return cdc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (cdc *ClosureDayCreate) SetNillableReason(s *string) *ClosureDayCreate {
+	if s != nil {
+		cdc.SetReason(*s)
+	}
+	return cdc
+}
+
+// SetLocationID sets the "location_id" field.
+func (cdc *ClosureDayCreate) SetLocationID(i int) *ClosureDayCreate {
+	cdc.mutation.SetLocationID(i)
+	return cdc
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (cdc *ClosureDayCreate) SetNillableLocationID(i *int) *ClosureDayCreate {
+	if i != nil {
+		cdc.SetLocationID(*i)
+	}
+	return cdc
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (cdc *ClosureDayCreate) SetLocation(l *Location) *ClosureDayCreate {
+	return cdc.SetLocationID(l.ID)
+}
+
+// Mutation returns the ClosureDayMutation object of the builder.
+func (cdc *ClosureDayCreate) Mutation() *ClosureDayMutation {
+	return cdc.mutation
+}
+
+// Save creates the ClosureDay in the database.
+func (cdc *ClosureDayCreate) Save(ctx context.Context) (*ClosureDay, error) {
+	return withHooks(ctx, cdc.sqlSave, cdc.mutation, cdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cdc *ClosureDayCreate) SaveX(ctx context.Context) *ClosureDay {
+	v, err := cdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdc *ClosureDayCreate) Exec(ctx context.Context) error {
+	_, err := cdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdc *ClosureDayCreate) ExecX(ctx context.Context) {
+	if err := cdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdc *ClosureDayCreate) check() error {
+	if _, ok := cdc.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "ClosureDay.date"`)}
+	}
+	if v, ok := cdc.mutation.Date(); ok {
+		if err := closureday.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "ClosureDay.date": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cdc *ClosureDayCreate) sqlSave(ctx context.Context) (*ClosureDay, error) {
+	if err := cdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cdc.mutation.id = &_node.ID
+	cdc.mutation.done = true
+	return _node, nil
+}
+
+func (cdc *ClosureDayCreate) createSpec() (*ClosureDay, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ClosureDay{config: cdc.config}
+		_spec = sqlgraph.NewCreateSpec(closureday.Table, sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt))
+	)
+	if value, ok := cdc.mutation.Date(); ok {
+		_spec.SetField(closureday.FieldDate, field.TypeString, value)
+		_node.Date = value
+	}
+	if value, ok := cdc.mutation.Reason(); ok {
+		_spec.SetField(closureday.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := cdc.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   closureday.LocationTable,
+			Columns: []string{closureday.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ClosureDayCreateBulk is the builder for creating many ClosureDay entities in bulk.
+type ClosureDayCreateBulk struct {
+	config
+	err      error
+	builders []*ClosureDayCreate
+}
+
+// Save creates the ClosureDay entities in the database.
+func (cdcb *ClosureDayCreateBulk) Save(ctx context.Context) ([]*ClosureDay, error) {
+	if cdcb.err != nil {
+		return nil, cdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cdcb.builders))
+	nodes := make([]*ClosureDay, len(cdcb.builders))
+	mutators := make([]Mutator, len(cdcb.builders))
+	for i := range cdcb.builders {
+		func(i int, root context.Context) {
+			builder := cdcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ClosureDayMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdcb *ClosureDayCreateBulk) SaveX(ctx context.Context) []*ClosureDay {
+	v, err := cdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cdcb *ClosureDayCreateBulk) Exec(ctx context.Context) error {
+	_, err := cdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdcb *ClosureDayCreateBulk) ExecX(ctx context.Context) {
+	if err := cdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClosureDayDelete is the builder for deleting a ClosureDay entity.
+type ClosureDayDelete struct {
+	config
+	hooks    []Hook
+	mutation *ClosureDayMutation
+}
+
+// Where appends a list predicates to the ClosureDayDelete builder.
+func (cdd *ClosureDayDelete) Where(ps ...predicate.ClosureDay) *ClosureDayDelete {
+	cdd.mutation.Where(ps...)
+	return cdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cdd *ClosureDayDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdd.sqlExec, cdd.mutation, cdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdd *ClosureDayDelete) ExecX(ctx context.Context) int {
+	n, err := cdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cdd *ClosureDayDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(closureday.Table, sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt))
+	if ps := cdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cdd.mutation.done = true
+	return affected, err
+}
+
+// ClosureDayDeleteOne is the builder for deleting a single ClosureDay entity.
+type ClosureDayDeleteOne struct {
+	cdd *ClosureDayDelete
+}
+
+// Where appends a list predicates to the ClosureDayDelete builder.
+func (cddo *ClosureDayDeleteOne) Where(ps ...predicate.ClosureDay) *ClosureDayDeleteOne {
+	cddo.cdd.mutation.Where(ps...)
+	return cddo
+}
+
+// Exec executes the deletion query.
+func (cddo *ClosureDayDeleteOne) Exec(ctx context.Context) error {
+	n, err := cddo.cdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{closureday.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cddo *ClosureDayDeleteOne) ExecX(ctx context.Context) {
+	if err := cddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
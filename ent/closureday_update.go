@@ -0,0 +1,408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ClosureDayUpdate is the builder for updating ClosureDay entities.
+type ClosureDayUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ClosureDayMutation
+}
+
+// Where appends a list predicates to the ClosureDayUpdate builder.
+func (cdu *ClosureDayUpdate) Where(ps ...predicate.ClosureDay) *ClosureDayUpdate {
+	cdu.mutation.Where(ps...)
+	return cdu
+}
+
+// SetDate sets the "date" field.
+func (cdu *ClosureDayUpdate) SetDate(s string) *ClosureDayUpdate {
+	cdu.mutation.SetDate(s)
+	return cdu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (cdu *ClosureDayUpdate) SetNillableDate(s *string) *ClosureDayUpdate {
+	if s != nil {
+		cdu.SetDate(*s)
+	}
+	return cdu
+}
+
+// SetReason sets the "reason" field.
+func (cdu *ClosureDayUpdate) SetReason(s string) *ClosureDayUpdate {
+	cdu.mutation.SetReason(s)
+	return cdu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (cdu *ClosureDayUpdate) SetNillableReason(s *string) *ClosureDayUpdate {
+	if s != nil {
+		cdu.SetReason(*s)
+	}
+	return cdu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (cdu *ClosureDayUpdate) ClearReason() *ClosureDayUpdate {
+	cdu.mutation.ClearReason()
+	return cdu
+}
+
+// SetLocationID sets the "location_id" field.
+func (cdu *ClosureDayUpdate) SetLocationID(i int) *ClosureDayUpdate {
+	cdu.mutation.SetLocationID(i)
+	return cdu
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (cdu *ClosureDayUpdate) SetNillableLocationID(i *int) *ClosureDayUpdate {
+	if i != nil {
+		cdu.SetLocationID(*i)
+	}
+	return cdu
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (cdu *ClosureDayUpdate) ClearLocationID() *ClosureDayUpdate {
+	cdu.mutation.ClearLocationID()
+	return cdu
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (cdu *ClosureDayUpdate) SetLocation(l *Location) *ClosureDayUpdate {
+	return cdu.SetLocationID(l.ID)
+}
+
+// Mutation returns the ClosureDayMutation object of the builder.
+func (cdu *ClosureDayUpdate) Mutation() *ClosureDayMutation {
+	return cdu.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (cdu *ClosureDayUpdate) ClearLocation() *ClosureDayUpdate {
+	cdu.mutation.ClearLocation()
+	return cdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cdu *ClosureDayUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cdu.sqlSave, cdu.mutation, cdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cdu *ClosureDayUpdate) SaveX(ctx context.Context) int {
+	affected, err := cdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cdu *ClosureDayUpdate) Exec(ctx context.Context) error {
+	_, err := cdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdu *ClosureDayUpdate) ExecX(ctx context.Context) {
+	if err := cdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cdu *ClosureDayUpdate) check() error {
+	if v, ok := cdu.mutation.Date(); ok {
+		if err := closureday.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "ClosureDay.date": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cdu *ClosureDayUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(closureday.Table, closureday.Columns, sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt))
+	if ps := cdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cdu.mutation.Date(); ok {
+		_spec.SetField(closureday.FieldDate, field.TypeString, value)
+	}
+	if value, ok := cdu.mutation.Reason(); ok {
+		_spec.SetField(closureday.FieldReason, field.TypeString, value)
+	}
+	if cdu.mutation.ReasonCleared() {
+		_spec.ClearField(closureday.FieldReason, field.TypeString)
+	}
+	if cdu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   closureday.LocationTable,
+			Columns: []string{closureday.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cdu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   closureday.LocationTable,
+			Columns: []string{closureday.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{closureday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cdu.mutation.done = true
+	return n, nil
+}
+
+// ClosureDayUpdateOne is the builder for updating a single ClosureDay entity.
+type ClosureDayUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ClosureDayMutation
+}
+
+// SetDate sets the "date" field.
+func (cduo *ClosureDayUpdateOne) SetDate(s string) *ClosureDayUpdateOne {
+	cduo.mutation.SetDate(s)
+	return cduo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (cduo *ClosureDayUpdateOne) SetNillableDate(s *string) *ClosureDayUpdateOne {
+	if s != nil {
+		cduo.SetDate(*s)
+	}
+	return cduo
+}
+
+// SetReason sets the "reason" field.
+func (cduo *ClosureDayUpdateOne) SetReason(s string) *ClosureDayUpdateOne {
+	cduo.mutation.SetReason(s)
+	return cduo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (cduo *ClosureDayUpdateOne) SetNillableReason(s *string) *ClosureDayUpdateOne {
+	if s != nil {
+		cduo.SetReason(*s)
+	}
+	return cduo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (cduo *ClosureDayUpdateOne) ClearReason() *ClosureDayUpdateOne {
+	cduo.mutation.ClearReason()
+	return cduo
+}
+
+// SetLocationID sets the "location_id" field.
+func (cduo *ClosureDayUpdateOne) SetLocationID(i int) *ClosureDayUpdateOne {
+	cduo.mutation.SetLocationID(i)
+	return cduo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (cduo *ClosureDayUpdateOne) SetNillableLocationID(i *int) *ClosureDayUpdateOne {
+	if i != nil {
+		cduo.SetLocationID(*i)
+	}
+	return cduo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (cduo *ClosureDayUpdateOne) ClearLocationID() *ClosureDayUpdateOne {
+	cduo.mutation.ClearLocationID()
+	return cduo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (cduo *ClosureDayUpdateOne) SetLocation(l *Location) *ClosureDayUpdateOne {
+	return cduo.SetLocationID(l.ID)
+}
+
+// Mutation returns the ClosureDayMutation object of the builder.
+func (cduo *ClosureDayUpdateOne) Mutation() *ClosureDayMutation {
+	return cduo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (cduo *ClosureDayUpdateOne) ClearLocation() *ClosureDayUpdateOne {
+	cduo.mutation.ClearLocation()
+	return cduo
+}
+
+// Where appends a list predicates to the ClosureDayUpdate builder.
+func (cduo *ClosureDayUpdateOne) Where(ps ...predicate.ClosureDay) *ClosureDayUpdateOne {
+	cduo.mutation.Where(ps...)
+	return cduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cduo *ClosureDayUpdateOne) Select(field string, fields ...string) *ClosureDayUpdateOne {
+	cduo.fields = append([]string{field}, fields...)
+	return cduo
+}
+
+// Save executes the query and returns the updated ClosureDay entity.
+func (cduo *ClosureDayUpdateOne) Save(ctx context.Context) (*ClosureDay, error) {
+	return withHooks(ctx, cduo.sqlSave, cduo.mutation, cduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cduo *ClosureDayUpdateOne) SaveX(ctx context.Context) *ClosureDay {
+	node, err := cduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cduo *ClosureDayUpdateOne) Exec(ctx context.Context) error {
+	_, err := cduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cduo *ClosureDayUpdateOne) ExecX(ctx context.Context) {
+	if err := cduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cduo *ClosureDayUpdateOne) check() error {
+	if v, ok := cduo.mutation.Date(); ok {
+		if err := closureday.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "ClosureDay.date": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cduo *ClosureDayUpdateOne) sqlSave(ctx context.Context) (_node *ClosureDay, err error) {
+	if err := cduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(closureday.Table, closureday.Columns, sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt))
+	id, ok := cduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ClosureDay.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, closureday.FieldID)
+		for _, f := range fields {
+			if !closureday.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != closureday.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cduo.mutation.Date(); ok {
+		_spec.SetField(closureday.FieldDate, field.TypeString, value)
+	}
+	if value, ok := cduo.mutation.Reason(); ok {
+		_spec.SetField(closureday.FieldReason, field.TypeString, value)
+	}
+	if cduo.mutation.ReasonCleared() {
+		_spec.ClearField(closureday.FieldReason, field.TypeString)
+	}
+	if cduo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   closureday.LocationTable,
+			Columns: []string{closureday.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cduo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   closureday.LocationTable,
+			Columns: []string{closureday.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ClosureDay{config: cduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{closureday.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cduo.mutation.done = true
+	return _node, nil
+}
@@ -4,6 +4,16 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"TerminSystem/ent/workinghours"
 	"context"
 	"errors"
 	"fmt"
@@ -73,7 +83,17 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			appointment.Table: appointment.ValidColumn,
+			appointment.Table:           appointment.ValidColumn,
+			blockedtime.Table:           blockedtime.ValidColumn,
+			closureday.Table:            closureday.ValidColumn,
+			location.Table:              location.ValidColumn,
+			openinghours.Table:          openinghours.ValidColumn,
+			openinghoursexception.Table: openinghoursexception.ValidColumn,
+			reminder.Table:              reminder.ValidColumn,
+			slothold.Table:              slothold.ValidColumn,
+			staff.Table:                 staff.ValidColumn,
+			waitlistentry.Table:         waitlistentry.ValidColumn,
+			workinghours.Table:          workinghours.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -20,6 +20,126 @@ func (f AppointmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AppointmentMutation", m)
 }
 
+// The BlockedTimeFunc type is an adapter to allow the use of ordinary
+// function as BlockedTime mutator.
+type BlockedTimeFunc func(context.Context, *ent.BlockedTimeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BlockedTimeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BlockedTimeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BlockedTimeMutation", m)
+}
+
+// The ClosureDayFunc type is an adapter to allow the use of ordinary
+// function as ClosureDay mutator.
+type ClosureDayFunc func(context.Context, *ent.ClosureDayMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ClosureDayFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ClosureDayMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ClosureDayMutation", m)
+}
+
+// The LocationFunc type is an adapter to allow the use of ordinary
+// function as Location mutator.
+type LocationFunc func(context.Context, *ent.LocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LocationMutation", m)
+}
+
+// The OpeningHoursFunc type is an adapter to allow the use of ordinary
+// function as OpeningHours mutator.
+type OpeningHoursFunc func(context.Context, *ent.OpeningHoursMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OpeningHoursFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OpeningHoursMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OpeningHoursMutation", m)
+}
+
+// The OpeningHoursExceptionFunc type is an adapter to allow the use of ordinary
+// function as OpeningHoursException mutator.
+type OpeningHoursExceptionFunc func(context.Context, *ent.OpeningHoursExceptionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OpeningHoursExceptionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OpeningHoursExceptionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OpeningHoursExceptionMutation", m)
+}
+
+// The ReminderFunc type is an adapter to allow the use of ordinary
+// function as Reminder mutator.
+type ReminderFunc func(context.Context, *ent.ReminderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReminderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReminderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReminderMutation", m)
+}
+
+// The SlotHoldFunc type is an adapter to allow the use of ordinary
+// function as SlotHold mutator.
+type SlotHoldFunc func(context.Context, *ent.SlotHoldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SlotHoldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SlotHoldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SlotHoldMutation", m)
+}
+
+// The StaffFunc type is an adapter to allow the use of ordinary
+// function as Staff mutator.
+type StaffFunc func(context.Context, *ent.StaffMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StaffFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StaffMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StaffMutation", m)
+}
+
+// The WaitlistEntryFunc type is an adapter to allow the use of ordinary
+// function as WaitlistEntry mutator.
+type WaitlistEntryFunc func(context.Context, *ent.WaitlistEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaitlistEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaitlistEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaitlistEntryMutation", m)
+}
+
+// The WorkingHoursFunc type is an adapter to allow the use of ordinary
+// function as WorkingHours mutator.
+type WorkingHoursFunc func(context.Context, *ent.WorkingHoursMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WorkingHoursFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WorkingHoursMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WorkingHoursMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
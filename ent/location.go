@@ -0,0 +1,277 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Location is the model entity for the Location schema.
+type Location struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Timezone holds the value of the "timezone" field.
+	Timezone string `json:"timezone,omitempty"`
+	// State holds the value of the "state" field.
+	State *string `json:"state,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LocationQuery when eager-loading is set.
+	Edges        LocationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LocationEdges holds the relations/edges for other nodes in the graph.
+type LocationEdges struct {
+	// Appointments holds the value of the appointments edge.
+	Appointments []*Appointment `json:"appointments,omitempty"`
+	// OpeningHours holds the value of the opening_hours edge.
+	OpeningHours []*OpeningHours `json:"opening_hours,omitempty"`
+	// OpeningHoursExceptions holds the value of the opening_hours_exceptions edge.
+	OpeningHoursExceptions []*OpeningHoursException `json:"opening_hours_exceptions,omitempty"`
+	// Staff holds the value of the staff edge.
+	Staff []*Staff `json:"staff,omitempty"`
+	// WaitlistEntries holds the value of the waitlist_entries edge.
+	WaitlistEntries []*WaitlistEntry `json:"waitlist_entries,omitempty"`
+	// SlotHolds holds the value of the slot_holds edge.
+	SlotHolds []*SlotHold `json:"slot_holds,omitempty"`
+	// BlockedTimes holds the value of the blocked_times edge.
+	BlockedTimes []*BlockedTime `json:"blocked_times,omitempty"`
+	// ClosureDays holds the value of the closure_days edge.
+	ClosureDays []*ClosureDay `json:"closure_days,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [8]bool
+}
+
+// AppointmentsOrErr returns the Appointments value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) AppointmentsOrErr() ([]*Appointment, error) {
+	if e.loadedTypes[0] {
+		return e.Appointments, nil
+	}
+	return nil, &NotLoadedError{edge: "appointments"}
+}
+
+// OpeningHoursOrErr returns the OpeningHours value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) OpeningHoursOrErr() ([]*OpeningHours, error) {
+	if e.loadedTypes[1] {
+		return e.OpeningHours, nil
+	}
+	return nil, &NotLoadedError{edge: "opening_hours"}
+}
+
+// OpeningHoursExceptionsOrErr returns the OpeningHoursExceptions value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) OpeningHoursExceptionsOrErr() ([]*OpeningHoursException, error) {
+	if e.loadedTypes[2] {
+		return e.OpeningHoursExceptions, nil
+	}
+	return nil, &NotLoadedError{edge: "opening_hours_exceptions"}
+}
+
+// StaffOrErr returns the Staff value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) StaffOrErr() ([]*Staff, error) {
+	if e.loadedTypes[3] {
+		return e.Staff, nil
+	}
+	return nil, &NotLoadedError{edge: "staff"}
+}
+
+// WaitlistEntriesOrErr returns the WaitlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) WaitlistEntriesOrErr() ([]*WaitlistEntry, error) {
+	if e.loadedTypes[4] {
+		return e.WaitlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "waitlist_entries"}
+}
+
+// SlotHoldsOrErr returns the SlotHolds value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) SlotHoldsOrErr() ([]*SlotHold, error) {
+	if e.loadedTypes[5] {
+		return e.SlotHolds, nil
+	}
+	return nil, &NotLoadedError{edge: "slot_holds"}
+}
+
+// BlockedTimesOrErr returns the BlockedTimes value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) BlockedTimesOrErr() ([]*BlockedTime, error) {
+	if e.loadedTypes[6] {
+		return e.BlockedTimes, nil
+	}
+	return nil, &NotLoadedError{edge: "blocked_times"}
+}
+
+// ClosureDaysOrErr returns the ClosureDays value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) ClosureDaysOrErr() ([]*ClosureDay, error) {
+	if e.loadedTypes[7] {
+		return e.ClosureDays, nil
+	}
+	return nil, &NotLoadedError{edge: "closure_days"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Location) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case location.FieldID:
+			values[i] = new(sql.NullInt64)
+		case location.FieldName, location.FieldAddress, location.FieldTimezone, location.FieldState:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Location fields.
+func (l *Location) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case location.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			l.ID = int(value.Int64)
+		case location.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				l.Name = value.String
+			}
+		case location.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				l.Address = value.String
+			}
+		case location.FieldTimezone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field timezone", values[i])
+			} else if value.Valid {
+				l.Timezone = value.String
+			}
+		case location.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				l.State = new(string)
+				*l.State = value.String
+			}
+		default:
+			l.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Location.
+// This includes values selected through modifiers, order, etc.
+func (l *Location) Value(name string) (ent.Value, error) {
+	return l.selectValues.Get(name)
+}
+
+// QueryAppointments queries the "appointments" edge of the Location entity.
+func (l *Location) QueryAppointments() *AppointmentQuery {
+	return NewLocationClient(l.config).QueryAppointments(l)
+}
+
+// QueryOpeningHours queries the "opening_hours" edge of the Location entity.
+func (l *Location) QueryOpeningHours() *OpeningHoursQuery {
+	return NewLocationClient(l.config).QueryOpeningHours(l)
+}
+
+// QueryOpeningHoursExceptions queries the "opening_hours_exceptions" edge of the Location entity.
+func (l *Location) QueryOpeningHoursExceptions() *OpeningHoursExceptionQuery {
+	return NewLocationClient(l.config).QueryOpeningHoursExceptions(l)
+}
+
+// QueryStaff queries the "staff" edge of the Location entity.
+func (l *Location) QueryStaff() *StaffQuery {
+	return NewLocationClient(l.config).QueryStaff(l)
+}
+
+// QueryWaitlistEntries queries the "waitlist_entries" edge of the Location entity.
+func (l *Location) QueryWaitlistEntries() *WaitlistEntryQuery {
+	return NewLocationClient(l.config).QueryWaitlistEntries(l)
+}
+
+// QuerySlotHolds queries the "slot_holds" edge of the Location entity.
+func (l *Location) QuerySlotHolds() *SlotHoldQuery {
+	return NewLocationClient(l.config).QuerySlotHolds(l)
+}
+
+// QueryBlockedTimes queries the "blocked_times" edge of the Location entity.
+func (l *Location) QueryBlockedTimes() *BlockedTimeQuery {
+	return NewLocationClient(l.config).QueryBlockedTimes(l)
+}
+
+// QueryClosureDays queries the "closure_days" edge of the Location entity.
+func (l *Location) QueryClosureDays() *ClosureDayQuery {
+	return NewLocationClient(l.config).QueryClosureDays(l)
+}
+
+// Update returns a builder for updating this Location.
+// Note that you need to call Location.Unwrap() before calling this method if this Location
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (l *Location) Update() *LocationUpdateOne {
+	return NewLocationClient(l.config).UpdateOne(l)
+}
+
+// Unwrap unwraps the Location entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (l *Location) Unwrap() *Location {
+	_tx, ok := l.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Location is not a transactional entity")
+	}
+	l.config.driver = _tx.drv
+	return l
+}
+
+// String implements the fmt.Stringer.
+func (l *Location) String() string {
+	var builder strings.Builder
+	builder.WriteString("Location(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", l.ID))
+	builder.WriteString("name=")
+	builder.WriteString(l.Name)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(l.Address)
+	builder.WriteString(", ")
+	builder.WriteString("timezone=")
+	builder.WriteString(l.Timezone)
+	builder.WriteString(", ")
+	if v := l.State; v != nil {
+		builder.WriteString("state=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Locations is a parsable slice of Location.
+type Locations []*Location
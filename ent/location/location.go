@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package location
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the location type in the database.
+	Label = "location"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// EdgeAppointments holds the string denoting the appointments edge name in mutations.
+	EdgeAppointments = "appointments"
+	// EdgeOpeningHours holds the string denoting the opening_hours edge name in mutations.
+	EdgeOpeningHours = "opening_hours"
+	// EdgeOpeningHoursExceptions holds the string denoting the opening_hours_exceptions edge name in mutations.
+	EdgeOpeningHoursExceptions = "opening_hours_exceptions"
+	// EdgeStaff holds the string denoting the staff edge name in mutations.
+	EdgeStaff = "staff"
+	// EdgeWaitlistEntries holds the string denoting the waitlist_entries edge name in mutations.
+	EdgeWaitlistEntries = "waitlist_entries"
+	// EdgeSlotHolds holds the string denoting the slot_holds edge name in mutations.
+	EdgeSlotHolds = "slot_holds"
+	// EdgeBlockedTimes holds the string denoting the blocked_times edge name in mutations.
+	EdgeBlockedTimes = "blocked_times"
+	// EdgeClosureDays holds the string denoting the closure_days edge name in mutations.
+	EdgeClosureDays = "closure_days"
+	// Table holds the table name of the location in the database.
+	Table = "locations"
+	// AppointmentsTable is the table that holds the appointments relation/edge.
+	AppointmentsTable = "appointments"
+	// AppointmentsInverseTable is the table name for the Appointment entity.
+	// It exists in this package in order to avoid circular dependency with the "appointment" package.
+	AppointmentsInverseTable = "appointments"
+	// AppointmentsColumn is the table column denoting the appointments relation/edge.
+	AppointmentsColumn = "location_id"
+	// OpeningHoursTable is the table that holds the opening_hours relation/edge.
+	OpeningHoursTable = "opening_hours"
+	// OpeningHoursInverseTable is the table name for the OpeningHours entity.
+	// It exists in this package in order to avoid circular dependency with the "openinghours" package.
+	OpeningHoursInverseTable = "opening_hours"
+	// OpeningHoursColumn is the table column denoting the opening_hours relation/edge.
+	OpeningHoursColumn = "location_id"
+	// OpeningHoursExceptionsTable is the table that holds the opening_hours_exceptions relation/edge.
+	OpeningHoursExceptionsTable = "opening_hours_exceptions"
+	// OpeningHoursExceptionsInverseTable is the table name for the OpeningHoursException entity.
+	// It exists in this package in order to avoid circular dependency with the "openinghoursexception" package.
+	OpeningHoursExceptionsInverseTable = "opening_hours_exceptions"
+	// OpeningHoursExceptionsColumn is the table column denoting the opening_hours_exceptions relation/edge.
+	OpeningHoursExceptionsColumn = "location_id"
+	// StaffTable is the table that holds the staff relation/edge.
+	StaffTable = "staffs"
+	// StaffInverseTable is the table name for the Staff entity.
+	// It exists in this package in order to avoid circular dependency with the "staff" package.
+	StaffInverseTable = "staffs"
+	// StaffColumn is the table column denoting the staff relation/edge.
+	StaffColumn = "location_id"
+	// WaitlistEntriesTable is the table that holds the waitlist_entries relation/edge.
+	WaitlistEntriesTable = "waitlist_entries"
+	// WaitlistEntriesInverseTable is the table name for the WaitlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "waitlistentry" package.
+	WaitlistEntriesInverseTable = "waitlist_entries"
+	// WaitlistEntriesColumn is the table column denoting the waitlist_entries relation/edge.
+	WaitlistEntriesColumn = "location_id"
+	// SlotHoldsTable is the table that holds the slot_holds relation/edge.
+	SlotHoldsTable = "slot_holds"
+	// SlotHoldsInverseTable is the table name for the SlotHold entity.
+	// It exists in this package in order to avoid circular dependency with the "slothold" package.
+	SlotHoldsInverseTable = "slot_holds"
+	// SlotHoldsColumn is the table column denoting the slot_holds relation/edge.
+	SlotHoldsColumn = "location_id"
+	// BlockedTimesTable is the table that holds the blocked_times relation/edge.
+	BlockedTimesTable = "blocked_times"
+	// BlockedTimesInverseTable is the table name for the BlockedTime entity.
+	// It exists in this package in order to avoid circular dependency with the "blockedtime" package.
+	BlockedTimesInverseTable = "blocked_times"
+	// BlockedTimesColumn is the table column denoting the blocked_times relation/edge.
+	BlockedTimesColumn = "location_id"
+	// ClosureDaysTable is the table that holds the closure_days relation/edge.
+	ClosureDaysTable = "closure_days"
+	// ClosureDaysInverseTable is the table name for the ClosureDay entity.
+	// It exists in this package in order to avoid circular dependency with the "closureday" package.
+	ClosureDaysInverseTable = "closure_days"
+	// ClosureDaysColumn is the table column denoting the closure_days relation/edge.
+	ClosureDaysColumn = "location_id"
+)
+
+// Columns holds all SQL columns for location fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldAddress,
+	FieldTimezone,
+	FieldState,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
+	// TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	TimezoneValidator func(string) error
+	// StateValidator is a validator for the "state" field. It is called by the builders before save.
+	StateValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Location queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAddress orders the results by the address field.
+func ByAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddress, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByAppointmentsCount orders the results by appointments count.
+func ByAppointmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppointmentsStep(), opts...)
+	}
+}
+
+// ByAppointments orders the results by appointments terms.
+func ByAppointments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOpeningHoursCount orders the results by opening_hours count.
+func ByOpeningHoursCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOpeningHoursStep(), opts...)
+	}
+}
+
+// ByOpeningHours orders the results by opening_hours terms.
+func ByOpeningHours(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOpeningHoursStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOpeningHoursExceptionsCount orders the results by opening_hours_exceptions count.
+func ByOpeningHoursExceptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOpeningHoursExceptionsStep(), opts...)
+	}
+}
+
+// ByOpeningHoursExceptions orders the results by opening_hours_exceptions terms.
+func ByOpeningHoursExceptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOpeningHoursExceptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStaffCount orders the results by staff count.
+func ByStaffCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStaffStep(), opts...)
+	}
+}
+
+// ByStaff orders the results by staff terms.
+func ByStaff(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStaffStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWaitlistEntriesCount orders the results by waitlist_entries count.
+func ByWaitlistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWaitlistEntriesStep(), opts...)
+	}
+}
+
+// ByWaitlistEntries orders the results by waitlist_entries terms.
+func ByWaitlistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaitlistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySlotHoldsCount orders the results by slot_holds count.
+func BySlotHoldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSlotHoldsStep(), opts...)
+	}
+}
+
+// BySlotHolds orders the results by slot_holds terms.
+func BySlotHolds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlotHoldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBlockedTimesCount orders the results by blocked_times count.
+func ByBlockedTimesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBlockedTimesStep(), opts...)
+	}
+}
+
+// ByBlockedTimes orders the results by blocked_times terms.
+func ByBlockedTimes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlockedTimesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByClosureDaysCount orders the results by closure_days count.
+func ByClosureDaysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newClosureDaysStep(), opts...)
+	}
+}
+
+// ByClosureDays orders the results by closure_days terms.
+func ByClosureDays(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClosureDaysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newAppointmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AppointmentsTable, AppointmentsColumn),
+	)
+}
+func newOpeningHoursStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OpeningHoursInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OpeningHoursTable, OpeningHoursColumn),
+	)
+}
+func newOpeningHoursExceptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OpeningHoursExceptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OpeningHoursExceptionsTable, OpeningHoursExceptionsColumn),
+	)
+}
+func newStaffStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StaffInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StaffTable, StaffColumn),
+	)
+}
+func newWaitlistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaitlistEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WaitlistEntriesTable, WaitlistEntriesColumn),
+	)
+}
+func newSlotHoldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlotHoldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlotHoldsTable, SlotHoldsColumn),
+	)
+}
+func newBlockedTimesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlockedTimesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BlockedTimesTable, BlockedTimesColumn),
+	)
+}
+func newClosureDaysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClosureDaysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ClosureDaysTable, ClosureDaysColumn),
+	)
+}
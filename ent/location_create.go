@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationCreate is the builder for creating a Location entity.
+type LocationCreate struct {
+	config
+	mutation *LocationMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (lc *LocationCreate) SetName(s string) *LocationCreate {
+	lc.mutation.SetName(s)
+	return lc
+}
+
+// SetAddress sets the "address" field.
+func (lc *LocationCreate) SetAddress(s string) *LocationCreate {
+	lc.mutation.SetAddress(s)
+	return lc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (lc *LocationCreate) SetNillableAddress(s *string) *LocationCreate {
+	if s != nil {
+		lc.SetAddress(*s)
+	}
+	return lc
+}
+
+// SetTimezone sets the "timezone" field.
+func (lc *LocationCreate) SetTimezone(s string) *LocationCreate {
+	lc.mutation.SetTimezone(s)
+	return lc
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (lc *LocationCreate) SetNillableTimezone(s *string) *LocationCreate {
+	if s != nil {
+		lc.SetTimezone(*s)
+	}
+	return lc
+}
+
+// SetState sets the "state" field.
+func (lc *LocationCreate) SetState(s string) *LocationCreate {
+	lc.mutation.SetState(s)
+	return lc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (lc *LocationCreate) SetNillableState(s *string) *LocationCreate {
+	if s != nil {
+		lc.SetState(*s)
+	}
+	return lc
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (lc *LocationCreate) AddAppointmentIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddAppointmentIDs(ids...)
+	return lc
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (lc *LocationCreate) AddAppointments(a ...*Appointment) *LocationCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return lc.AddAppointmentIDs(ids...)
+}
+
+// AddOpeningHourIDs adds the "opening_hours" edge to the OpeningHours entity by IDs.
+func (lc *LocationCreate) AddOpeningHourIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddOpeningHourIDs(ids...)
+	return lc
+}
+
+// AddOpeningHours adds the "opening_hours" edges to the OpeningHours entity.
+func (lc *LocationCreate) AddOpeningHours(o ...*OpeningHours) *LocationCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lc.AddOpeningHourIDs(ids...)
+}
+
+// AddOpeningHoursExceptionIDs adds the "opening_hours_exceptions" edge to the OpeningHoursException entity by IDs.
+func (lc *LocationCreate) AddOpeningHoursExceptionIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddOpeningHoursExceptionIDs(ids...)
+	return lc
+}
+
+// AddOpeningHoursExceptions adds the "opening_hours_exceptions" edges to the OpeningHoursException entity.
+func (lc *LocationCreate) AddOpeningHoursExceptions(o ...*OpeningHoursException) *LocationCreate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lc.AddOpeningHoursExceptionIDs(ids...)
+}
+
+// AddStaffIDs adds the "staff" edge to the Staff entity by IDs.
+func (lc *LocationCreate) AddStaffIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddStaffIDs(ids...)
+	return lc
+}
+
+// AddStaff adds the "staff" edges to the Staff entity.
+func (lc *LocationCreate) AddStaff(s ...*Staff) *LocationCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lc.AddStaffIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (lc *LocationCreate) AddWaitlistEntryIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddWaitlistEntryIDs(ids...)
+	return lc
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (lc *LocationCreate) AddWaitlistEntries(w ...*WaitlistEntry) *LocationCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return lc.AddWaitlistEntryIDs(ids...)
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by IDs.
+func (lc *LocationCreate) AddSlotHoldIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddSlotHoldIDs(ids...)
+	return lc
+}
+
+// AddSlotHolds adds the "slot_holds" edges to the SlotHold entity.
+func (lc *LocationCreate) AddSlotHolds(s ...*SlotHold) *LocationCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lc.AddSlotHoldIDs(ids...)
+}
+
+// AddBlockedTimeIDs adds the "blocked_times" edge to the BlockedTime entity by IDs.
+func (lc *LocationCreate) AddBlockedTimeIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddBlockedTimeIDs(ids...)
+	return lc
+}
+
+// AddBlockedTimes adds the "blocked_times" edges to the BlockedTime entity.
+func (lc *LocationCreate) AddBlockedTimes(b ...*BlockedTime) *LocationCreate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return lc.AddBlockedTimeIDs(ids...)
+}
+
+// AddClosureDayIDs adds the "closure_days" edge to the ClosureDay entity by IDs.
+func (lc *LocationCreate) AddClosureDayIDs(ids ...int) *LocationCreate {
+	lc.mutation.AddClosureDayIDs(ids...)
+	return lc
+}
+
+// AddClosureDays adds the "closure_days" edges to the ClosureDay entity.
+func (lc *LocationCreate) AddClosureDays(c ...*ClosureDay) *LocationCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lc.AddClosureDayIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (lc *LocationCreate) Mutation() *LocationMutation {
+	return lc.mutation
+}
+
+// Save creates the Location in the database.
+func (lc *LocationCreate) Save(ctx context.Context) (*Location, error) {
+	lc.defaults()
+	return withHooks(ctx, lc.sqlSave, lc.mutation, lc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lc *LocationCreate) SaveX(ctx context.Context) *Location {
+	v, err := lc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lc *LocationCreate) Exec(ctx context.Context) error {
+	_, err := lc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lc *LocationCreate) ExecX(ctx context.Context) {
+	if err := lc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lc *LocationCreate) defaults() {
+	if _, ok := lc.mutation.Timezone(); !ok {
+		v := location.DefaultTimezone
+		lc.mutation.SetTimezone(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lc *LocationCreate) check() error {
+	if _, ok := lc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Location.name"`)}
+	}
+	if v, ok := lc.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Location.name": %w`, err)}
+		}
+	}
+	if _, ok := lc.mutation.Timezone(); !ok {
+		return &ValidationError{Name: "timezone", err: errors.New(`ent: missing required field "Location.timezone"`)}
+	}
+	if v, ok := lc.mutation.Timezone(); ok {
+		if err := location.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Location.timezone": %w`, err)}
+		}
+	}
+	if v, ok := lc.mutation.State(); ok {
+		if err := location.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Location.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lc *LocationCreate) sqlSave(ctx context.Context) (*Location, error) {
+	if err := lc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	lc.mutation.id = &_node.ID
+	lc.mutation.done = true
+	return _node, nil
+}
+
+func (lc *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Location{config: lc.config}
+		_spec = sqlgraph.NewCreateSpec(location.Table, sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt))
+	)
+	if value, ok := lc.mutation.Name(); ok {
+		_spec.SetField(location.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := lc.mutation.Address(); ok {
+		_spec.SetField(location.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if value, ok := lc.mutation.Timezone(); ok {
+		_spec.SetField(location.FieldTimezone, field.TypeString, value)
+		_node.Timezone = value
+	}
+	if value, ok := lc.mutation.State(); ok {
+		_spec.SetField(location.FieldState, field.TypeString, value)
+		_node.State = &value
+	}
+	if nodes := lc.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.OpeningHoursIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.OpeningHoursExceptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.SlotHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.BlockedTimesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := lc.mutation.ClosureDaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LocationCreateBulk is the builder for creating many Location entities in bulk.
+type LocationCreateBulk struct {
+	config
+	err      error
+	builders []*LocationCreate
+}
+
+// Save creates the Location entities in the database.
+func (lcb *LocationCreateBulk) Save(ctx context.Context) ([]*Location, error) {
+	if lcb.err != nil {
+		return nil, lcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lcb.builders))
+	nodes := make([]*Location, len(lcb.builders))
+	mutators := make([]Mutator, len(lcb.builders))
+	for i := range lcb.builders {
+		func(i int, root context.Context) {
+			builder := lcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lcb *LocationCreateBulk) SaveX(ctx context.Context) []*Location {
+	v, err := lcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lcb *LocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := lcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lcb *LocationCreateBulk) ExecX(ctx context.Context) {
+	if err := lcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
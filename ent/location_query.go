@@ -0,0 +1,1150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationQuery is the builder for querying Location entities.
+type LocationQuery struct {
+	config
+	ctx                        *QueryContext
+	order                      []location.OrderOption
+	inters                     []Interceptor
+	predicates                 []predicate.Location
+	withAppointments           *AppointmentQuery
+	withOpeningHours           *OpeningHoursQuery
+	withOpeningHoursExceptions *OpeningHoursExceptionQuery
+	withStaff                  *StaffQuery
+	withWaitlistEntries        *WaitlistEntryQuery
+	withSlotHolds              *SlotHoldQuery
+	withBlockedTimes           *BlockedTimeQuery
+	withClosureDays            *ClosureDayQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LocationQuery builder.
+func (lq *LocationQuery) Where(ps ...predicate.Location) *LocationQuery {
+	lq.predicates = append(lq.predicates, ps...)
+	return lq
+}
+
+// Limit the number of records to be returned by this query.
+func (lq *LocationQuery) Limit(limit int) *LocationQuery {
+	lq.ctx.Limit = &limit
+	return lq
+}
+
+// Offset to start from.
+func (lq *LocationQuery) Offset(offset int) *LocationQuery {
+	lq.ctx.Offset = &offset
+	return lq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (lq *LocationQuery) Unique(unique bool) *LocationQuery {
+	lq.ctx.Unique = &unique
+	return lq
+}
+
+// Order specifies how the records should be ordered.
+func (lq *LocationQuery) Order(o ...location.OrderOption) *LocationQuery {
+	lq.order = append(lq.order, o...)
+	return lq
+}
+
+// QueryAppointments chains the current query on the "appointments" edge.
+func (lq *LocationQuery) QueryAppointments() *AppointmentQuery {
+	query := (&AppointmentClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(appointment.Table, appointment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.AppointmentsTable, location.AppointmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOpeningHours chains the current query on the "opening_hours" edge.
+func (lq *LocationQuery) QueryOpeningHours() *OpeningHoursQuery {
+	query := (&OpeningHoursClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(openinghours.Table, openinghours.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.OpeningHoursTable, location.OpeningHoursColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOpeningHoursExceptions chains the current query on the "opening_hours_exceptions" edge.
+func (lq *LocationQuery) QueryOpeningHoursExceptions() *OpeningHoursExceptionQuery {
+	query := (&OpeningHoursExceptionClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(openinghoursexception.Table, openinghoursexception.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.OpeningHoursExceptionsTable, location.OpeningHoursExceptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStaff chains the current query on the "staff" edge.
+func (lq *LocationQuery) QueryStaff() *StaffQuery {
+	query := (&StaffClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.StaffTable, location.StaffColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWaitlistEntries chains the current query on the "waitlist_entries" edge.
+func (lq *LocationQuery) QueryWaitlistEntries() *WaitlistEntryQuery {
+	query := (&WaitlistEntryClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(waitlistentry.Table, waitlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.WaitlistEntriesTable, location.WaitlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySlotHolds chains the current query on the "slot_holds" edge.
+func (lq *LocationQuery) QuerySlotHolds() *SlotHoldQuery {
+	query := (&SlotHoldClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(slothold.Table, slothold.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.SlotHoldsTable, location.SlotHoldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBlockedTimes chains the current query on the "blocked_times" edge.
+func (lq *LocationQuery) QueryBlockedTimes() *BlockedTimeQuery {
+	query := (&BlockedTimeClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(blockedtime.Table, blockedtime.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.BlockedTimesTable, location.BlockedTimesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryClosureDays chains the current query on the "closure_days" edge.
+func (lq *LocationQuery) QueryClosureDays() *ClosureDayQuery {
+	query := (&ClosureDayClient{config: lq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := lq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := lq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, selector),
+			sqlgraph.To(closureday.Table, closureday.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ClosureDaysTable, location.ClosureDaysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(lq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Location entity from the query.
+// Returns a *NotFoundError when no Location was found.
+func (lq *LocationQuery) First(ctx context.Context) (*Location, error) {
+	nodes, err := lq.Limit(1).All(setContextOp(ctx, lq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{location.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (lq *LocationQuery) FirstX(ctx context.Context) *Location {
+	node, err := lq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Location ID from the query.
+// Returns a *NotFoundError when no Location ID was found.
+func (lq *LocationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lq.Limit(1).IDs(setContextOp(ctx, lq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{location.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (lq *LocationQuery) FirstIDX(ctx context.Context) int {
+	id, err := lq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Location entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Location entity is found.
+// Returns a *NotFoundError when no Location entities are found.
+func (lq *LocationQuery) Only(ctx context.Context) (*Location, error) {
+	nodes, err := lq.Limit(2).All(setContextOp(ctx, lq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{location.Label}
+	default:
+		return nil, &NotSingularError{location.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (lq *LocationQuery) OnlyX(ctx context.Context) *Location {
+	node, err := lq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Location ID in the query.
+// Returns a *NotSingularError when more than one Location ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (lq *LocationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = lq.Limit(2).IDs(setContextOp(ctx, lq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{location.Label}
+	default:
+		err = &NotSingularError{location.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (lq *LocationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := lq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Locations.
+func (lq *LocationQuery) All(ctx context.Context) ([]*Location, error) {
+	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryAll)
+	if err := lq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Location, *LocationQuery]()
+	return withInterceptors[[]*Location](ctx, lq, qr, lq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lq *LocationQuery) AllX(ctx context.Context) []*Location {
+	nodes, err := lq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Location IDs.
+func (lq *LocationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if lq.ctx.Unique == nil && lq.path != nil {
+		lq.Unique(true)
+	}
+	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryIDs)
+	if err = lq.Select(location.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (lq *LocationQuery) IDsX(ctx context.Context) []int {
+	ids, err := lq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (lq *LocationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryCount)
+	if err := lq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, lq, querierCount[*LocationQuery](), lq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (lq *LocationQuery) CountX(ctx context.Context) int {
+	count, err := lq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (lq *LocationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, lq.ctx, ent.OpQueryExist)
+	switch _, err := lq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (lq *LocationQuery) ExistX(ctx context.Context) bool {
+	exist, err := lq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LocationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (lq *LocationQuery) Clone() *LocationQuery {
+	if lq == nil {
+		return nil
+	}
+	return &LocationQuery{
+		config:                     lq.config,
+		ctx:                        lq.ctx.Clone(),
+		order:                      append([]location.OrderOption{}, lq.order...),
+		inters:                     append([]Interceptor{}, lq.inters...),
+		predicates:                 append([]predicate.Location{}, lq.predicates...),
+		withAppointments:           lq.withAppointments.Clone(),
+		withOpeningHours:           lq.withOpeningHours.Clone(),
+		withOpeningHoursExceptions: lq.withOpeningHoursExceptions.Clone(),
+		withStaff:                  lq.withStaff.Clone(),
+		withWaitlistEntries:        lq.withWaitlistEntries.Clone(),
+		withSlotHolds:              lq.withSlotHolds.Clone(),
+		withBlockedTimes:           lq.withBlockedTimes.Clone(),
+		withClosureDays:            lq.withClosureDays.Clone(),
+		// clone intermediate query.
+		sql:  lq.sql.Clone(),
+		path: lq.path,
+	}
+}
+
+// WithAppointments tells the query-builder to eager-load the nodes that are connected to
+// the "appointments" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithAppointments(opts ...func(*AppointmentQuery)) *LocationQuery {
+	query := (&AppointmentClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withAppointments = query
+	return lq
+}
+
+// WithOpeningHours tells the query-builder to eager-load the nodes that are connected to
+// the "opening_hours" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithOpeningHours(opts ...func(*OpeningHoursQuery)) *LocationQuery {
+	query := (&OpeningHoursClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withOpeningHours = query
+	return lq
+}
+
+// WithOpeningHoursExceptions tells the query-builder to eager-load the nodes that are connected to
+// the "opening_hours_exceptions" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithOpeningHoursExceptions(opts ...func(*OpeningHoursExceptionQuery)) *LocationQuery {
+	query := (&OpeningHoursExceptionClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withOpeningHoursExceptions = query
+	return lq
+}
+
+// WithStaff tells the query-builder to eager-load the nodes that are connected to
+// the "staff" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithStaff(opts ...func(*StaffQuery)) *LocationQuery {
+	query := (&StaffClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withStaff = query
+	return lq
+}
+
+// WithWaitlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "waitlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithWaitlistEntries(opts ...func(*WaitlistEntryQuery)) *LocationQuery {
+	query := (&WaitlistEntryClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withWaitlistEntries = query
+	return lq
+}
+
+// WithSlotHolds tells the query-builder to eager-load the nodes that are connected to
+// the "slot_holds" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithSlotHolds(opts ...func(*SlotHoldQuery)) *LocationQuery {
+	query := (&SlotHoldClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withSlotHolds = query
+	return lq
+}
+
+// WithBlockedTimes tells the query-builder to eager-load the nodes that are connected to
+// the "blocked_times" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithBlockedTimes(opts ...func(*BlockedTimeQuery)) *LocationQuery {
+	query := (&BlockedTimeClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withBlockedTimes = query
+	return lq
+}
+
+// WithClosureDays tells the query-builder to eager-load the nodes that are connected to
+// the "closure_days" edge. The optional arguments are used to configure the query builder of the edge.
+func (lq *LocationQuery) WithClosureDays(opts ...func(*ClosureDayQuery)) *LocationQuery {
+	query := (&ClosureDayClient{config: lq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	lq.withClosureDays = query
+	return lq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Location.Query().
+//		GroupBy(location.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (lq *LocationQuery) GroupBy(field string, fields ...string) *LocationGroupBy {
+	lq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LocationGroupBy{build: lq}
+	grbuild.flds = &lq.ctx.Fields
+	grbuild.label = location.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Location.Query().
+//		Select(location.FieldName).
+//		Scan(ctx, &v)
+func (lq *LocationQuery) Select(fields ...string) *LocationSelect {
+	lq.ctx.Fields = append(lq.ctx.Fields, fields...)
+	sbuild := &LocationSelect{LocationQuery: lq}
+	sbuild.label = location.Label
+	sbuild.flds, sbuild.scan = &lq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LocationSelect configured with the given aggregations.
+func (lq *LocationQuery) Aggregate(fns ...AggregateFunc) *LocationSelect {
+	return lq.Select().Aggregate(fns...)
+}
+
+func (lq *LocationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range lq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, lq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range lq.ctx.Fields {
+		if !location.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if lq.path != nil {
+		prev, err := lq.path(ctx)
+		if err != nil {
+			return err
+		}
+		lq.sql = prev
+	}
+	return nil
+}
+
+func (lq *LocationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Location, error) {
+	var (
+		nodes       = []*Location{}
+		_spec       = lq.querySpec()
+		loadedTypes = [8]bool{
+			lq.withAppointments != nil,
+			lq.withOpeningHours != nil,
+			lq.withOpeningHoursExceptions != nil,
+			lq.withStaff != nil,
+			lq.withWaitlistEntries != nil,
+			lq.withSlotHolds != nil,
+			lq.withBlockedTimes != nil,
+			lq.withClosureDays != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Location).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Location{config: lq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, lq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := lq.withAppointments; query != nil {
+		if err := lq.loadAppointments(ctx, query, nodes,
+			func(n *Location) { n.Edges.Appointments = []*Appointment{} },
+			func(n *Location, e *Appointment) { n.Edges.Appointments = append(n.Edges.Appointments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withOpeningHours; query != nil {
+		if err := lq.loadOpeningHours(ctx, query, nodes,
+			func(n *Location) { n.Edges.OpeningHours = []*OpeningHours{} },
+			func(n *Location, e *OpeningHours) { n.Edges.OpeningHours = append(n.Edges.OpeningHours, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withOpeningHoursExceptions; query != nil {
+		if err := lq.loadOpeningHoursExceptions(ctx, query, nodes,
+			func(n *Location) { n.Edges.OpeningHoursExceptions = []*OpeningHoursException{} },
+			func(n *Location, e *OpeningHoursException) {
+				n.Edges.OpeningHoursExceptions = append(n.Edges.OpeningHoursExceptions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withStaff; query != nil {
+		if err := lq.loadStaff(ctx, query, nodes,
+			func(n *Location) { n.Edges.Staff = []*Staff{} },
+			func(n *Location, e *Staff) { n.Edges.Staff = append(n.Edges.Staff, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withWaitlistEntries; query != nil {
+		if err := lq.loadWaitlistEntries(ctx, query, nodes,
+			func(n *Location) { n.Edges.WaitlistEntries = []*WaitlistEntry{} },
+			func(n *Location, e *WaitlistEntry) { n.Edges.WaitlistEntries = append(n.Edges.WaitlistEntries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withSlotHolds; query != nil {
+		if err := lq.loadSlotHolds(ctx, query, nodes,
+			func(n *Location) { n.Edges.SlotHolds = []*SlotHold{} },
+			func(n *Location, e *SlotHold) { n.Edges.SlotHolds = append(n.Edges.SlotHolds, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withBlockedTimes; query != nil {
+		if err := lq.loadBlockedTimes(ctx, query, nodes,
+			func(n *Location) { n.Edges.BlockedTimes = []*BlockedTime{} },
+			func(n *Location, e *BlockedTime) { n.Edges.BlockedTimes = append(n.Edges.BlockedTimes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := lq.withClosureDays; query != nil {
+		if err := lq.loadClosureDays(ctx, query, nodes,
+			func(n *Location) { n.Edges.ClosureDays = []*ClosureDay{} },
+			func(n *Location, e *ClosureDay) { n.Edges.ClosureDays = append(n.Edges.ClosureDays, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (lq *LocationQuery) loadAppointments(ctx context.Context, query *AppointmentQuery, nodes []*Location, init func(*Location), assign func(*Location, *Appointment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(appointment.FieldLocationID)
+	}
+	query.Where(predicate.Appointment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.AppointmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadOpeningHours(ctx context.Context, query *OpeningHoursQuery, nodes []*Location, init func(*Location), assign func(*Location, *OpeningHours)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(openinghours.FieldLocationID)
+	}
+	query.Where(predicate.OpeningHours(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.OpeningHoursColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadOpeningHoursExceptions(ctx context.Context, query *OpeningHoursExceptionQuery, nodes []*Location, init func(*Location), assign func(*Location, *OpeningHoursException)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(openinghoursexception.FieldLocationID)
+	}
+	query.Where(predicate.OpeningHoursException(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.OpeningHoursExceptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadStaff(ctx context.Context, query *StaffQuery, nodes []*Location, init func(*Location), assign func(*Location, *Staff)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(staff.FieldLocationID)
+	}
+	query.Where(predicate.Staff(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.StaffColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadWaitlistEntries(ctx context.Context, query *WaitlistEntryQuery, nodes []*Location, init func(*Location), assign func(*Location, *WaitlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(waitlistentry.FieldLocationID)
+	}
+	query.Where(predicate.WaitlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.WaitlistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadSlotHolds(ctx context.Context, query *SlotHoldQuery, nodes []*Location, init func(*Location), assign func(*Location, *SlotHold)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(slothold.FieldLocationID)
+	}
+	query.Where(predicate.SlotHold(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.SlotHoldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadBlockedTimes(ctx context.Context, query *BlockedTimeQuery, nodes []*Location, init func(*Location), assign func(*Location, *BlockedTime)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(blockedtime.FieldLocationID)
+	}
+	query.Where(predicate.BlockedTime(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.BlockedTimesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (lq *LocationQuery) loadClosureDays(ctx context.Context, query *ClosureDayQuery, nodes []*Location, init func(*Location), assign func(*Location, *ClosureDay)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Location)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(closureday.FieldLocationID)
+	}
+	query.Where(predicate.ClosureDay(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(location.ClosureDaysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.LocationID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "location_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "location_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (lq *LocationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := lq.querySpec()
+	_spec.Node.Columns = lq.ctx.Fields
+	if len(lq.ctx.Fields) > 0 {
+		_spec.Unique = lq.ctx.Unique != nil && *lq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, lq.driver, _spec)
+}
+
+func (lq *LocationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(location.Table, location.Columns, sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt))
+	_spec.From = lq.sql
+	if unique := lq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if lq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := lq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, location.FieldID)
+		for i := range fields {
+			if fields[i] != location.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := lq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := lq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := lq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (lq *LocationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(lq.driver.Dialect())
+	t1 := builder.Table(location.Table)
+	columns := lq.ctx.Fields
+	if len(columns) == 0 {
+		columns = location.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if lq.sql != nil {
+		selector = lq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if lq.ctx.Unique != nil && *lq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range lq.predicates {
+		p(selector)
+	}
+	for _, p := range lq.order {
+		p(selector)
+	}
+	if offset := lq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := lq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LocationGroupBy is the group-by builder for Location entities.
+type LocationGroupBy struct {
+	selector
+	build *LocationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (lgb *LocationGroupBy) Aggregate(fns ...AggregateFunc) *LocationGroupBy {
+	lgb.fns = append(lgb.fns, fns...)
+	return lgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (lgb *LocationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, lgb.build.ctx, ent.OpQueryGroupBy)
+	if err := lgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LocationQuery, *LocationGroupBy](ctx, lgb.build, lgb, lgb.build.inters, v)
+}
+
+func (lgb *LocationGroupBy) sqlScan(ctx context.Context, root *LocationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(lgb.fns))
+	for _, fn := range lgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*lgb.flds)+len(lgb.fns))
+		for _, f := range *lgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*lgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := lgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LocationSelect is the builder for selecting fields of Location entities.
+type LocationSelect struct {
+	*LocationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ls *LocationSelect) Aggregate(fns ...AggregateFunc) *LocationSelect {
+	ls.fns = append(ls.fns, fns...)
+	return ls
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ls *LocationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ls.ctx, ent.OpQuerySelect)
+	if err := ls.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LocationQuery, *LocationSelect](ctx, ls.LocationQuery, ls, ls.inters, v)
+}
+
+func (ls *LocationSelect) sqlScan(ctx context.Context, root *LocationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ls.fns))
+	for _, fn := range ls.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ls.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ls.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1697 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// LocationUpdate is the builder for updating Location entities.
+type LocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LocationMutation
+}
+
+// Where appends a list predicates to the LocationUpdate builder.
+func (lu *LocationUpdate) Where(ps ...predicate.Location) *LocationUpdate {
+	lu.mutation.Where(ps...)
+	return lu
+}
+
+// SetName sets the "name" field.
+func (lu *LocationUpdate) SetName(s string) *LocationUpdate {
+	lu.mutation.SetName(s)
+	return lu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableName(s *string) *LocationUpdate {
+	if s != nil {
+		lu.SetName(*s)
+	}
+	return lu
+}
+
+// SetAddress sets the "address" field.
+func (lu *LocationUpdate) SetAddress(s string) *LocationUpdate {
+	lu.mutation.SetAddress(s)
+	return lu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableAddress(s *string) *LocationUpdate {
+	if s != nil {
+		lu.SetAddress(*s)
+	}
+	return lu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (lu *LocationUpdate) ClearAddress() *LocationUpdate {
+	lu.mutation.ClearAddress()
+	return lu
+}
+
+// SetTimezone sets the "timezone" field.
+func (lu *LocationUpdate) SetTimezone(s string) *LocationUpdate {
+	lu.mutation.SetTimezone(s)
+	return lu
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableTimezone(s *string) *LocationUpdate {
+	if s != nil {
+		lu.SetTimezone(*s)
+	}
+	return lu
+}
+
+// SetState sets the "state" field.
+func (lu *LocationUpdate) SetState(s string) *LocationUpdate {
+	lu.mutation.SetState(s)
+	return lu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (lu *LocationUpdate) SetNillableState(s *string) *LocationUpdate {
+	if s != nil {
+		lu.SetState(*s)
+	}
+	return lu
+}
+
+// ClearState clears the value of the "state" field.
+func (lu *LocationUpdate) ClearState() *LocationUpdate {
+	lu.mutation.ClearState()
+	return lu
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (lu *LocationUpdate) AddAppointmentIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddAppointmentIDs(ids...)
+	return lu
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (lu *LocationUpdate) AddAppointments(a ...*Appointment) *LocationUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return lu.AddAppointmentIDs(ids...)
+}
+
+// AddOpeningHourIDs adds the "opening_hours" edge to the OpeningHours entity by IDs.
+func (lu *LocationUpdate) AddOpeningHourIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddOpeningHourIDs(ids...)
+	return lu
+}
+
+// AddOpeningHours adds the "opening_hours" edges to the OpeningHours entity.
+func (lu *LocationUpdate) AddOpeningHours(o ...*OpeningHours) *LocationUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lu.AddOpeningHourIDs(ids...)
+}
+
+// AddOpeningHoursExceptionIDs adds the "opening_hours_exceptions" edge to the OpeningHoursException entity by IDs.
+func (lu *LocationUpdate) AddOpeningHoursExceptionIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddOpeningHoursExceptionIDs(ids...)
+	return lu
+}
+
+// AddOpeningHoursExceptions adds the "opening_hours_exceptions" edges to the OpeningHoursException entity.
+func (lu *LocationUpdate) AddOpeningHoursExceptions(o ...*OpeningHoursException) *LocationUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lu.AddOpeningHoursExceptionIDs(ids...)
+}
+
+// AddStaffIDs adds the "staff" edge to the Staff entity by IDs.
+func (lu *LocationUpdate) AddStaffIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddStaffIDs(ids...)
+	return lu
+}
+
+// AddStaff adds the "staff" edges to the Staff entity.
+func (lu *LocationUpdate) AddStaff(s ...*Staff) *LocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.AddStaffIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (lu *LocationUpdate) AddWaitlistEntryIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddWaitlistEntryIDs(ids...)
+	return lu
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (lu *LocationUpdate) AddWaitlistEntries(w ...*WaitlistEntry) *LocationUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return lu.AddWaitlistEntryIDs(ids...)
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by IDs.
+func (lu *LocationUpdate) AddSlotHoldIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddSlotHoldIDs(ids...)
+	return lu
+}
+
+// AddSlotHolds adds the "slot_holds" edges to the SlotHold entity.
+func (lu *LocationUpdate) AddSlotHolds(s ...*SlotHold) *LocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.AddSlotHoldIDs(ids...)
+}
+
+// AddBlockedTimeIDs adds the "blocked_times" edge to the BlockedTime entity by IDs.
+func (lu *LocationUpdate) AddBlockedTimeIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddBlockedTimeIDs(ids...)
+	return lu
+}
+
+// AddBlockedTimes adds the "blocked_times" edges to the BlockedTime entity.
+func (lu *LocationUpdate) AddBlockedTimes(b ...*BlockedTime) *LocationUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return lu.AddBlockedTimeIDs(ids...)
+}
+
+// AddClosureDayIDs adds the "closure_days" edge to the ClosureDay entity by IDs.
+func (lu *LocationUpdate) AddClosureDayIDs(ids ...int) *LocationUpdate {
+	lu.mutation.AddClosureDayIDs(ids...)
+	return lu
+}
+
+// AddClosureDays adds the "closure_days" edges to the ClosureDay entity.
+func (lu *LocationUpdate) AddClosureDays(c ...*ClosureDay) *LocationUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lu.AddClosureDayIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (lu *LocationUpdate) Mutation() *LocationMutation {
+	return lu.mutation
+}
+
+// ClearAppointments clears all "appointments" edges to the Appointment entity.
+func (lu *LocationUpdate) ClearAppointments() *LocationUpdate {
+	lu.mutation.ClearAppointments()
+	return lu
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to Appointment entities by IDs.
+func (lu *LocationUpdate) RemoveAppointmentIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveAppointmentIDs(ids...)
+	return lu
+}
+
+// RemoveAppointments removes "appointments" edges to Appointment entities.
+func (lu *LocationUpdate) RemoveAppointments(a ...*Appointment) *LocationUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return lu.RemoveAppointmentIDs(ids...)
+}
+
+// ClearOpeningHours clears all "opening_hours" edges to the OpeningHours entity.
+func (lu *LocationUpdate) ClearOpeningHours() *LocationUpdate {
+	lu.mutation.ClearOpeningHours()
+	return lu
+}
+
+// RemoveOpeningHourIDs removes the "opening_hours" edge to OpeningHours entities by IDs.
+func (lu *LocationUpdate) RemoveOpeningHourIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveOpeningHourIDs(ids...)
+	return lu
+}
+
+// RemoveOpeningHours removes "opening_hours" edges to OpeningHours entities.
+func (lu *LocationUpdate) RemoveOpeningHours(o ...*OpeningHours) *LocationUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lu.RemoveOpeningHourIDs(ids...)
+}
+
+// ClearOpeningHoursExceptions clears all "opening_hours_exceptions" edges to the OpeningHoursException entity.
+func (lu *LocationUpdate) ClearOpeningHoursExceptions() *LocationUpdate {
+	lu.mutation.ClearOpeningHoursExceptions()
+	return lu
+}
+
+// RemoveOpeningHoursExceptionIDs removes the "opening_hours_exceptions" edge to OpeningHoursException entities by IDs.
+func (lu *LocationUpdate) RemoveOpeningHoursExceptionIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveOpeningHoursExceptionIDs(ids...)
+	return lu
+}
+
+// RemoveOpeningHoursExceptions removes "opening_hours_exceptions" edges to OpeningHoursException entities.
+func (lu *LocationUpdate) RemoveOpeningHoursExceptions(o ...*OpeningHoursException) *LocationUpdate {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return lu.RemoveOpeningHoursExceptionIDs(ids...)
+}
+
+// ClearStaff clears all "staff" edges to the Staff entity.
+func (lu *LocationUpdate) ClearStaff() *LocationUpdate {
+	lu.mutation.ClearStaff()
+	return lu
+}
+
+// RemoveStaffIDs removes the "staff" edge to Staff entities by IDs.
+func (lu *LocationUpdate) RemoveStaffIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveStaffIDs(ids...)
+	return lu
+}
+
+// RemoveStaff removes "staff" edges to Staff entities.
+func (lu *LocationUpdate) RemoveStaff(s ...*Staff) *LocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.RemoveStaffIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (lu *LocationUpdate) ClearWaitlistEntries() *LocationUpdate {
+	lu.mutation.ClearWaitlistEntries()
+	return lu
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (lu *LocationUpdate) RemoveWaitlistEntryIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveWaitlistEntryIDs(ids...)
+	return lu
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (lu *LocationUpdate) RemoveWaitlistEntries(w ...*WaitlistEntry) *LocationUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return lu.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearSlotHolds clears all "slot_holds" edges to the SlotHold entity.
+func (lu *LocationUpdate) ClearSlotHolds() *LocationUpdate {
+	lu.mutation.ClearSlotHolds()
+	return lu
+}
+
+// RemoveSlotHoldIDs removes the "slot_holds" edge to SlotHold entities by IDs.
+func (lu *LocationUpdate) RemoveSlotHoldIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveSlotHoldIDs(ids...)
+	return lu
+}
+
+// RemoveSlotHolds removes "slot_holds" edges to SlotHold entities.
+func (lu *LocationUpdate) RemoveSlotHolds(s ...*SlotHold) *LocationUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return lu.RemoveSlotHoldIDs(ids...)
+}
+
+// ClearBlockedTimes clears all "blocked_times" edges to the BlockedTime entity.
+func (lu *LocationUpdate) ClearBlockedTimes() *LocationUpdate {
+	lu.mutation.ClearBlockedTimes()
+	return lu
+}
+
+// RemoveBlockedTimeIDs removes the "blocked_times" edge to BlockedTime entities by IDs.
+func (lu *LocationUpdate) RemoveBlockedTimeIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveBlockedTimeIDs(ids...)
+	return lu
+}
+
+// RemoveBlockedTimes removes "blocked_times" edges to BlockedTime entities.
+func (lu *LocationUpdate) RemoveBlockedTimes(b ...*BlockedTime) *LocationUpdate {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return lu.RemoveBlockedTimeIDs(ids...)
+}
+
+// ClearClosureDays clears all "closure_days" edges to the ClosureDay entity.
+func (lu *LocationUpdate) ClearClosureDays() *LocationUpdate {
+	lu.mutation.ClearClosureDays()
+	return lu
+}
+
+// RemoveClosureDayIDs removes the "closure_days" edge to ClosureDay entities by IDs.
+func (lu *LocationUpdate) RemoveClosureDayIDs(ids ...int) *LocationUpdate {
+	lu.mutation.RemoveClosureDayIDs(ids...)
+	return lu
+}
+
+// RemoveClosureDays removes "closure_days" edges to ClosureDay entities.
+func (lu *LocationUpdate) RemoveClosureDays(c ...*ClosureDay) *LocationUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return lu.RemoveClosureDayIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (lu *LocationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, lu.sqlSave, lu.mutation, lu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lu *LocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := lu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (lu *LocationUpdate) Exec(ctx context.Context) error {
+	_, err := lu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lu *LocationUpdate) ExecX(ctx context.Context) {
+	if err := lu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lu *LocationUpdate) check() error {
+	if v, ok := lu.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Location.name": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.Timezone(); ok {
+		if err := location.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Location.timezone": %w`, err)}
+		}
+	}
+	if v, ok := lu.mutation.State(); ok {
+		if err := location.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Location.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (lu *LocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := lu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(location.Table, location.Columns, sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt))
+	if ps := lu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := lu.mutation.Name(); ok {
+		_spec.SetField(location.FieldName, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.Address(); ok {
+		_spec.SetField(location.FieldAddress, field.TypeString, value)
+	}
+	if lu.mutation.AddressCleared() {
+		_spec.ClearField(location.FieldAddress, field.TypeString)
+	}
+	if value, ok := lu.mutation.Timezone(); ok {
+		_spec.SetField(location.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := lu.mutation.State(); ok {
+		_spec.SetField(location.FieldState, field.TypeString, value)
+	}
+	if lu.mutation.StateCleared() {
+		_spec.ClearField(location.FieldState, field.TypeString)
+	}
+	if lu.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedAppointmentsIDs(); len(nodes) > 0 && !lu.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.OpeningHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedOpeningHoursIDs(); len(nodes) > 0 && !lu.mutation.OpeningHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.OpeningHoursIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.OpeningHoursExceptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedOpeningHoursExceptionsIDs(); len(nodes) > 0 && !lu.mutation.OpeningHoursExceptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.OpeningHoursExceptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedStaffIDs(); len(nodes) > 0 && !lu.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !lu.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedSlotHoldsIDs(); len(nodes) > 0 && !lu.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.SlotHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.BlockedTimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedBlockedTimesIDs(); len(nodes) > 0 && !lu.mutation.BlockedTimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.BlockedTimesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if lu.mutation.ClosureDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.RemovedClosureDaysIDs(); len(nodes) > 0 && !lu.mutation.ClosureDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := lu.mutation.ClosureDaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, lu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{location.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	lu.mutation.done = true
+	return n, nil
+}
+
+// LocationUpdateOne is the builder for updating a single Location entity.
+type LocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LocationMutation
+}
+
+// SetName sets the "name" field.
+func (luo *LocationUpdateOne) SetName(s string) *LocationUpdateOne {
+	luo.mutation.SetName(s)
+	return luo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableName(s *string) *LocationUpdateOne {
+	if s != nil {
+		luo.SetName(*s)
+	}
+	return luo
+}
+
+// SetAddress sets the "address" field.
+func (luo *LocationUpdateOne) SetAddress(s string) *LocationUpdateOne {
+	luo.mutation.SetAddress(s)
+	return luo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableAddress(s *string) *LocationUpdateOne {
+	if s != nil {
+		luo.SetAddress(*s)
+	}
+	return luo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (luo *LocationUpdateOne) ClearAddress() *LocationUpdateOne {
+	luo.mutation.ClearAddress()
+	return luo
+}
+
+// SetTimezone sets the "timezone" field.
+func (luo *LocationUpdateOne) SetTimezone(s string) *LocationUpdateOne {
+	luo.mutation.SetTimezone(s)
+	return luo
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableTimezone(s *string) *LocationUpdateOne {
+	if s != nil {
+		luo.SetTimezone(*s)
+	}
+	return luo
+}
+
+// SetState sets the "state" field.
+func (luo *LocationUpdateOne) SetState(s string) *LocationUpdateOne {
+	luo.mutation.SetState(s)
+	return luo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (luo *LocationUpdateOne) SetNillableState(s *string) *LocationUpdateOne {
+	if s != nil {
+		luo.SetState(*s)
+	}
+	return luo
+}
+
+// ClearState clears the value of the "state" field.
+func (luo *LocationUpdateOne) ClearState() *LocationUpdateOne {
+	luo.mutation.ClearState()
+	return luo
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (luo *LocationUpdateOne) AddAppointmentIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddAppointmentIDs(ids...)
+	return luo
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (luo *LocationUpdateOne) AddAppointments(a ...*Appointment) *LocationUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return luo.AddAppointmentIDs(ids...)
+}
+
+// AddOpeningHourIDs adds the "opening_hours" edge to the OpeningHours entity by IDs.
+func (luo *LocationUpdateOne) AddOpeningHourIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddOpeningHourIDs(ids...)
+	return luo
+}
+
+// AddOpeningHours adds the "opening_hours" edges to the OpeningHours entity.
+func (luo *LocationUpdateOne) AddOpeningHours(o ...*OpeningHours) *LocationUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return luo.AddOpeningHourIDs(ids...)
+}
+
+// AddOpeningHoursExceptionIDs adds the "opening_hours_exceptions" edge to the OpeningHoursException entity by IDs.
+func (luo *LocationUpdateOne) AddOpeningHoursExceptionIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddOpeningHoursExceptionIDs(ids...)
+	return luo
+}
+
+// AddOpeningHoursExceptions adds the "opening_hours_exceptions" edges to the OpeningHoursException entity.
+func (luo *LocationUpdateOne) AddOpeningHoursExceptions(o ...*OpeningHoursException) *LocationUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return luo.AddOpeningHoursExceptionIDs(ids...)
+}
+
+// AddStaffIDs adds the "staff" edge to the Staff entity by IDs.
+func (luo *LocationUpdateOne) AddStaffIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddStaffIDs(ids...)
+	return luo
+}
+
+// AddStaff adds the "staff" edges to the Staff entity.
+func (luo *LocationUpdateOne) AddStaff(s ...*Staff) *LocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.AddStaffIDs(ids...)
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (luo *LocationUpdateOne) AddWaitlistEntryIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddWaitlistEntryIDs(ids...)
+	return luo
+}
+
+// AddWaitlistEntries adds the "waitlist_entries" edges to the WaitlistEntry entity.
+func (luo *LocationUpdateOne) AddWaitlistEntries(w ...*WaitlistEntry) *LocationUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return luo.AddWaitlistEntryIDs(ids...)
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by IDs.
+func (luo *LocationUpdateOne) AddSlotHoldIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddSlotHoldIDs(ids...)
+	return luo
+}
+
+// AddSlotHolds adds the "slot_holds" edges to the SlotHold entity.
+func (luo *LocationUpdateOne) AddSlotHolds(s ...*SlotHold) *LocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.AddSlotHoldIDs(ids...)
+}
+
+// AddBlockedTimeIDs adds the "blocked_times" edge to the BlockedTime entity by IDs.
+func (luo *LocationUpdateOne) AddBlockedTimeIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddBlockedTimeIDs(ids...)
+	return luo
+}
+
+// AddBlockedTimes adds the "blocked_times" edges to the BlockedTime entity.
+func (luo *LocationUpdateOne) AddBlockedTimes(b ...*BlockedTime) *LocationUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return luo.AddBlockedTimeIDs(ids...)
+}
+
+// AddClosureDayIDs adds the "closure_days" edge to the ClosureDay entity by IDs.
+func (luo *LocationUpdateOne) AddClosureDayIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.AddClosureDayIDs(ids...)
+	return luo
+}
+
+// AddClosureDays adds the "closure_days" edges to the ClosureDay entity.
+func (luo *LocationUpdateOne) AddClosureDays(c ...*ClosureDay) *LocationUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return luo.AddClosureDayIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (luo *LocationUpdateOne) Mutation() *LocationMutation {
+	return luo.mutation
+}
+
+// ClearAppointments clears all "appointments" edges to the Appointment entity.
+func (luo *LocationUpdateOne) ClearAppointments() *LocationUpdateOne {
+	luo.mutation.ClearAppointments()
+	return luo
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to Appointment entities by IDs.
+func (luo *LocationUpdateOne) RemoveAppointmentIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveAppointmentIDs(ids...)
+	return luo
+}
+
+// RemoveAppointments removes "appointments" edges to Appointment entities.
+func (luo *LocationUpdateOne) RemoveAppointments(a ...*Appointment) *LocationUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return luo.RemoveAppointmentIDs(ids...)
+}
+
+// ClearOpeningHours clears all "opening_hours" edges to the OpeningHours entity.
+func (luo *LocationUpdateOne) ClearOpeningHours() *LocationUpdateOne {
+	luo.mutation.ClearOpeningHours()
+	return luo
+}
+
+// RemoveOpeningHourIDs removes the "opening_hours" edge to OpeningHours entities by IDs.
+func (luo *LocationUpdateOne) RemoveOpeningHourIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveOpeningHourIDs(ids...)
+	return luo
+}
+
+// RemoveOpeningHours removes "opening_hours" edges to OpeningHours entities.
+func (luo *LocationUpdateOne) RemoveOpeningHours(o ...*OpeningHours) *LocationUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return luo.RemoveOpeningHourIDs(ids...)
+}
+
+// ClearOpeningHoursExceptions clears all "opening_hours_exceptions" edges to the OpeningHoursException entity.
+func (luo *LocationUpdateOne) ClearOpeningHoursExceptions() *LocationUpdateOne {
+	luo.mutation.ClearOpeningHoursExceptions()
+	return luo
+}
+
+// RemoveOpeningHoursExceptionIDs removes the "opening_hours_exceptions" edge to OpeningHoursException entities by IDs.
+func (luo *LocationUpdateOne) RemoveOpeningHoursExceptionIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveOpeningHoursExceptionIDs(ids...)
+	return luo
+}
+
+// RemoveOpeningHoursExceptions removes "opening_hours_exceptions" edges to OpeningHoursException entities.
+func (luo *LocationUpdateOne) RemoveOpeningHoursExceptions(o ...*OpeningHoursException) *LocationUpdateOne {
+	ids := make([]int, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return luo.RemoveOpeningHoursExceptionIDs(ids...)
+}
+
+// ClearStaff clears all "staff" edges to the Staff entity.
+func (luo *LocationUpdateOne) ClearStaff() *LocationUpdateOne {
+	luo.mutation.ClearStaff()
+	return luo
+}
+
+// RemoveStaffIDs removes the "staff" edge to Staff entities by IDs.
+func (luo *LocationUpdateOne) RemoveStaffIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveStaffIDs(ids...)
+	return luo
+}
+
+// RemoveStaff removes "staff" edges to Staff entities.
+func (luo *LocationUpdateOne) RemoveStaff(s ...*Staff) *LocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.RemoveStaffIDs(ids...)
+}
+
+// ClearWaitlistEntries clears all "waitlist_entries" edges to the WaitlistEntry entity.
+func (luo *LocationUpdateOne) ClearWaitlistEntries() *LocationUpdateOne {
+	luo.mutation.ClearWaitlistEntries()
+	return luo
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to WaitlistEntry entities by IDs.
+func (luo *LocationUpdateOne) RemoveWaitlistEntryIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveWaitlistEntryIDs(ids...)
+	return luo
+}
+
+// RemoveWaitlistEntries removes "waitlist_entries" edges to WaitlistEntry entities.
+func (luo *LocationUpdateOne) RemoveWaitlistEntries(w ...*WaitlistEntry) *LocationUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return luo.RemoveWaitlistEntryIDs(ids...)
+}
+
+// ClearSlotHolds clears all "slot_holds" edges to the SlotHold entity.
+func (luo *LocationUpdateOne) ClearSlotHolds() *LocationUpdateOne {
+	luo.mutation.ClearSlotHolds()
+	return luo
+}
+
+// RemoveSlotHoldIDs removes the "slot_holds" edge to SlotHold entities by IDs.
+func (luo *LocationUpdateOne) RemoveSlotHoldIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveSlotHoldIDs(ids...)
+	return luo
+}
+
+// RemoveSlotHolds removes "slot_holds" edges to SlotHold entities.
+func (luo *LocationUpdateOne) RemoveSlotHolds(s ...*SlotHold) *LocationUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return luo.RemoveSlotHoldIDs(ids...)
+}
+
+// ClearBlockedTimes clears all "blocked_times" edges to the BlockedTime entity.
+func (luo *LocationUpdateOne) ClearBlockedTimes() *LocationUpdateOne {
+	luo.mutation.ClearBlockedTimes()
+	return luo
+}
+
+// RemoveBlockedTimeIDs removes the "blocked_times" edge to BlockedTime entities by IDs.
+func (luo *LocationUpdateOne) RemoveBlockedTimeIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveBlockedTimeIDs(ids...)
+	return luo
+}
+
+// RemoveBlockedTimes removes "blocked_times" edges to BlockedTime entities.
+func (luo *LocationUpdateOne) RemoveBlockedTimes(b ...*BlockedTime) *LocationUpdateOne {
+	ids := make([]int, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return luo.RemoveBlockedTimeIDs(ids...)
+}
+
+// ClearClosureDays clears all "closure_days" edges to the ClosureDay entity.
+func (luo *LocationUpdateOne) ClearClosureDays() *LocationUpdateOne {
+	luo.mutation.ClearClosureDays()
+	return luo
+}
+
+// RemoveClosureDayIDs removes the "closure_days" edge to ClosureDay entities by IDs.
+func (luo *LocationUpdateOne) RemoveClosureDayIDs(ids ...int) *LocationUpdateOne {
+	luo.mutation.RemoveClosureDayIDs(ids...)
+	return luo
+}
+
+// RemoveClosureDays removes "closure_days" edges to ClosureDay entities.
+func (luo *LocationUpdateOne) RemoveClosureDays(c ...*ClosureDay) *LocationUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return luo.RemoveClosureDayIDs(ids...)
+}
+
+// Where appends a list predicates to the LocationUpdate builder.
+func (luo *LocationUpdateOne) Where(ps ...predicate.Location) *LocationUpdateOne {
+	luo.mutation.Where(ps...)
+	return luo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (luo *LocationUpdateOne) Select(field string, fields ...string) *LocationUpdateOne {
+	luo.fields = append([]string{field}, fields...)
+	return luo
+}
+
+// Save executes the query and returns the updated Location entity.
+func (luo *LocationUpdateOne) Save(ctx context.Context) (*Location, error) {
+	return withHooks(ctx, luo.sqlSave, luo.mutation, luo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (luo *LocationUpdateOne) SaveX(ctx context.Context) *Location {
+	node, err := luo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (luo *LocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := luo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (luo *LocationUpdateOne) ExecX(ctx context.Context) {
+	if err := luo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (luo *LocationUpdateOne) check() error {
+	if v, ok := luo.mutation.Name(); ok {
+		if err := location.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Location.name": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.Timezone(); ok {
+		if err := location.TimezoneValidator(v); err != nil {
+			return &ValidationError{Name: "timezone", err: fmt.Errorf(`ent: validator failed for field "Location.timezone": %w`, err)}
+		}
+	}
+	if v, ok := luo.mutation.State(); ok {
+		if err := location.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "Location.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (luo *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err error) {
+	if err := luo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(location.Table, location.Columns, sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt))
+	id, ok := luo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Location.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := luo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, location.FieldID)
+		for _, f := range fields {
+			if !location.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != location.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := luo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := luo.mutation.Name(); ok {
+		_spec.SetField(location.FieldName, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.Address(); ok {
+		_spec.SetField(location.FieldAddress, field.TypeString, value)
+	}
+	if luo.mutation.AddressCleared() {
+		_spec.ClearField(location.FieldAddress, field.TypeString)
+	}
+	if value, ok := luo.mutation.Timezone(); ok {
+		_spec.SetField(location.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := luo.mutation.State(); ok {
+		_spec.SetField(location.FieldState, field.TypeString, value)
+	}
+	if luo.mutation.StateCleared() {
+		_spec.ClearField(location.FieldState, field.TypeString)
+	}
+	if luo.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedAppointmentsIDs(); len(nodes) > 0 && !luo.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.AppointmentsTable,
+			Columns: []string{location.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.OpeningHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedOpeningHoursIDs(); len(nodes) > 0 && !luo.mutation.OpeningHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.OpeningHoursIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursTable,
+			Columns: []string{location.OpeningHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.OpeningHoursExceptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedOpeningHoursExceptionsIDs(); len(nodes) > 0 && !luo.mutation.OpeningHoursExceptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.OpeningHoursExceptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OpeningHoursExceptionsTable,
+			Columns: []string{location.OpeningHoursExceptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedStaffIDs(); len(nodes) > 0 && !luo.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.StaffTable,
+			Columns: []string{location.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedWaitlistEntriesIDs(); len(nodes) > 0 && !luo.mutation.WaitlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.WaitlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.WaitlistEntriesTable,
+			Columns: []string{location.WaitlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedSlotHoldsIDs(); len(nodes) > 0 && !luo.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.SlotHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.SlotHoldsTable,
+			Columns: []string{location.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.BlockedTimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedBlockedTimesIDs(); len(nodes) > 0 && !luo.mutation.BlockedTimesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.BlockedTimesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.BlockedTimesTable,
+			Columns: []string{location.BlockedTimesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blockedtime.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if luo.mutation.ClosureDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.RemovedClosureDaysIDs(); len(nodes) > 0 && !luo.mutation.ClosureDaysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := luo.mutation.ClosureDaysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ClosureDaysTable,
+			Columns: []string{location.ClosureDaysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(closureday.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Location{config: luo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, luo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{location.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	luo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -19,18 +20,416 @@ var (
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
 		{Name: "description", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "checked_in", "completed", "no_show", "cancelled"}, Default: "confirmed"},
+		{Name: "cancelled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_reason", Type: field.TypeString, Nullable: true},
+		{Name: "confirm_by", Type: field.TypeTime, Nullable: true},
+		{Name: "counter", Type: field.TypeInt, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+		{Name: "staff_id", Type: field.TypeInt, Nullable: true},
 	}
 	// AppointmentsTable holds the schema information for the "appointments" table.
 	AppointmentsTable = &schema.Table{
 		Name:       "appointments",
 		Columns:    AppointmentsColumns,
 		PrimaryKey: []*schema.Column{AppointmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "appointments_locations_appointments",
+				Columns:    []*schema.Column{AppointmentsColumns[15]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "appointments_staffs_appointments",
+				Columns:    []*schema.Column{AppointmentsColumns[16]},
+				RefColumns: []*schema.Column{StaffsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "appointment_start_time_counter",
+				Unique:  true,
+				Columns: []*schema.Column{AppointmentsColumns[6], AppointmentsColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "location_id IS NULL AND status <> 'cancelled'",
+				},
+			},
+			{
+				Name:    "appointment_start_time_location_id_counter",
+				Unique:  true,
+				Columns: []*schema.Column{AppointmentsColumns[6], AppointmentsColumns[15], AppointmentsColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "location_id IS NOT NULL AND status <> 'cancelled'",
+				},
+			},
+			{
+				Name:    "appointment_start_time_staff_id",
+				Unique:  true,
+				Columns: []*schema.Column{AppointmentsColumns[6], AppointmentsColumns[16]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status <> 'cancelled'",
+				},
+			},
+			{
+				Name:    "appointment_status",
+				Unique:  false,
+				Columns: []*schema.Column{AppointmentsColumns[9]},
+			},
+		},
+	}
+	// BlockedTimesColumns holds the columns for the "blocked_times" table.
+	BlockedTimesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "uid", Type: field.TypeString},
+		{Name: "summary", Type: field.TypeString, Nullable: true},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BlockedTimesTable holds the schema information for the "blocked_times" table.
+	BlockedTimesTable = &schema.Table{
+		Name:       "blocked_times",
+		Columns:    BlockedTimesColumns,
+		PrimaryKey: []*schema.Column{BlockedTimesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blocked_times_locations_blocked_times",
+				Columns:    []*schema.Column{BlockedTimesColumns[7]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "blockedtime_start_time_end_time",
+				Unique:  false,
+				Columns: []*schema.Column{BlockedTimesColumns[4], BlockedTimesColumns[5]},
+			},
+			{
+				Name:    "blockedtime_name",
+				Unique:  true,
+				Columns: []*schema.Column{BlockedTimesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "location_id IS NULL",
+				},
+			},
+			{
+				Name:    "blockedtime_location_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{BlockedTimesColumns[7], BlockedTimesColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "location_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// ClosureDaysColumns holds the columns for the "closure_days" table.
+	ClosureDaysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+	}
+	// ClosureDaysTable holds the schema information for the "closure_days" table.
+	ClosureDaysTable = &schema.Table{
+		Name:       "closure_days",
+		Columns:    ClosureDaysColumns,
+		PrimaryKey: []*schema.Column{ClosureDaysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "closure_days_locations_closure_days",
+				Columns:    []*schema.Column{ClosureDaysColumns[3]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "closureday_date",
+				Unique:  true,
+				Columns: []*schema.Column{ClosureDaysColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "location_id IS NULL",
+				},
+			},
+			{
+				Name:    "closureday_location_id_date",
+				Unique:  true,
+				Columns: []*schema.Column{ClosureDaysColumns[3], ClosureDaysColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "location_id IS NOT NULL",
+				},
+			},
+		},
+	}
+	// LocationsColumns holds the columns for the "locations" table.
+	LocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Default: "Europe/Berlin"},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+	}
+	// LocationsTable holds the schema information for the "locations" table.
+	LocationsTable = &schema.Table{
+		Name:       "locations",
+		Columns:    LocationsColumns,
+		PrimaryKey: []*schema.Column{LocationsColumns[0]},
+	}
+	// OpeningHoursColumns holds the columns for the "opening_hours" table.
+	OpeningHoursColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "weekday", Type: field.TypeInt},
+		{Name: "open", Type: field.TypeString},
+		{Name: "close", Type: field.TypeString},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+	}
+	// OpeningHoursTable holds the schema information for the "opening_hours" table.
+	OpeningHoursTable = &schema.Table{
+		Name:       "opening_hours",
+		Columns:    OpeningHoursColumns,
+		PrimaryKey: []*schema.Column{OpeningHoursColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "opening_hours_locations_opening_hours",
+				Columns:    []*schema.Column{OpeningHoursColumns[4]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "openinghours_location_id_weekday",
+				Unique:  false,
+				Columns: []*schema.Column{OpeningHoursColumns[4], OpeningHoursColumns[1]},
+			},
+		},
+	}
+	// OpeningHoursExceptionsColumns holds the columns for the "opening_hours_exceptions" table.
+	OpeningHoursExceptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "date", Type: field.TypeString},
+		{Name: "open", Type: field.TypeString},
+		{Name: "close", Type: field.TypeString},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+	}
+	// OpeningHoursExceptionsTable holds the schema information for the "opening_hours_exceptions" table.
+	OpeningHoursExceptionsTable = &schema.Table{
+		Name:       "opening_hours_exceptions",
+		Columns:    OpeningHoursExceptionsColumns,
+		PrimaryKey: []*schema.Column{OpeningHoursExceptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "opening_hours_exceptions_locations_opening_hours_exceptions",
+				Columns:    []*schema.Column{OpeningHoursExceptionsColumns[4]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "openinghoursexception_location_id_date",
+				Unique:  false,
+				Columns: []*schema.Column{OpeningHoursExceptionsColumns[4], OpeningHoursExceptionsColumns[1]},
+			},
+		},
+	}
+	// RemindersColumns holds the columns for the "reminders" table.
+	RemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "offset", Type: field.TypeInt64},
+		{Name: "sent_at", Type: field.TypeTime},
+		{Name: "appointment_id", Type: field.TypeInt},
+	}
+	// RemindersTable holds the schema information for the "reminders" table.
+	RemindersTable = &schema.Table{
+		Name:       "reminders",
+		Columns:    RemindersColumns,
+		PrimaryKey: []*schema.Column{RemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminders_appointments_reminders",
+				Columns:    []*schema.Column{RemindersColumns[3]},
+				RefColumns: []*schema.Column{AppointmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminder_appointment_id_offset",
+				Unique:  true,
+				Columns: []*schema.Column{RemindersColumns[3], RemindersColumns[1]},
+			},
+		},
+	}
+	// SlotHoldsColumns holds the columns for the "slot_holds" table.
+	SlotHoldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "client", Type: field.TypeString, Nullable: true},
+		{Name: "address", Type: field.TypeString, Nullable: true},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+		{Name: "staff_id", Type: field.TypeInt, Nullable: true},
+	}
+	// SlotHoldsTable holds the schema information for the "slot_holds" table.
+	SlotHoldsTable = &schema.Table{
+		Name:       "slot_holds",
+		Columns:    SlotHoldsColumns,
+		PrimaryKey: []*schema.Column{SlotHoldsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "slot_holds_locations_slot_holds",
+				Columns:    []*schema.Column{SlotHoldsColumns[7]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "slot_holds_staffs_slot_holds",
+				Columns:    []*schema.Column{SlotHoldsColumns[8]},
+				RefColumns: []*schema.Column{StaffsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "slothold_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SlotHoldsColumns[4]},
+			},
+			{
+				Name:    "slothold_client",
+				Unique:  false,
+				Columns: []*schema.Column{SlotHoldsColumns[5]},
+			},
+			{
+				Name:    "slothold_address",
+				Unique:  false,
+				Columns: []*schema.Column{SlotHoldsColumns[6]},
+			},
+		},
+	}
+	// StaffsColumns holds the columns for the "staffs" table.
+	StaffsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "types", Type: field.TypeJSON, Nullable: true},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+	}
+	// StaffsTable holds the schema information for the "staffs" table.
+	StaffsTable = &schema.Table{
+		Name:       "staffs",
+		Columns:    StaffsColumns,
+		PrimaryKey: []*schema.Column{StaffsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "staffs_locations_staff",
+				Columns:    []*schema.Column{StaffsColumns[3]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WaitlistEntriesColumns holds the columns for the "waitlist_entries" table.
+	WaitlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString},
+		{Name: "phone", Type: field.TypeString},
+		{Name: "date", Type: field.TypeString},
+		{Name: "window_start", Type: field.TypeString, Nullable: true},
+		{Name: "window_end", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"waiting", "offered", "claimed", "expired"}, Default: "waiting"},
+		{Name: "token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "offered_start", Type: field.TypeTime, Nullable: true},
+		{Name: "offered_end", Type: field.TypeTime, Nullable: true},
+		{Name: "offer_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "location_id", Type: field.TypeInt, Nullable: true},
+	}
+	// WaitlistEntriesTable holds the schema information for the "waitlist_entries" table.
+	WaitlistEntriesTable = &schema.Table{
+		Name:       "waitlist_entries",
+		Columns:    WaitlistEntriesColumns,
+		PrimaryKey: []*schema.Column{WaitlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "waitlist_entries_locations_waitlist_entries",
+				Columns:    []*schema.Column{WaitlistEntriesColumns[14]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "waitlistentry_date_status",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[4], WaitlistEntriesColumns[8]},
+			},
+			{
+				Name:    "waitlistentry_status_offer_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{WaitlistEntriesColumns[8], WaitlistEntriesColumns[12]},
+			},
+		},
+	}
+	// WorkingHoursColumns holds the columns for the "working_hours" table.
+	WorkingHoursColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "weekday", Type: field.TypeInt},
+		{Name: "start", Type: field.TypeString},
+		{Name: "end", Type: field.TypeString},
+		{Name: "staff_working_hours", Type: field.TypeInt},
+	}
+	// WorkingHoursTable holds the schema information for the "working_hours" table.
+	WorkingHoursTable = &schema.Table{
+		Name:       "working_hours",
+		Columns:    WorkingHoursColumns,
+		PrimaryKey: []*schema.Column{WorkingHoursColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "working_hours_staffs_working_hours",
+				Columns:    []*schema.Column{WorkingHoursColumns[4]},
+				RefColumns: []*schema.Column{StaffsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AppointmentsTable,
+		BlockedTimesTable,
+		ClosureDaysTable,
+		LocationsTable,
+		OpeningHoursTable,
+		OpeningHoursExceptionsTable,
+		RemindersTable,
+		SlotHoldsTable,
+		StaffsTable,
+		WaitlistEntriesTable,
+		WorkingHoursTable,
 	}
 )
 
 func init() {
+	AppointmentsTable.ForeignKeys[0].RefTable = LocationsTable
+	AppointmentsTable.ForeignKeys[1].RefTable = StaffsTable
+	BlockedTimesTable.ForeignKeys[0].RefTable = LocationsTable
+	ClosureDaysTable.ForeignKeys[0].RefTable = LocationsTable
+	OpeningHoursTable.ForeignKeys[0].RefTable = LocationsTable
+	OpeningHoursExceptionsTable.ForeignKeys[0].RefTable = LocationsTable
+	RemindersTable.ForeignKeys[0].RefTable = AppointmentsTable
+	SlotHoldsTable.ForeignKeys[0].RefTable = LocationsTable
+	SlotHoldsTable.ForeignKeys[1].RefTable = StaffsTable
+	StaffsTable.ForeignKeys[0].RefTable = LocationsTable
+	WaitlistEntriesTable.ForeignKeys[0].RefTable = LocationsTable
+	WorkingHoursTable.ForeignKeys[0].RefTable = StaffsTable
 }
@@ -4,7 +4,17 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
 	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/reminder"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"TerminSystem/ent/workinghours"
 	"context"
 	"errors"
 	"fmt"
@@ -24,27 +34,51 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAppointment = "Appointment"
+	TypeAppointment           = "Appointment"
+	TypeBlockedTime           = "BlockedTime"
+	TypeClosureDay            = "ClosureDay"
+	TypeLocation              = "Location"
+	TypeOpeningHours          = "OpeningHours"
+	TypeOpeningHoursException = "OpeningHoursException"
+	TypeReminder              = "Reminder"
+	TypeSlotHold              = "SlotHold"
+	TypeStaff                 = "Staff"
+	TypeWaitlistEntry         = "WaitlistEntry"
+	TypeWorkingHours          = "WorkingHours"
 )
 
 // AppointmentMutation represents an operation that mutates the Appointment nodes in the graph.
 type AppointmentMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	email         *string
-	phone         *string
-	_type         *appointment.Type
-	delkey        *string
-	start_time    *time.Time
-	end_time      *time.Time
-	description   *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Appointment, error)
-	predicates    []predicate.Appointment
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	email            *string
+	phone            *string
+	_type            *appointment.Type
+	delkey           *string
+	start_time       *time.Time
+	end_time         *time.Time
+	description      *string
+	status           *appointment.Status
+	cancelled_at     *time.Time
+	cancel_reason    *string
+	confirm_by       *time.Time
+	counter          *int
+	addcounter       *int
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	staff            *int
+	clearedstaff     bool
+	location         *int
+	clearedlocation  bool
+	reminders        map[int]struct{}
+	removedreminders map[int]struct{}
+	clearedreminders bool
+	done             bool
+	oldValue         func(context.Context) (*Appointment, error)
+	predicates       []predicate.Appointment
 }
 
 var _ ent.Mutation = (*AppointmentMutation)(nil)
@@ -433,6 +467,514 @@ func (m *AppointmentMutation) ResetDescription() {
 	m.description = nil
 }
 
+// SetStatus sets the "status" field.
+func (m *AppointmentMutation) SetStatus(a appointment.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AppointmentMutation) Status() (r appointment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldStatus(ctx context.Context) (v appointment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AppointmentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCancelledAt sets the "cancelled_at" field.
+func (m *AppointmentMutation) SetCancelledAt(t time.Time) {
+	m.cancelled_at = &t
+}
+
+// CancelledAt returns the value of the "cancelled_at" field in the mutation.
+func (m *AppointmentMutation) CancelledAt() (r time.Time, exists bool) {
+	v := m.cancelled_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelledAt returns the old "cancelled_at" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldCancelledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelledAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelledAt: %w", err)
+	}
+	return oldValue.CancelledAt, nil
+}
+
+// ClearCancelledAt clears the value of the "cancelled_at" field.
+func (m *AppointmentMutation) ClearCancelledAt() {
+	m.cancelled_at = nil
+	m.clearedFields[appointment.FieldCancelledAt] = struct{}{}
+}
+
+// CancelledAtCleared returns if the "cancelled_at" field was cleared in this mutation.
+func (m *AppointmentMutation) CancelledAtCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldCancelledAt]
+	return ok
+}
+
+// ResetCancelledAt resets all changes to the "cancelled_at" field.
+func (m *AppointmentMutation) ResetCancelledAt() {
+	m.cancelled_at = nil
+	delete(m.clearedFields, appointment.FieldCancelledAt)
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (m *AppointmentMutation) SetCancelReason(s string) {
+	m.cancel_reason = &s
+}
+
+// CancelReason returns the value of the "cancel_reason" field in the mutation.
+func (m *AppointmentMutation) CancelReason() (r string, exists bool) {
+	v := m.cancel_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancelReason returns the old "cancel_reason" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldCancelReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancelReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancelReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancelReason: %w", err)
+	}
+	return oldValue.CancelReason, nil
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (m *AppointmentMutation) ClearCancelReason() {
+	m.cancel_reason = nil
+	m.clearedFields[appointment.FieldCancelReason] = struct{}{}
+}
+
+// CancelReasonCleared returns if the "cancel_reason" field was cleared in this mutation.
+func (m *AppointmentMutation) CancelReasonCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldCancelReason]
+	return ok
+}
+
+// ResetCancelReason resets all changes to the "cancel_reason" field.
+func (m *AppointmentMutation) ResetCancelReason() {
+	m.cancel_reason = nil
+	delete(m.clearedFields, appointment.FieldCancelReason)
+}
+
+// SetConfirmBy sets the "confirm_by" field.
+func (m *AppointmentMutation) SetConfirmBy(t time.Time) {
+	m.confirm_by = &t
+}
+
+// ConfirmBy returns the value of the "confirm_by" field in the mutation.
+func (m *AppointmentMutation) ConfirmBy() (r time.Time, exists bool) {
+	v := m.confirm_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfirmBy returns the old "confirm_by" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldConfirmBy(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfirmBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfirmBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfirmBy: %w", err)
+	}
+	return oldValue.ConfirmBy, nil
+}
+
+// ClearConfirmBy clears the value of the "confirm_by" field.
+func (m *AppointmentMutation) ClearConfirmBy() {
+	m.confirm_by = nil
+	m.clearedFields[appointment.FieldConfirmBy] = struct{}{}
+}
+
+// ConfirmByCleared returns if the "confirm_by" field was cleared in this mutation.
+func (m *AppointmentMutation) ConfirmByCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldConfirmBy]
+	return ok
+}
+
+// ResetConfirmBy resets all changes to the "confirm_by" field.
+func (m *AppointmentMutation) ResetConfirmBy() {
+	m.confirm_by = nil
+	delete(m.clearedFields, appointment.FieldConfirmBy)
+}
+
+// SetCounter sets the "counter" field.
+func (m *AppointmentMutation) SetCounter(i int) {
+	m.counter = &i
+	m.addcounter = nil
+}
+
+// Counter returns the value of the "counter" field in the mutation.
+func (m *AppointmentMutation) Counter() (r int, exists bool) {
+	v := m.counter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCounter returns the old "counter" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldCounter(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCounter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCounter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCounter: %w", err)
+	}
+	return oldValue.Counter, nil
+}
+
+// AddCounter adds i to the "counter" field.
+func (m *AppointmentMutation) AddCounter(i int) {
+	if m.addcounter != nil {
+		*m.addcounter += i
+	} else {
+		m.addcounter = &i
+	}
+}
+
+// AddedCounter returns the value that was added to the "counter" field in this mutation.
+func (m *AppointmentMutation) AddedCounter() (r int, exists bool) {
+	v := m.addcounter
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCounter clears the value of the "counter" field.
+func (m *AppointmentMutation) ClearCounter() {
+	m.counter = nil
+	m.addcounter = nil
+	m.clearedFields[appointment.FieldCounter] = struct{}{}
+}
+
+// CounterCleared returns if the "counter" field was cleared in this mutation.
+func (m *AppointmentMutation) CounterCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldCounter]
+	return ok
+}
+
+// ResetCounter resets all changes to the "counter" field.
+func (m *AppointmentMutation) ResetCounter() {
+	m.counter = nil
+	m.addcounter = nil
+	delete(m.clearedFields, appointment.FieldCounter)
+}
+
+// SetStaffID sets the "staff_id" field.
+func (m *AppointmentMutation) SetStaffID(i int) {
+	m.staff = &i
+}
+
+// StaffID returns the value of the "staff_id" field in the mutation.
+func (m *AppointmentMutation) StaffID() (r int, exists bool) {
+	v := m.staff
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStaffID returns the old "staff_id" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldStaffID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStaffID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStaffID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStaffID: %w", err)
+	}
+	return oldValue.StaffID, nil
+}
+
+// ClearStaffID clears the value of the "staff_id" field.
+func (m *AppointmentMutation) ClearStaffID() {
+	m.staff = nil
+	m.clearedFields[appointment.FieldStaffID] = struct{}{}
+}
+
+// StaffIDCleared returns if the "staff_id" field was cleared in this mutation.
+func (m *AppointmentMutation) StaffIDCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldStaffID]
+	return ok
+}
+
+// ResetStaffID resets all changes to the "staff_id" field.
+func (m *AppointmentMutation) ResetStaffID() {
+	m.staff = nil
+	delete(m.clearedFields, appointment.FieldStaffID)
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *AppointmentMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *AppointmentMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *AppointmentMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[appointment.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *AppointmentMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *AppointmentMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, appointment.FieldLocationID)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AppointmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AppointmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Appointment entity.
+// If the Appointment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AppointmentMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *AppointmentMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[appointment.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *AppointmentMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[appointment.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AppointmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, appointment.FieldUpdatedAt)
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (m *AppointmentMutation) ClearStaff() {
+	m.clearedstaff = true
+	m.clearedFields[appointment.FieldStaffID] = struct{}{}
+}
+
+// StaffCleared reports if the "staff" edge to the Staff entity was cleared.
+func (m *AppointmentMutation) StaffCleared() bool {
+	return m.StaffIDCleared() || m.clearedstaff
+}
+
+// StaffIDs returns the "staff" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StaffID instead. It exists only for internal usage by the builders.
+func (m *AppointmentMutation) StaffIDs() (ids []int) {
+	if id := m.staff; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStaff resets all changes to the "staff" edge.
+func (m *AppointmentMutation) ResetStaff() {
+	m.staff = nil
+	m.clearedstaff = false
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *AppointmentMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[appointment.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *AppointmentMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *AppointmentMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *AppointmentMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// AddReminderIDs adds the "reminders" edge to the Reminder entity by ids.
+func (m *AppointmentMutation) AddReminderIDs(ids ...int) {
+	if m.reminders == nil {
+		m.reminders = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reminders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReminders clears the "reminders" edge to the Reminder entity.
+func (m *AppointmentMutation) ClearReminders() {
+	m.clearedreminders = true
+}
+
+// RemindersCleared reports if the "reminders" edge to the Reminder entity was cleared.
+func (m *AppointmentMutation) RemindersCleared() bool {
+	return m.clearedreminders
+}
+
+// RemoveReminderIDs removes the "reminders" edge to the Reminder entity by IDs.
+func (m *AppointmentMutation) RemoveReminderIDs(ids ...int) {
+	if m.removedreminders == nil {
+		m.removedreminders = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reminders, ids[i])
+		m.removedreminders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReminders returns the removed IDs of the "reminders" edge to the Reminder entity.
+func (m *AppointmentMutation) RemovedRemindersIDs() (ids []int) {
+	for id := range m.removedreminders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RemindersIDs returns the "reminders" edge IDs in the mutation.
+func (m *AppointmentMutation) RemindersIDs() (ids []int) {
+	for id := range m.reminders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReminders resets all changes to the "reminders" edge.
+func (m *AppointmentMutation) ResetReminders() {
+	m.reminders = nil
+	m.clearedreminders = false
+	m.removedreminders = nil
+}
+
 // Where appends a list predicates to the AppointmentMutation builder.
 func (m *AppointmentMutation) Where(ps ...predicate.Appointment) {
 	m.predicates = append(m.predicates, ps...)
@@ -467,7 +1009,7 @@ func (m *AppointmentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AppointmentMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 16)
 	if m.name != nil {
 		fields = append(fields, appointment.FieldName)
 	}
@@ -492,6 +1034,30 @@ func (m *AppointmentMutation) Fields() []string {
 	if m.description != nil {
 		fields = append(fields, appointment.FieldDescription)
 	}
+	if m.status != nil {
+		fields = append(fields, appointment.FieldStatus)
+	}
+	if m.cancelled_at != nil {
+		fields = append(fields, appointment.FieldCancelledAt)
+	}
+	if m.cancel_reason != nil {
+		fields = append(fields, appointment.FieldCancelReason)
+	}
+	if m.confirm_by != nil {
+		fields = append(fields, appointment.FieldConfirmBy)
+	}
+	if m.counter != nil {
+		fields = append(fields, appointment.FieldCounter)
+	}
+	if m.staff != nil {
+		fields = append(fields, appointment.FieldStaffID)
+	}
+	if m.location != nil {
+		fields = append(fields, appointment.FieldLocationID)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, appointment.FieldUpdatedAt)
+	}
 	return fields
 }
 
@@ -516,6 +1082,22 @@ func (m *AppointmentMutation) Field(name string) (ent.Value, bool) {
 		return m.EndTime()
 	case appointment.FieldDescription:
 		return m.Description()
+	case appointment.FieldStatus:
+		return m.Status()
+	case appointment.FieldCancelledAt:
+		return m.CancelledAt()
+	case appointment.FieldCancelReason:
+		return m.CancelReason()
+	case appointment.FieldConfirmBy:
+		return m.ConfirmBy()
+	case appointment.FieldCounter:
+		return m.Counter()
+	case appointment.FieldStaffID:
+		return m.StaffID()
+	case appointment.FieldLocationID:
+		return m.LocationID()
+	case appointment.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -541,6 +1123,22 @@ func (m *AppointmentMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldEndTime(ctx)
 	case appointment.FieldDescription:
 		return m.OldDescription(ctx)
+	case appointment.FieldStatus:
+		return m.OldStatus(ctx)
+	case appointment.FieldCancelledAt:
+		return m.OldCancelledAt(ctx)
+	case appointment.FieldCancelReason:
+		return m.OldCancelReason(ctx)
+	case appointment.FieldConfirmBy:
+		return m.OldConfirmBy(ctx)
+	case appointment.FieldCounter:
+		return m.OldCounter(ctx)
+	case appointment.FieldStaffID:
+		return m.OldStaffID(ctx)
+	case appointment.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case appointment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Appointment field %s", name)
 }
@@ -606,6 +1204,62 @@ func (m *AppointmentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDescription(v)
 		return nil
+	case appointment.FieldStatus:
+		v, ok := value.(appointment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case appointment.FieldCancelledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelledAt(v)
+		return nil
+	case appointment.FieldCancelReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancelReason(v)
+		return nil
+	case appointment.FieldConfirmBy:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmBy(v)
+		return nil
+	case appointment.FieldCounter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCounter(v)
+		return nil
+	case appointment.FieldStaffID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStaffID(v)
+		return nil
+	case appointment.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	case appointment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Appointment field %s", name)
 }
@@ -613,13 +1267,21 @@ func (m *AppointmentMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *AppointmentMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addcounter != nil {
+		fields = append(fields, appointment.FieldCounter)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *AppointmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case appointment.FieldCounter:
+		return m.AddedCounter()
+	}
 	return nil, false
 }
 
@@ -628,6 +1290,13 @@ func (m *AppointmentMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *AppointmentMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case appointment.FieldCounter:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCounter(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Appointment numeric field %s", name)
 }
@@ -635,7 +1304,29 @@ func (m *AppointmentMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *AppointmentMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(appointment.FieldCancelledAt) {
+		fields = append(fields, appointment.FieldCancelledAt)
+	}
+	if m.FieldCleared(appointment.FieldCancelReason) {
+		fields = append(fields, appointment.FieldCancelReason)
+	}
+	if m.FieldCleared(appointment.FieldConfirmBy) {
+		fields = append(fields, appointment.FieldConfirmBy)
+	}
+	if m.FieldCleared(appointment.FieldCounter) {
+		fields = append(fields, appointment.FieldCounter)
+	}
+	if m.FieldCleared(appointment.FieldStaffID) {
+		fields = append(fields, appointment.FieldStaffID)
+	}
+	if m.FieldCleared(appointment.FieldLocationID) {
+		fields = append(fields, appointment.FieldLocationID)
+	}
+	if m.FieldCleared(appointment.FieldUpdatedAt) {
+		fields = append(fields, appointment.FieldUpdatedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -648,6 +1339,29 @@ func (m *AppointmentMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *AppointmentMutation) ClearField(name string) error {
+	switch name {
+	case appointment.FieldCancelledAt:
+		m.ClearCancelledAt()
+		return nil
+	case appointment.FieldCancelReason:
+		m.ClearCancelReason()
+		return nil
+	case appointment.FieldConfirmBy:
+		m.ClearConfirmBy()
+		return nil
+	case appointment.FieldCounter:
+		m.ClearCounter()
+		return nil
+	case appointment.FieldStaffID:
+		m.ClearStaffID()
+		return nil
+	case appointment.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	case appointment.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Appointment nullable field %s", name)
 }
 
@@ -679,54 +1393,7787 @@ func (m *AppointmentMutation) ResetField(name string) error {
 	case appointment.FieldDescription:
 		m.ResetDescription()
 		return nil
+	case appointment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case appointment.FieldCancelledAt:
+		m.ResetCancelledAt()
+		return nil
+	case appointment.FieldCancelReason:
+		m.ResetCancelReason()
+		return nil
+	case appointment.FieldConfirmBy:
+		m.ResetConfirmBy()
+		return nil
+	case appointment.FieldCounter:
+		m.ResetCounter()
+		return nil
+	case appointment.FieldStaffID:
+		m.ResetStaffID()
+		return nil
+	case appointment.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case appointment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Appointment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AppointmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 3)
+	if m.staff != nil {
+		edges = append(edges, appointment.EdgeStaff)
+	}
+	if m.location != nil {
+		edges = append(edges, appointment.EdgeLocation)
+	}
+	if m.reminders != nil {
+		edges = append(edges, appointment.EdgeReminders)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *AppointmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case appointment.EdgeStaff:
+		if id := m.staff; id != nil {
+			return []ent.Value{*id}
+		}
+	case appointment.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	case appointment.EdgeReminders:
+		ids := make([]ent.Value, 0, len(m.reminders))
+		for id := range m.reminders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AppointmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 3)
+	if m.removedreminders != nil {
+		edges = append(edges, appointment.EdgeReminders)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *AppointmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case appointment.EdgeReminders:
+		ids := make([]ent.Value, 0, len(m.removedreminders))
+		for id := range m.removedreminders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AppointmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 3)
+	if m.clearedstaff {
+		edges = append(edges, appointment.EdgeStaff)
+	}
+	if m.clearedlocation {
+		edges = append(edges, appointment.EdgeLocation)
+	}
+	if m.clearedreminders {
+		edges = append(edges, appointment.EdgeReminders)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *AppointmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case appointment.EdgeStaff:
+		return m.clearedstaff
+	case appointment.EdgeLocation:
+		return m.clearedlocation
+	case appointment.EdgeReminders:
+		return m.clearedreminders
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *AppointmentMutation) ClearEdge(name string) error {
+	switch name {
+	case appointment.EdgeStaff:
+		m.ClearStaff()
+		return nil
+	case appointment.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
 	return fmt.Errorf("unknown Appointment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *AppointmentMutation) ResetEdge(name string) error {
+	switch name {
+	case appointment.EdgeStaff:
+		m.ResetStaff()
+		return nil
+	case appointment.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	case appointment.EdgeReminders:
+		m.ResetReminders()
+		return nil
+	}
 	return fmt.Errorf("unknown Appointment edge %s", name)
 }
+
+// BlockedTimeMutation represents an operation that mutates the BlockedTime nodes in the graph.
+type BlockedTimeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	uid             *string
+	summary         *string
+	start_time      *time.Time
+	end_time        *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*BlockedTime, error)
+	predicates      []predicate.BlockedTime
+}
+
+var _ ent.Mutation = (*BlockedTimeMutation)(nil)
+
+// blockedtimeOption allows management of the mutation configuration using functional options.
+type blockedtimeOption func(*BlockedTimeMutation)
+
+// newBlockedTimeMutation creates new mutation for the BlockedTime entity.
+func newBlockedTimeMutation(c config, op Op, opts ...blockedtimeOption) *BlockedTimeMutation {
+	m := &BlockedTimeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlockedTime,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlockedTimeID sets the ID field of the mutation.
+func withBlockedTimeID(id int) blockedtimeOption {
+	return func(m *BlockedTimeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BlockedTime
+		)
+		m.oldValue = func(ctx context.Context) (*BlockedTime, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BlockedTime.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlockedTime sets the old BlockedTime of the mutation.
+func withBlockedTime(node *BlockedTime) blockedtimeOption {
+	return func(m *BlockedTimeMutation) {
+		m.oldValue = func(context.Context) (*BlockedTime, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlockedTimeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlockedTimeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlockedTimeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlockedTimeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BlockedTime.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *BlockedTimeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BlockedTimeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *BlockedTimeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUID sets the "uid" field.
+func (m *BlockedTimeMutation) SetUID(s string) {
+	m.uid = &s
+}
+
+// UID returns the value of the "uid" field in the mutation.
+func (m *BlockedTimeMutation) UID() (r string, exists bool) {
+	v := m.uid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUID returns the old "uid" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUID: %w", err)
+	}
+	return oldValue.UID, nil
+}
+
+// ResetUID resets all changes to the "uid" field.
+func (m *BlockedTimeMutation) ResetUID() {
+	m.uid = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *BlockedTimeMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *BlockedTimeMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *BlockedTimeMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[blockedtime.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *BlockedTimeMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[blockedtime.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *BlockedTimeMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, blockedtime.FieldSummary)
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *BlockedTimeMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *BlockedTimeMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *BlockedTimeMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *BlockedTimeMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *BlockedTimeMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *BlockedTimeMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *BlockedTimeMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *BlockedTimeMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *BlockedTimeMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[blockedtime.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *BlockedTimeMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[blockedtime.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *BlockedTimeMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, blockedtime.FieldLocationID)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BlockedTimeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BlockedTimeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BlockedTime entity.
+// If the BlockedTime object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockedTimeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BlockedTimeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *BlockedTimeMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[blockedtime.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *BlockedTimeMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *BlockedTimeMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *BlockedTimeMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the BlockedTimeMutation builder.
+func (m *BlockedTimeMutation) Where(ps ...predicate.BlockedTime) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlockedTimeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlockedTimeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BlockedTime, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlockedTimeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlockedTimeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BlockedTime).
+func (m *BlockedTimeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlockedTimeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.name != nil {
+		fields = append(fields, blockedtime.FieldName)
+	}
+	if m.uid != nil {
+		fields = append(fields, blockedtime.FieldUID)
+	}
+	if m.summary != nil {
+		fields = append(fields, blockedtime.FieldSummary)
+	}
+	if m.start_time != nil {
+		fields = append(fields, blockedtime.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, blockedtime.FieldEndTime)
+	}
+	if m.location != nil {
+		fields = append(fields, blockedtime.FieldLocationID)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, blockedtime.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlockedTimeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blockedtime.FieldName:
+		return m.Name()
+	case blockedtime.FieldUID:
+		return m.UID()
+	case blockedtime.FieldSummary:
+		return m.Summary()
+	case blockedtime.FieldStartTime:
+		return m.StartTime()
+	case blockedtime.FieldEndTime:
+		return m.EndTime()
+	case blockedtime.FieldLocationID:
+		return m.LocationID()
+	case blockedtime.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlockedTimeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blockedtime.FieldName:
+		return m.OldName(ctx)
+	case blockedtime.FieldUID:
+		return m.OldUID(ctx)
+	case blockedtime.FieldSummary:
+		return m.OldSummary(ctx)
+	case blockedtime.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case blockedtime.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case blockedtime.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case blockedtime.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BlockedTime field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockedTimeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blockedtime.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case blockedtime.FieldUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUID(v)
+		return nil
+	case blockedtime.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case blockedtime.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case blockedtime.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case blockedtime.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	case blockedtime.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlockedTime field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlockedTimeMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlockedTimeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlockedTimeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BlockedTime numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlockedTimeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(blockedtime.FieldSummary) {
+		fields = append(fields, blockedtime.FieldSummary)
+	}
+	if m.FieldCleared(blockedtime.FieldLocationID) {
+		fields = append(fields, blockedtime.FieldLocationID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlockedTimeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlockedTimeMutation) ClearField(name string) error {
+	switch name {
+	case blockedtime.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case blockedtime.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockedTime nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlockedTimeMutation) ResetField(name string) error {
+	switch name {
+	case blockedtime.FieldName:
+		m.ResetName()
+		return nil
+	case blockedtime.FieldUID:
+		m.ResetUID()
+		return nil
+	case blockedtime.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case blockedtime.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case blockedtime.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case blockedtime.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case blockedtime.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockedTime field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlockedTimeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.location != nil {
+		edges = append(edges, blockedtime.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlockedTimeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case blockedtime.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlockedTimeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlockedTimeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlockedTimeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlocation {
+		edges = append(edges, blockedtime.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlockedTimeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case blockedtime.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlockedTimeMutation) ClearEdge(name string) error {
+	switch name {
+	case blockedtime.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockedTime unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlockedTimeMutation) ResetEdge(name string) error {
+	switch name {
+	case blockedtime.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown BlockedTime edge %s", name)
+}
+
+// ClosureDayMutation represents an operation that mutates the ClosureDay nodes in the graph.
+type ClosureDayMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	date            *string
+	reason          *string
+	clearedFields   map[string]struct{}
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*ClosureDay, error)
+	predicates      []predicate.ClosureDay
+}
+
+var _ ent.Mutation = (*ClosureDayMutation)(nil)
+
+// closuredayOption allows management of the mutation configuration using functional options.
+type closuredayOption func(*ClosureDayMutation)
+
+// newClosureDayMutation creates new mutation for the ClosureDay entity.
+func newClosureDayMutation(c config, op Op, opts ...closuredayOption) *ClosureDayMutation {
+	m := &ClosureDayMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeClosureDay,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withClosureDayID sets the ID field of the mutation.
+func withClosureDayID(id int) closuredayOption {
+	return func(m *ClosureDayMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ClosureDay
+		)
+		m.oldValue = func(ctx context.Context) (*ClosureDay, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ClosureDay.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withClosureDay sets the old ClosureDay of the mutation.
+func withClosureDay(node *ClosureDay) closuredayOption {
+	return func(m *ClosureDayMutation) {
+		m.oldValue = func(context.Context) (*ClosureDay, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ClosureDayMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ClosureDayMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ClosureDayMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ClosureDayMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ClosureDay.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDate sets the "date" field.
+func (m *ClosureDayMutation) SetDate(s string) {
+	m.date = &s
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *ClosureDayMutation) Date() (r string, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the ClosureDay entity.
+// If the ClosureDay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClosureDayMutation) OldDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *ClosureDayMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ClosureDayMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ClosureDayMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ClosureDay entity.
+// If the ClosureDay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClosureDayMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ClosureDayMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[closureday.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ClosureDayMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[closureday.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ClosureDayMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, closureday.FieldReason)
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *ClosureDayMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *ClosureDayMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the ClosureDay entity.
+// If the ClosureDay object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ClosureDayMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *ClosureDayMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[closureday.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *ClosureDayMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[closureday.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *ClosureDayMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, closureday.FieldLocationID)
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *ClosureDayMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[closureday.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *ClosureDayMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *ClosureDayMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *ClosureDayMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the ClosureDayMutation builder.
+func (m *ClosureDayMutation) Where(ps ...predicate.ClosureDay) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ClosureDayMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ClosureDayMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ClosureDay, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ClosureDayMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ClosureDayMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ClosureDay).
+func (m *ClosureDayMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ClosureDayMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.date != nil {
+		fields = append(fields, closureday.FieldDate)
+	}
+	if m.reason != nil {
+		fields = append(fields, closureday.FieldReason)
+	}
+	if m.location != nil {
+		fields = append(fields, closureday.FieldLocationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ClosureDayMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case closureday.FieldDate:
+		return m.Date()
+	case closureday.FieldReason:
+		return m.Reason()
+	case closureday.FieldLocationID:
+		return m.LocationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ClosureDayMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case closureday.FieldDate:
+		return m.OldDate(ctx)
+	case closureday.FieldReason:
+		return m.OldReason(ctx)
+	case closureday.FieldLocationID:
+		return m.OldLocationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ClosureDay field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClosureDayMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case closureday.FieldDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case closureday.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case closureday.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ClosureDay field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ClosureDayMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ClosureDayMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ClosureDayMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ClosureDay numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ClosureDayMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(closureday.FieldReason) {
+		fields = append(fields, closureday.FieldReason)
+	}
+	if m.FieldCleared(closureday.FieldLocationID) {
+		fields = append(fields, closureday.FieldLocationID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ClosureDayMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ClosureDayMutation) ClearField(name string) error {
+	switch name {
+	case closureday.FieldReason:
+		m.ClearReason()
+		return nil
+	case closureday.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown ClosureDay nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ClosureDayMutation) ResetField(name string) error {
+	switch name {
+	case closureday.FieldDate:
+		m.ResetDate()
+		return nil
+	case closureday.FieldReason:
+		m.ResetReason()
+		return nil
+	case closureday.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown ClosureDay field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ClosureDayMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.location != nil {
+		edges = append(edges, closureday.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ClosureDayMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case closureday.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ClosureDayMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ClosureDayMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ClosureDayMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlocation {
+		edges = append(edges, closureday.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ClosureDayMutation) EdgeCleared(name string) bool {
+	switch name {
+	case closureday.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ClosureDayMutation) ClearEdge(name string) error {
+	switch name {
+	case closureday.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown ClosureDay unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ClosureDayMutation) ResetEdge(name string) error {
+	switch name {
+	case closureday.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown ClosureDay edge %s", name)
+}
+
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
+	config
+	op                              Op
+	typ                             string
+	id                              *int
+	name                            *string
+	address                         *string
+	timezone                        *string
+	state                           *string
+	clearedFields                   map[string]struct{}
+	appointments                    map[int]struct{}
+	removedappointments             map[int]struct{}
+	clearedappointments             bool
+	opening_hours                   map[int]struct{}
+	removedopening_hours            map[int]struct{}
+	clearedopening_hours            bool
+	opening_hours_exceptions        map[int]struct{}
+	removedopening_hours_exceptions map[int]struct{}
+	clearedopening_hours_exceptions bool
+	staff                           map[int]struct{}
+	removedstaff                    map[int]struct{}
+	clearedstaff                    bool
+	waitlist_entries                map[int]struct{}
+	removedwaitlist_entries         map[int]struct{}
+	clearedwaitlist_entries         bool
+	slot_holds                      map[int]struct{}
+	removedslot_holds               map[int]struct{}
+	clearedslot_holds               bool
+	blocked_times                   map[int]struct{}
+	removedblocked_times            map[int]struct{}
+	clearedblocked_times            bool
+	closure_days                    map[int]struct{}
+	removedclosure_days             map[int]struct{}
+	clearedclosure_days             bool
+	done                            bool
+	oldValue                        func(context.Context) (*Location, error)
+	predicates                      []predicate.Location
+}
+
+var _ ent.Mutation = (*LocationMutation)(nil)
+
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
+
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id int) locationOption {
+	return func(m *LocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Location
+		)
+		m.oldValue = func(ctx context.Context) (*Location, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Location.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LocationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAddress sets the "address" field.
+func (m *LocationMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *LocationMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *LocationMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[location.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *LocationMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[location.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *LocationMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, location.FieldAddress)
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *LocationMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *LocationMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *LocationMutation) ResetTimezone() {
+	m.timezone = nil
+}
+
+// SetState sets the "state" field.
+func (m *LocationMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *LocationMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldState(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ClearState clears the value of the "state" field.
+func (m *LocationMutation) ClearState() {
+	m.state = nil
+	m.clearedFields[location.FieldState] = struct{}{}
+}
+
+// StateCleared returns if the "state" field was cleared in this mutation.
+func (m *LocationMutation) StateCleared() bool {
+	_, ok := m.clearedFields[location.FieldState]
+	return ok
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *LocationMutation) ResetState() {
+	m.state = nil
+	delete(m.clearedFields, location.FieldState)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by ids.
+func (m *LocationMutation) AddAppointmentIDs(ids ...int) {
+	if m.appointments == nil {
+		m.appointments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.appointments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAppointments clears the "appointments" edge to the Appointment entity.
+func (m *LocationMutation) ClearAppointments() {
+	m.clearedappointments = true
+}
+
+// AppointmentsCleared reports if the "appointments" edge to the Appointment entity was cleared.
+func (m *LocationMutation) AppointmentsCleared() bool {
+	return m.clearedappointments
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to the Appointment entity by IDs.
+func (m *LocationMutation) RemoveAppointmentIDs(ids ...int) {
+	if m.removedappointments == nil {
+		m.removedappointments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.appointments, ids[i])
+		m.removedappointments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAppointments returns the removed IDs of the "appointments" edge to the Appointment entity.
+func (m *LocationMutation) RemovedAppointmentsIDs() (ids []int) {
+	for id := range m.removedappointments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AppointmentsIDs returns the "appointments" edge IDs in the mutation.
+func (m *LocationMutation) AppointmentsIDs() (ids []int) {
+	for id := range m.appointments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAppointments resets all changes to the "appointments" edge.
+func (m *LocationMutation) ResetAppointments() {
+	m.appointments = nil
+	m.clearedappointments = false
+	m.removedappointments = nil
+}
+
+// AddOpeningHourIDs adds the "opening_hours" edge to the OpeningHours entity by ids.
+func (m *LocationMutation) AddOpeningHourIDs(ids ...int) {
+	if m.opening_hours == nil {
+		m.opening_hours = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.opening_hours[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOpeningHours clears the "opening_hours" edge to the OpeningHours entity.
+func (m *LocationMutation) ClearOpeningHours() {
+	m.clearedopening_hours = true
+}
+
+// OpeningHoursCleared reports if the "opening_hours" edge to the OpeningHours entity was cleared.
+func (m *LocationMutation) OpeningHoursCleared() bool {
+	return m.clearedopening_hours
+}
+
+// RemoveOpeningHourIDs removes the "opening_hours" edge to the OpeningHours entity by IDs.
+func (m *LocationMutation) RemoveOpeningHourIDs(ids ...int) {
+	if m.removedopening_hours == nil {
+		m.removedopening_hours = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.opening_hours, ids[i])
+		m.removedopening_hours[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOpeningHours returns the removed IDs of the "opening_hours" edge to the OpeningHours entity.
+func (m *LocationMutation) RemovedOpeningHoursIDs() (ids []int) {
+	for id := range m.removedopening_hours {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OpeningHoursIDs returns the "opening_hours" edge IDs in the mutation.
+func (m *LocationMutation) OpeningHoursIDs() (ids []int) {
+	for id := range m.opening_hours {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOpeningHours resets all changes to the "opening_hours" edge.
+func (m *LocationMutation) ResetOpeningHours() {
+	m.opening_hours = nil
+	m.clearedopening_hours = false
+	m.removedopening_hours = nil
+}
+
+// AddOpeningHoursExceptionIDs adds the "opening_hours_exceptions" edge to the OpeningHoursException entity by ids.
+func (m *LocationMutation) AddOpeningHoursExceptionIDs(ids ...int) {
+	if m.opening_hours_exceptions == nil {
+		m.opening_hours_exceptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.opening_hours_exceptions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOpeningHoursExceptions clears the "opening_hours_exceptions" edge to the OpeningHoursException entity.
+func (m *LocationMutation) ClearOpeningHoursExceptions() {
+	m.clearedopening_hours_exceptions = true
+}
+
+// OpeningHoursExceptionsCleared reports if the "opening_hours_exceptions" edge to the OpeningHoursException entity was cleared.
+func (m *LocationMutation) OpeningHoursExceptionsCleared() bool {
+	return m.clearedopening_hours_exceptions
+}
+
+// RemoveOpeningHoursExceptionIDs removes the "opening_hours_exceptions" edge to the OpeningHoursException entity by IDs.
+func (m *LocationMutation) RemoveOpeningHoursExceptionIDs(ids ...int) {
+	if m.removedopening_hours_exceptions == nil {
+		m.removedopening_hours_exceptions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.opening_hours_exceptions, ids[i])
+		m.removedopening_hours_exceptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOpeningHoursExceptions returns the removed IDs of the "opening_hours_exceptions" edge to the OpeningHoursException entity.
+func (m *LocationMutation) RemovedOpeningHoursExceptionsIDs() (ids []int) {
+	for id := range m.removedopening_hours_exceptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OpeningHoursExceptionsIDs returns the "opening_hours_exceptions" edge IDs in the mutation.
+func (m *LocationMutation) OpeningHoursExceptionsIDs() (ids []int) {
+	for id := range m.opening_hours_exceptions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOpeningHoursExceptions resets all changes to the "opening_hours_exceptions" edge.
+func (m *LocationMutation) ResetOpeningHoursExceptions() {
+	m.opening_hours_exceptions = nil
+	m.clearedopening_hours_exceptions = false
+	m.removedopening_hours_exceptions = nil
+}
+
+// AddStaffIDs adds the "staff" edge to the Staff entity by ids.
+func (m *LocationMutation) AddStaffIDs(ids ...int) {
+	if m.staff == nil {
+		m.staff = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.staff[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (m *LocationMutation) ClearStaff() {
+	m.clearedstaff = true
+}
+
+// StaffCleared reports if the "staff" edge to the Staff entity was cleared.
+func (m *LocationMutation) StaffCleared() bool {
+	return m.clearedstaff
+}
+
+// RemoveStaffIDs removes the "staff" edge to the Staff entity by IDs.
+func (m *LocationMutation) RemoveStaffIDs(ids ...int) {
+	if m.removedstaff == nil {
+		m.removedstaff = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.staff, ids[i])
+		m.removedstaff[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStaff returns the removed IDs of the "staff" edge to the Staff entity.
+func (m *LocationMutation) RemovedStaffIDs() (ids []int) {
+	for id := range m.removedstaff {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StaffIDs returns the "staff" edge IDs in the mutation.
+func (m *LocationMutation) StaffIDs() (ids []int) {
+	for id := range m.staff {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStaff resets all changes to the "staff" edge.
+func (m *LocationMutation) ResetStaff() {
+	m.staff = nil
+	m.clearedstaff = false
+	m.removedstaff = nil
+}
+
+// AddWaitlistEntryIDs adds the "waitlist_entries" edge to the WaitlistEntry entity by ids.
+func (m *LocationMutation) AddWaitlistEntryIDs(ids ...int) {
+	if m.waitlist_entries == nil {
+		m.waitlist_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.waitlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWaitlistEntries clears the "waitlist_entries" edge to the WaitlistEntry entity.
+func (m *LocationMutation) ClearWaitlistEntries() {
+	m.clearedwaitlist_entries = true
+}
+
+// WaitlistEntriesCleared reports if the "waitlist_entries" edge to the WaitlistEntry entity was cleared.
+func (m *LocationMutation) WaitlistEntriesCleared() bool {
+	return m.clearedwaitlist_entries
+}
+
+// RemoveWaitlistEntryIDs removes the "waitlist_entries" edge to the WaitlistEntry entity by IDs.
+func (m *LocationMutation) RemoveWaitlistEntryIDs(ids ...int) {
+	if m.removedwaitlist_entries == nil {
+		m.removedwaitlist_entries = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.waitlist_entries, ids[i])
+		m.removedwaitlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWaitlistEntries returns the removed IDs of the "waitlist_entries" edge to the WaitlistEntry entity.
+func (m *LocationMutation) RemovedWaitlistEntriesIDs() (ids []int) {
+	for id := range m.removedwaitlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WaitlistEntriesIDs returns the "waitlist_entries" edge IDs in the mutation.
+func (m *LocationMutation) WaitlistEntriesIDs() (ids []int) {
+	for id := range m.waitlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWaitlistEntries resets all changes to the "waitlist_entries" edge.
+func (m *LocationMutation) ResetWaitlistEntries() {
+	m.waitlist_entries = nil
+	m.clearedwaitlist_entries = false
+	m.removedwaitlist_entries = nil
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by ids.
+func (m *LocationMutation) AddSlotHoldIDs(ids ...int) {
+	if m.slot_holds == nil {
+		m.slot_holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.slot_holds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSlotHolds clears the "slot_holds" edge to the SlotHold entity.
+func (m *LocationMutation) ClearSlotHolds() {
+	m.clearedslot_holds = true
+}
+
+// SlotHoldsCleared reports if the "slot_holds" edge to the SlotHold entity was cleared.
+func (m *LocationMutation) SlotHoldsCleared() bool {
+	return m.clearedslot_holds
+}
+
+// RemoveSlotHoldIDs removes the "slot_holds" edge to the SlotHold entity by IDs.
+func (m *LocationMutation) RemoveSlotHoldIDs(ids ...int) {
+	if m.removedslot_holds == nil {
+		m.removedslot_holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.slot_holds, ids[i])
+		m.removedslot_holds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSlotHolds returns the removed IDs of the "slot_holds" edge to the SlotHold entity.
+func (m *LocationMutation) RemovedSlotHoldsIDs() (ids []int) {
+	for id := range m.removedslot_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SlotHoldsIDs returns the "slot_holds" edge IDs in the mutation.
+func (m *LocationMutation) SlotHoldsIDs() (ids []int) {
+	for id := range m.slot_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSlotHolds resets all changes to the "slot_holds" edge.
+func (m *LocationMutation) ResetSlotHolds() {
+	m.slot_holds = nil
+	m.clearedslot_holds = false
+	m.removedslot_holds = nil
+}
+
+// AddBlockedTimeIDs adds the "blocked_times" edge to the BlockedTime entity by ids.
+func (m *LocationMutation) AddBlockedTimeIDs(ids ...int) {
+	if m.blocked_times == nil {
+		m.blocked_times = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.blocked_times[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlockedTimes clears the "blocked_times" edge to the BlockedTime entity.
+func (m *LocationMutation) ClearBlockedTimes() {
+	m.clearedblocked_times = true
+}
+
+// BlockedTimesCleared reports if the "blocked_times" edge to the BlockedTime entity was cleared.
+func (m *LocationMutation) BlockedTimesCleared() bool {
+	return m.clearedblocked_times
+}
+
+// RemoveBlockedTimeIDs removes the "blocked_times" edge to the BlockedTime entity by IDs.
+func (m *LocationMutation) RemoveBlockedTimeIDs(ids ...int) {
+	if m.removedblocked_times == nil {
+		m.removedblocked_times = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.blocked_times, ids[i])
+		m.removedblocked_times[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlockedTimes returns the removed IDs of the "blocked_times" edge to the BlockedTime entity.
+func (m *LocationMutation) RemovedBlockedTimesIDs() (ids []int) {
+	for id := range m.removedblocked_times {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlockedTimesIDs returns the "blocked_times" edge IDs in the mutation.
+func (m *LocationMutation) BlockedTimesIDs() (ids []int) {
+	for id := range m.blocked_times {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlockedTimes resets all changes to the "blocked_times" edge.
+func (m *LocationMutation) ResetBlockedTimes() {
+	m.blocked_times = nil
+	m.clearedblocked_times = false
+	m.removedblocked_times = nil
+}
+
+// AddClosureDayIDs adds the "closure_days" edge to the ClosureDay entity by ids.
+func (m *LocationMutation) AddClosureDayIDs(ids ...int) {
+	if m.closure_days == nil {
+		m.closure_days = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.closure_days[ids[i]] = struct{}{}
+	}
+}
+
+// ClearClosureDays clears the "closure_days" edge to the ClosureDay entity.
+func (m *LocationMutation) ClearClosureDays() {
+	m.clearedclosure_days = true
+}
+
+// ClosureDaysCleared reports if the "closure_days" edge to the ClosureDay entity was cleared.
+func (m *LocationMutation) ClosureDaysCleared() bool {
+	return m.clearedclosure_days
+}
+
+// RemoveClosureDayIDs removes the "closure_days" edge to the ClosureDay entity by IDs.
+func (m *LocationMutation) RemoveClosureDayIDs(ids ...int) {
+	if m.removedclosure_days == nil {
+		m.removedclosure_days = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.closure_days, ids[i])
+		m.removedclosure_days[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedClosureDays returns the removed IDs of the "closure_days" edge to the ClosureDay entity.
+func (m *LocationMutation) RemovedClosureDaysIDs() (ids []int) {
+	for id := range m.removedclosure_days {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ClosureDaysIDs returns the "closure_days" edge IDs in the mutation.
+func (m *LocationMutation) ClosureDaysIDs() (ids []int) {
+	for id := range m.closure_days {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetClosureDays resets all changes to the "closure_days" edge.
+func (m *LocationMutation) ResetClosureDays() {
+	m.closure_days = nil
+	m.clearedclosure_days = false
+	m.removedclosure_days = nil
+}
+
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, location.FieldName)
+	}
+	if m.address != nil {
+		fields = append(fields, location.FieldAddress)
+	}
+	if m.timezone != nil {
+		fields = append(fields, location.FieldTimezone)
+	}
+	if m.state != nil {
+		fields = append(fields, location.FieldState)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldName:
+		return m.Name()
+	case location.FieldAddress:
+		return m.Address()
+	case location.FieldTimezone:
+		return m.Timezone()
+	case location.FieldState:
+		return m.State()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case location.FieldName:
+		return m.OldName(ctx)
+	case location.FieldAddress:
+		return m.OldAddress(ctx)
+	case location.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case location.FieldState:
+		return m.OldState(ctx)
+	}
+	return nil, fmt.Errorf("unknown Location field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case location.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case location.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	case location.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case location.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(location.FieldAddress) {
+		fields = append(fields, location.FieldAddress)
+	}
+	if m.FieldCleared(location.FieldState) {
+		fields = append(fields, location.FieldState)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMutation) ClearField(name string) error {
+	switch name {
+	case location.FieldAddress:
+		m.ClearAddress()
+		return nil
+	case location.FieldState:
+		m.ClearState()
+		return nil
+	}
+	return fmt.Errorf("unknown Location nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMutation) ResetField(name string) error {
+	switch name {
+	case location.FieldName:
+		m.ResetName()
+		return nil
+	case location.FieldAddress:
+		m.ResetAddress()
+		return nil
+	case location.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case location.FieldState:
+		m.ResetState()
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.appointments != nil {
+		edges = append(edges, location.EdgeAppointments)
+	}
+	if m.opening_hours != nil {
+		edges = append(edges, location.EdgeOpeningHours)
+	}
+	if m.opening_hours_exceptions != nil {
+		edges = append(edges, location.EdgeOpeningHoursExceptions)
+	}
+	if m.staff != nil {
+		edges = append(edges, location.EdgeStaff)
+	}
+	if m.waitlist_entries != nil {
+		edges = append(edges, location.EdgeWaitlistEntries)
+	}
+	if m.slot_holds != nil {
+		edges = append(edges, location.EdgeSlotHolds)
+	}
+	if m.blocked_times != nil {
+		edges = append(edges, location.EdgeBlockedTimes)
+	}
+	if m.closure_days != nil {
+		edges = append(edges, location.EdgeClosureDays)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeAppointments:
+		ids := make([]ent.Value, 0, len(m.appointments))
+		for id := range m.appointments {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOpeningHours:
+		ids := make([]ent.Value, 0, len(m.opening_hours))
+		for id := range m.opening_hours {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOpeningHoursExceptions:
+		ids := make([]ent.Value, 0, len(m.opening_hours_exceptions))
+		for id := range m.opening_hours_exceptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeStaff:
+		ids := make([]ent.Value, 0, len(m.staff))
+		for id := range m.staff {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.waitlist_entries))
+		for id := range m.waitlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeSlotHolds:
+		ids := make([]ent.Value, 0, len(m.slot_holds))
+		for id := range m.slot_holds {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeBlockedTimes:
+		ids := make([]ent.Value, 0, len(m.blocked_times))
+		for id := range m.blocked_times {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeClosureDays:
+		ids := make([]ent.Value, 0, len(m.closure_days))
+		for id := range m.closure_days {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedappointments != nil {
+		edges = append(edges, location.EdgeAppointments)
+	}
+	if m.removedopening_hours != nil {
+		edges = append(edges, location.EdgeOpeningHours)
+	}
+	if m.removedopening_hours_exceptions != nil {
+		edges = append(edges, location.EdgeOpeningHoursExceptions)
+	}
+	if m.removedstaff != nil {
+		edges = append(edges, location.EdgeStaff)
+	}
+	if m.removedwaitlist_entries != nil {
+		edges = append(edges, location.EdgeWaitlistEntries)
+	}
+	if m.removedslot_holds != nil {
+		edges = append(edges, location.EdgeSlotHolds)
+	}
+	if m.removedblocked_times != nil {
+		edges = append(edges, location.EdgeBlockedTimes)
+	}
+	if m.removedclosure_days != nil {
+		edges = append(edges, location.EdgeClosureDays)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeAppointments:
+		ids := make([]ent.Value, 0, len(m.removedappointments))
+		for id := range m.removedappointments {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOpeningHours:
+		ids := make([]ent.Value, 0, len(m.removedopening_hours))
+		for id := range m.removedopening_hours {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOpeningHoursExceptions:
+		ids := make([]ent.Value, 0, len(m.removedopening_hours_exceptions))
+		for id := range m.removedopening_hours_exceptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeStaff:
+		ids := make([]ent.Value, 0, len(m.removedstaff))
+		for id := range m.removedstaff {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeWaitlistEntries:
+		ids := make([]ent.Value, 0, len(m.removedwaitlist_entries))
+		for id := range m.removedwaitlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeSlotHolds:
+		ids := make([]ent.Value, 0, len(m.removedslot_holds))
+		for id := range m.removedslot_holds {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeBlockedTimes:
+		ids := make([]ent.Value, 0, len(m.removedblocked_times))
+		for id := range m.removedblocked_times {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeClosureDays:
+		ids := make([]ent.Value, 0, len(m.removedclosure_days))
+		for id := range m.removedclosure_days {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedappointments {
+		edges = append(edges, location.EdgeAppointments)
+	}
+	if m.clearedopening_hours {
+		edges = append(edges, location.EdgeOpeningHours)
+	}
+	if m.clearedopening_hours_exceptions {
+		edges = append(edges, location.EdgeOpeningHoursExceptions)
+	}
+	if m.clearedstaff {
+		edges = append(edges, location.EdgeStaff)
+	}
+	if m.clearedwaitlist_entries {
+		edges = append(edges, location.EdgeWaitlistEntries)
+	}
+	if m.clearedslot_holds {
+		edges = append(edges, location.EdgeSlotHolds)
+	}
+	if m.clearedblocked_times {
+		edges = append(edges, location.EdgeBlockedTimes)
+	}
+	if m.clearedclosure_days {
+		edges = append(edges, location.EdgeClosureDays)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case location.EdgeAppointments:
+		return m.clearedappointments
+	case location.EdgeOpeningHours:
+		return m.clearedopening_hours
+	case location.EdgeOpeningHoursExceptions:
+		return m.clearedopening_hours_exceptions
+	case location.EdgeStaff:
+		return m.clearedstaff
+	case location.EdgeWaitlistEntries:
+		return m.clearedwaitlist_entries
+	case location.EdgeSlotHolds:
+		return m.clearedslot_holds
+	case location.EdgeBlockedTimes:
+		return m.clearedblocked_times
+	case location.EdgeClosureDays:
+		return m.clearedclosure_days
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocationMutation) ResetEdge(name string) error {
+	switch name {
+	case location.EdgeAppointments:
+		m.ResetAppointments()
+		return nil
+	case location.EdgeOpeningHours:
+		m.ResetOpeningHours()
+		return nil
+	case location.EdgeOpeningHoursExceptions:
+		m.ResetOpeningHoursExceptions()
+		return nil
+	case location.EdgeStaff:
+		m.ResetStaff()
+		return nil
+	case location.EdgeWaitlistEntries:
+		m.ResetWaitlistEntries()
+		return nil
+	case location.EdgeSlotHolds:
+		m.ResetSlotHolds()
+		return nil
+	case location.EdgeBlockedTimes:
+		m.ResetBlockedTimes()
+		return nil
+	case location.EdgeClosureDays:
+		m.ResetClosureDays()
+		return nil
+	}
+	return fmt.Errorf("unknown Location edge %s", name)
+}
+
+// OpeningHoursMutation represents an operation that mutates the OpeningHours nodes in the graph.
+type OpeningHoursMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	weekday         *int
+	addweekday      *int
+	open            *string
+	close           *string
+	clearedFields   map[string]struct{}
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*OpeningHours, error)
+	predicates      []predicate.OpeningHours
+}
+
+var _ ent.Mutation = (*OpeningHoursMutation)(nil)
+
+// openinghoursOption allows management of the mutation configuration using functional options.
+type openinghoursOption func(*OpeningHoursMutation)
+
+// newOpeningHoursMutation creates new mutation for the OpeningHours entity.
+func newOpeningHoursMutation(c config, op Op, opts ...openinghoursOption) *OpeningHoursMutation {
+	m := &OpeningHoursMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOpeningHours,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOpeningHoursID sets the ID field of the mutation.
+func withOpeningHoursID(id int) openinghoursOption {
+	return func(m *OpeningHoursMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OpeningHours
+		)
+		m.oldValue = func(ctx context.Context) (*OpeningHours, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OpeningHours.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOpeningHours sets the old OpeningHours of the mutation.
+func withOpeningHours(node *OpeningHours) openinghoursOption {
+	return func(m *OpeningHoursMutation) {
+		m.oldValue = func(context.Context) (*OpeningHours, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OpeningHoursMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OpeningHoursMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OpeningHoursMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OpeningHoursMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OpeningHours.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWeekday sets the "weekday" field.
+func (m *OpeningHoursMutation) SetWeekday(i int) {
+	m.weekday = &i
+	m.addweekday = nil
+}
+
+// Weekday returns the value of the "weekday" field in the mutation.
+func (m *OpeningHoursMutation) Weekday() (r int, exists bool) {
+	v := m.weekday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeekday returns the old "weekday" field's value of the OpeningHours entity.
+// If the OpeningHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursMutation) OldWeekday(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeekday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeekday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeekday: %w", err)
+	}
+	return oldValue.Weekday, nil
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (m *OpeningHoursMutation) AddWeekday(i int) {
+	if m.addweekday != nil {
+		*m.addweekday += i
+	} else {
+		m.addweekday = &i
+	}
+}
+
+// AddedWeekday returns the value that was added to the "weekday" field in this mutation.
+func (m *OpeningHoursMutation) AddedWeekday() (r int, exists bool) {
+	v := m.addweekday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeekday resets all changes to the "weekday" field.
+func (m *OpeningHoursMutation) ResetWeekday() {
+	m.weekday = nil
+	m.addweekday = nil
+}
+
+// SetOpen sets the "open" field.
+func (m *OpeningHoursMutation) SetOpen(s string) {
+	m.open = &s
+}
+
+// Open returns the value of the "open" field in the mutation.
+func (m *OpeningHoursMutation) Open() (r string, exists bool) {
+	v := m.open
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpen returns the old "open" field's value of the OpeningHours entity.
+// If the OpeningHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursMutation) OldOpen(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpen: %w", err)
+	}
+	return oldValue.Open, nil
+}
+
+// ResetOpen resets all changes to the "open" field.
+func (m *OpeningHoursMutation) ResetOpen() {
+	m.open = nil
+}
+
+// SetClose sets the "close" field.
+func (m *OpeningHoursMutation) SetClose(s string) {
+	m.close = &s
+}
+
+// Close returns the value of the "close" field in the mutation.
+func (m *OpeningHoursMutation) Close() (r string, exists bool) {
+	v := m.close
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClose returns the old "close" field's value of the OpeningHours entity.
+// If the OpeningHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursMutation) OldClose(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClose: %w", err)
+	}
+	return oldValue.Close, nil
+}
+
+// ResetClose resets all changes to the "close" field.
+func (m *OpeningHoursMutation) ResetClose() {
+	m.close = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *OpeningHoursMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *OpeningHoursMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the OpeningHours entity.
+// If the OpeningHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *OpeningHoursMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[openinghours.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *OpeningHoursMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[openinghours.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *OpeningHoursMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, openinghours.FieldLocationID)
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *OpeningHoursMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[openinghours.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *OpeningHoursMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *OpeningHoursMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *OpeningHoursMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the OpeningHoursMutation builder.
+func (m *OpeningHoursMutation) Where(ps ...predicate.OpeningHours) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OpeningHoursMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OpeningHoursMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OpeningHours, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OpeningHoursMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OpeningHoursMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OpeningHours).
+func (m *OpeningHoursMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OpeningHoursMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.weekday != nil {
+		fields = append(fields, openinghours.FieldWeekday)
+	}
+	if m.open != nil {
+		fields = append(fields, openinghours.FieldOpen)
+	}
+	if m.close != nil {
+		fields = append(fields, openinghours.FieldClose)
+	}
+	if m.location != nil {
+		fields = append(fields, openinghours.FieldLocationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OpeningHoursMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case openinghours.FieldWeekday:
+		return m.Weekday()
+	case openinghours.FieldOpen:
+		return m.Open()
+	case openinghours.FieldClose:
+		return m.Close()
+	case openinghours.FieldLocationID:
+		return m.LocationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OpeningHoursMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case openinghours.FieldWeekday:
+		return m.OldWeekday(ctx)
+	case openinghours.FieldOpen:
+		return m.OldOpen(ctx)
+	case openinghours.FieldClose:
+		return m.OldClose(ctx)
+	case openinghours.FieldLocationID:
+		return m.OldLocationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown OpeningHours field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OpeningHoursMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case openinghours.FieldWeekday:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeekday(v)
+		return nil
+	case openinghours.FieldOpen:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpen(v)
+		return nil
+	case openinghours.FieldClose:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClose(v)
+		return nil
+	case openinghours.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHours field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OpeningHoursMutation) AddedFields() []string {
+	var fields []string
+	if m.addweekday != nil {
+		fields = append(fields, openinghours.FieldWeekday)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OpeningHoursMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case openinghours.FieldWeekday:
+		return m.AddedWeekday()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OpeningHoursMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case openinghours.FieldWeekday:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeekday(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHours numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OpeningHoursMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(openinghours.FieldLocationID) {
+		fields = append(fields, openinghours.FieldLocationID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OpeningHoursMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OpeningHoursMutation) ClearField(name string) error {
+	switch name {
+	case openinghours.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHours nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OpeningHoursMutation) ResetField(name string) error {
+	switch name {
+	case openinghours.FieldWeekday:
+		m.ResetWeekday()
+		return nil
+	case openinghours.FieldOpen:
+		m.ResetOpen()
+		return nil
+	case openinghours.FieldClose:
+		m.ResetClose()
+		return nil
+	case openinghours.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHours field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OpeningHoursMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.location != nil {
+		edges = append(edges, openinghours.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OpeningHoursMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case openinghours.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OpeningHoursMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OpeningHoursMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OpeningHoursMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlocation {
+		edges = append(edges, openinghours.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OpeningHoursMutation) EdgeCleared(name string) bool {
+	switch name {
+	case openinghours.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OpeningHoursMutation) ClearEdge(name string) error {
+	switch name {
+	case openinghours.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHours unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OpeningHoursMutation) ResetEdge(name string) error {
+	switch name {
+	case openinghours.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHours edge %s", name)
+}
+
+// OpeningHoursExceptionMutation represents an operation that mutates the OpeningHoursException nodes in the graph.
+type OpeningHoursExceptionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	date            *string
+	open            *string
+	close           *string
+	clearedFields   map[string]struct{}
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*OpeningHoursException, error)
+	predicates      []predicate.OpeningHoursException
+}
+
+var _ ent.Mutation = (*OpeningHoursExceptionMutation)(nil)
+
+// openinghoursexceptionOption allows management of the mutation configuration using functional options.
+type openinghoursexceptionOption func(*OpeningHoursExceptionMutation)
+
+// newOpeningHoursExceptionMutation creates new mutation for the OpeningHoursException entity.
+func newOpeningHoursExceptionMutation(c config, op Op, opts ...openinghoursexceptionOption) *OpeningHoursExceptionMutation {
+	m := &OpeningHoursExceptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOpeningHoursException,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOpeningHoursExceptionID sets the ID field of the mutation.
+func withOpeningHoursExceptionID(id int) openinghoursexceptionOption {
+	return func(m *OpeningHoursExceptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OpeningHoursException
+		)
+		m.oldValue = func(ctx context.Context) (*OpeningHoursException, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OpeningHoursException.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOpeningHoursException sets the old OpeningHoursException of the mutation.
+func withOpeningHoursException(node *OpeningHoursException) openinghoursexceptionOption {
+	return func(m *OpeningHoursExceptionMutation) {
+		m.oldValue = func(context.Context) (*OpeningHoursException, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OpeningHoursExceptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OpeningHoursExceptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OpeningHoursExceptionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OpeningHoursExceptionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OpeningHoursException.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDate sets the "date" field.
+func (m *OpeningHoursExceptionMutation) SetDate(s string) {
+	m.date = &s
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *OpeningHoursExceptionMutation) Date() (r string, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the OpeningHoursException entity.
+// If the OpeningHoursException object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursExceptionMutation) OldDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *OpeningHoursExceptionMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetOpen sets the "open" field.
+func (m *OpeningHoursExceptionMutation) SetOpen(s string) {
+	m.open = &s
+}
+
+// Open returns the value of the "open" field in the mutation.
+func (m *OpeningHoursExceptionMutation) Open() (r string, exists bool) {
+	v := m.open
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpen returns the old "open" field's value of the OpeningHoursException entity.
+// If the OpeningHoursException object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursExceptionMutation) OldOpen(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpen: %w", err)
+	}
+	return oldValue.Open, nil
+}
+
+// ResetOpen resets all changes to the "open" field.
+func (m *OpeningHoursExceptionMutation) ResetOpen() {
+	m.open = nil
+}
+
+// SetClose sets the "close" field.
+func (m *OpeningHoursExceptionMutation) SetClose(s string) {
+	m.close = &s
+}
+
+// Close returns the value of the "close" field in the mutation.
+func (m *OpeningHoursExceptionMutation) Close() (r string, exists bool) {
+	v := m.close
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClose returns the old "close" field's value of the OpeningHoursException entity.
+// If the OpeningHoursException object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursExceptionMutation) OldClose(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClose: %w", err)
+	}
+	return oldValue.Close, nil
+}
+
+// ResetClose resets all changes to the "close" field.
+func (m *OpeningHoursExceptionMutation) ResetClose() {
+	m.close = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *OpeningHoursExceptionMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *OpeningHoursExceptionMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the OpeningHoursException entity.
+// If the OpeningHoursException object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OpeningHoursExceptionMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *OpeningHoursExceptionMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[openinghoursexception.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *OpeningHoursExceptionMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[openinghoursexception.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *OpeningHoursExceptionMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, openinghoursexception.FieldLocationID)
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *OpeningHoursExceptionMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[openinghoursexception.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *OpeningHoursExceptionMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *OpeningHoursExceptionMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *OpeningHoursExceptionMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the OpeningHoursExceptionMutation builder.
+func (m *OpeningHoursExceptionMutation) Where(ps ...predicate.OpeningHoursException) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OpeningHoursExceptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OpeningHoursExceptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OpeningHoursException, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OpeningHoursExceptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OpeningHoursExceptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OpeningHoursException).
+func (m *OpeningHoursExceptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OpeningHoursExceptionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.date != nil {
+		fields = append(fields, openinghoursexception.FieldDate)
+	}
+	if m.open != nil {
+		fields = append(fields, openinghoursexception.FieldOpen)
+	}
+	if m.close != nil {
+		fields = append(fields, openinghoursexception.FieldClose)
+	}
+	if m.location != nil {
+		fields = append(fields, openinghoursexception.FieldLocationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OpeningHoursExceptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case openinghoursexception.FieldDate:
+		return m.Date()
+	case openinghoursexception.FieldOpen:
+		return m.Open()
+	case openinghoursexception.FieldClose:
+		return m.Close()
+	case openinghoursexception.FieldLocationID:
+		return m.LocationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OpeningHoursExceptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case openinghoursexception.FieldDate:
+		return m.OldDate(ctx)
+	case openinghoursexception.FieldOpen:
+		return m.OldOpen(ctx)
+	case openinghoursexception.FieldClose:
+		return m.OldClose(ctx)
+	case openinghoursexception.FieldLocationID:
+		return m.OldLocationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown OpeningHoursException field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OpeningHoursExceptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case openinghoursexception.FieldDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case openinghoursexception.FieldOpen:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpen(v)
+		return nil
+	case openinghoursexception.FieldClose:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClose(v)
+		return nil
+	case openinghoursexception.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHoursException field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OpeningHoursExceptionMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OpeningHoursExceptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OpeningHoursExceptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OpeningHoursException numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OpeningHoursExceptionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(openinghoursexception.FieldLocationID) {
+		fields = append(fields, openinghoursexception.FieldLocationID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OpeningHoursExceptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OpeningHoursExceptionMutation) ClearField(name string) error {
+	switch name {
+	case openinghoursexception.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHoursException nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OpeningHoursExceptionMutation) ResetField(name string) error {
+	switch name {
+	case openinghoursexception.FieldDate:
+		m.ResetDate()
+		return nil
+	case openinghoursexception.FieldOpen:
+		m.ResetOpen()
+		return nil
+	case openinghoursexception.FieldClose:
+		m.ResetClose()
+		return nil
+	case openinghoursexception.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHoursException field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OpeningHoursExceptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.location != nil {
+		edges = append(edges, openinghoursexception.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OpeningHoursExceptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case openinghoursexception.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OpeningHoursExceptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OpeningHoursExceptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OpeningHoursExceptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlocation {
+		edges = append(edges, openinghoursexception.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OpeningHoursExceptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case openinghoursexception.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OpeningHoursExceptionMutation) ClearEdge(name string) error {
+	switch name {
+	case openinghoursexception.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHoursException unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OpeningHoursExceptionMutation) ResetEdge(name string) error {
+	switch name {
+	case openinghoursexception.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown OpeningHoursException edge %s", name)
+}
+
+// ReminderMutation represents an operation that mutates the Reminder nodes in the graph.
+type ReminderMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	_offset            *time.Duration
+	add_offset         *time.Duration
+	sent_at            *time.Time
+	clearedFields      map[string]struct{}
+	appointment        *int
+	clearedappointment bool
+	done               bool
+	oldValue           func(context.Context) (*Reminder, error)
+	predicates         []predicate.Reminder
+}
+
+var _ ent.Mutation = (*ReminderMutation)(nil)
+
+// reminderOption allows management of the mutation configuration using functional options.
+type reminderOption func(*ReminderMutation)
+
+// newReminderMutation creates new mutation for the Reminder entity.
+func newReminderMutation(c config, op Op, opts ...reminderOption) *ReminderMutation {
+	m := &ReminderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReminder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReminderID sets the ID field of the mutation.
+func withReminderID(id int) reminderOption {
+	return func(m *ReminderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reminder
+		)
+		m.oldValue = func(ctx context.Context) (*Reminder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reminder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReminder sets the old Reminder of the mutation.
+func withReminder(node *Reminder) reminderOption {
+	return func(m *ReminderMutation) {
+		m.oldValue = func(context.Context) (*Reminder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReminderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReminderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReminderMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReminderMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reminder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (m *ReminderMutation) SetAppointmentID(i int) {
+	m.appointment = &i
+}
+
+// AppointmentID returns the value of the "appointment_id" field in the mutation.
+func (m *ReminderMutation) AppointmentID() (r int, exists bool) {
+	v := m.appointment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppointmentID returns the old "appointment_id" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldAppointmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppointmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppointmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppointmentID: %w", err)
+	}
+	return oldValue.AppointmentID, nil
+}
+
+// ResetAppointmentID resets all changes to the "appointment_id" field.
+func (m *ReminderMutation) ResetAppointmentID() {
+	m.appointment = nil
+}
+
+// SetOffset sets the "offset" field.
+func (m *ReminderMutation) SetOffset(t time.Duration) {
+	m._offset = &t
+	m.add_offset = nil
+}
+
+// Offset returns the value of the "offset" field in the mutation.
+func (m *ReminderMutation) Offset() (r time.Duration, exists bool) {
+	v := m._offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffset returns the old "offset" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldOffset(ctx context.Context) (v time.Duration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
+	}
+	return oldValue.Offset, nil
+}
+
+// AddOffset adds t to the "offset" field.
+func (m *ReminderMutation) AddOffset(t time.Duration) {
+	if m.add_offset != nil {
+		*m.add_offset += t
+	} else {
+		m.add_offset = &t
+	}
+}
+
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *ReminderMutation) AddedOffset() (r time.Duration, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOffset resets all changes to the "offset" field.
+func (m *ReminderMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
+}
+
+// SetSentAt sets the "sent_at" field.
+func (m *ReminderMutation) SetSentAt(t time.Time) {
+	m.sent_at = &t
+}
+
+// SentAt returns the value of the "sent_at" field in the mutation.
+func (m *ReminderMutation) SentAt() (r time.Time, exists bool) {
+	v := m.sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSentAt returns the old "sent_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldSentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSentAt: %w", err)
+	}
+	return oldValue.SentAt, nil
+}
+
+// ResetSentAt resets all changes to the "sent_at" field.
+func (m *ReminderMutation) ResetSentAt() {
+	m.sent_at = nil
+}
+
+// ClearAppointment clears the "appointment" edge to the Appointment entity.
+func (m *ReminderMutation) ClearAppointment() {
+	m.clearedappointment = true
+	m.clearedFields[reminder.FieldAppointmentID] = struct{}{}
+}
+
+// AppointmentCleared reports if the "appointment" edge to the Appointment entity was cleared.
+func (m *ReminderMutation) AppointmentCleared() bool {
+	return m.clearedappointment
+}
+
+// AppointmentIDs returns the "appointment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AppointmentID instead. It exists only for internal usage by the builders.
+func (m *ReminderMutation) AppointmentIDs() (ids []int) {
+	if id := m.appointment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAppointment resets all changes to the "appointment" edge.
+func (m *ReminderMutation) ResetAppointment() {
+	m.appointment = nil
+	m.clearedappointment = false
+}
+
+// Where appends a list predicates to the ReminderMutation builder.
+func (m *ReminderMutation) Where(ps ...predicate.Reminder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReminderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReminderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reminder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReminderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReminderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Reminder).
+func (m *ReminderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReminderMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.appointment != nil {
+		fields = append(fields, reminder.FieldAppointmentID)
+	}
+	if m._offset != nil {
+		fields = append(fields, reminder.FieldOffset)
+	}
+	if m.sent_at != nil {
+		fields = append(fields, reminder.FieldSentAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReminderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reminder.FieldAppointmentID:
+		return m.AppointmentID()
+	case reminder.FieldOffset:
+		return m.Offset()
+	case reminder.FieldSentAt:
+		return m.SentAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReminderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reminder.FieldAppointmentID:
+		return m.OldAppointmentID(ctx)
+	case reminder.FieldOffset:
+		return m.OldOffset(ctx)
+	case reminder.FieldSentAt:
+		return m.OldSentAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Reminder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReminderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reminder.FieldAppointmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppointmentID(v)
+		return nil
+	case reminder.FieldOffset:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffset(v)
+		return nil
+	case reminder.FieldSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSentAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReminderMutation) AddedFields() []string {
+	var fields []string
+	if m.add_offset != nil {
+		fields = append(fields, reminder.FieldOffset)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReminderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reminder.FieldOffset:
+		return m.AddedOffset()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReminderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reminder.FieldOffset:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOffset(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReminderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReminderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReminderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Reminder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReminderMutation) ResetField(name string) error {
+	switch name {
+	case reminder.FieldAppointmentID:
+		m.ResetAppointmentID()
+		return nil
+	case reminder.FieldOffset:
+		m.ResetOffset()
+		return nil
+	case reminder.FieldSentAt:
+		m.ResetSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReminderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.appointment != nil {
+		edges = append(edges, reminder.EdgeAppointment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReminderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reminder.EdgeAppointment:
+		if id := m.appointment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReminderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReminderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReminderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedappointment {
+		edges = append(edges, reminder.EdgeAppointment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReminderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reminder.EdgeAppointment:
+		return m.clearedappointment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReminderMutation) ClearEdge(name string) error {
+	switch name {
+	case reminder.EdgeAppointment:
+		m.ClearAppointment()
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReminderMutation) ResetEdge(name string) error {
+	switch name {
+	case reminder.EdgeAppointment:
+		m.ResetAppointment()
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder edge %s", name)
+}
+
+// SlotHoldMutation represents an operation that mutates the SlotHold nodes in the graph.
+type SlotHoldMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	token           *string
+	start_time      *time.Time
+	end_time        *time.Time
+	expires_at      *time.Time
+	client          *string
+	address         *string
+	clearedFields   map[string]struct{}
+	location        *int
+	clearedlocation bool
+	staff           *int
+	clearedstaff    bool
+	done            bool
+	oldValue        func(context.Context) (*SlotHold, error)
+	predicates      []predicate.SlotHold
+}
+
+var _ ent.Mutation = (*SlotHoldMutation)(nil)
+
+// slotholdOption allows management of the mutation configuration using functional options.
+type slotholdOption func(*SlotHoldMutation)
+
+// newSlotHoldMutation creates new mutation for the SlotHold entity.
+func newSlotHoldMutation(c config, op Op, opts ...slotholdOption) *SlotHoldMutation {
+	m := &SlotHoldMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSlotHold,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSlotHoldID sets the ID field of the mutation.
+func withSlotHoldID(id int) slotholdOption {
+	return func(m *SlotHoldMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SlotHold
+		)
+		m.oldValue = func(ctx context.Context) (*SlotHold, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SlotHold.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSlotHold sets the old SlotHold of the mutation.
+func withSlotHold(node *SlotHold) slotholdOption {
+	return func(m *SlotHoldMutation) {
+		m.oldValue = func(context.Context) (*SlotHold, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SlotHoldMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SlotHoldMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SlotHoldMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SlotHoldMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SlotHold.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetToken sets the "token" field.
+func (m *SlotHoldMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *SlotHoldMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *SlotHoldMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *SlotHoldMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *SlotHoldMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *SlotHoldMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *SlotHoldMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *SlotHoldMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *SlotHoldMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SlotHoldMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SlotHoldMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SlotHoldMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *SlotHoldMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *SlotHoldMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *SlotHoldMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[slothold.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *SlotHoldMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[slothold.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *SlotHoldMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, slothold.FieldLocationID)
+}
+
+// SetStaffID sets the "staff_id" field.
+func (m *SlotHoldMutation) SetStaffID(i int) {
+	m.staff = &i
+}
+
+// StaffID returns the value of the "staff_id" field in the mutation.
+func (m *SlotHoldMutation) StaffID() (r int, exists bool) {
+	v := m.staff
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStaffID returns the old "staff_id" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldStaffID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStaffID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStaffID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStaffID: %w", err)
+	}
+	return oldValue.StaffID, nil
+}
+
+// ClearStaffID clears the value of the "staff_id" field.
+func (m *SlotHoldMutation) ClearStaffID() {
+	m.staff = nil
+	m.clearedFields[slothold.FieldStaffID] = struct{}{}
+}
+
+// StaffIDCleared returns if the "staff_id" field was cleared in this mutation.
+func (m *SlotHoldMutation) StaffIDCleared() bool {
+	_, ok := m.clearedFields[slothold.FieldStaffID]
+	return ok
+}
+
+// ResetStaffID resets all changes to the "staff_id" field.
+func (m *SlotHoldMutation) ResetStaffID() {
+	m.staff = nil
+	delete(m.clearedFields, slothold.FieldStaffID)
+}
+
+// SetClient sets the "client" field.
+func (m *SlotHoldMutation) SetClient(s string) {
+	m.client = &s
+}
+
+// GetClient returns the value of the "client" field in the mutation.
+func (m *SlotHoldMutation) GetClient() (r string, exists bool) {
+	v := m.client
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClient returns the old "client" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldClient(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClient: %w", err)
+	}
+	return oldValue.Client, nil
+}
+
+// ClearClient clears the value of the "client" field.
+func (m *SlotHoldMutation) ClearClient() {
+	m.client = nil
+	m.clearedFields[slothold.FieldClient] = struct{}{}
+}
+
+// ClientCleared returns if the "client" field was cleared in this mutation.
+func (m *SlotHoldMutation) ClientCleared() bool {
+	_, ok := m.clearedFields[slothold.FieldClient]
+	return ok
+}
+
+// ResetClient resets all changes to the "client" field.
+func (m *SlotHoldMutation) ResetClient() {
+	m.client = nil
+	delete(m.clearedFields, slothold.FieldClient)
+}
+
+// SetAddress sets the "address" field.
+func (m *SlotHoldMutation) SetAddress(s string) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *SlotHoldMutation) Address() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the SlotHold entity.
+// If the SlotHold object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlotHoldMutation) OldAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *SlotHoldMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[slothold.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *SlotHoldMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[slothold.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *SlotHoldMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, slothold.FieldAddress)
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *SlotHoldMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[slothold.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *SlotHoldMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *SlotHoldMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *SlotHoldMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (m *SlotHoldMutation) ClearStaff() {
+	m.clearedstaff = true
+	m.clearedFields[slothold.FieldStaffID] = struct{}{}
+}
+
+// StaffCleared reports if the "staff" edge to the Staff entity was cleared.
+func (m *SlotHoldMutation) StaffCleared() bool {
+	return m.StaffIDCleared() || m.clearedstaff
+}
+
+// StaffIDs returns the "staff" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StaffID instead. It exists only for internal usage by the builders.
+func (m *SlotHoldMutation) StaffIDs() (ids []int) {
+	if id := m.staff; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStaff resets all changes to the "staff" edge.
+func (m *SlotHoldMutation) ResetStaff() {
+	m.staff = nil
+	m.clearedstaff = false
+}
+
+// Where appends a list predicates to the SlotHoldMutation builder.
+func (m *SlotHoldMutation) Where(ps ...predicate.SlotHold) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SlotHoldMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SlotHoldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SlotHold, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SlotHoldMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SlotHoldMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SlotHold).
+func (m *SlotHoldMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SlotHoldMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.token != nil {
+		fields = append(fields, slothold.FieldToken)
+	}
+	if m.start_time != nil {
+		fields = append(fields, slothold.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, slothold.FieldEndTime)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, slothold.FieldExpiresAt)
+	}
+	if m.location != nil {
+		fields = append(fields, slothold.FieldLocationID)
+	}
+	if m.staff != nil {
+		fields = append(fields, slothold.FieldStaffID)
+	}
+	if m.client != nil {
+		fields = append(fields, slothold.FieldClient)
+	}
+	if m.address != nil {
+		fields = append(fields, slothold.FieldAddress)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SlotHoldMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case slothold.FieldToken:
+		return m.Token()
+	case slothold.FieldStartTime:
+		return m.StartTime()
+	case slothold.FieldEndTime:
+		return m.EndTime()
+	case slothold.FieldExpiresAt:
+		return m.ExpiresAt()
+	case slothold.FieldLocationID:
+		return m.LocationID()
+	case slothold.FieldStaffID:
+		return m.StaffID()
+	case slothold.FieldClient:
+		return m.GetClient()
+	case slothold.FieldAddress:
+		return m.Address()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SlotHoldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case slothold.FieldToken:
+		return m.OldToken(ctx)
+	case slothold.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case slothold.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case slothold.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case slothold.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case slothold.FieldStaffID:
+		return m.OldStaffID(ctx)
+	case slothold.FieldClient:
+		return m.OldClient(ctx)
+	case slothold.FieldAddress:
+		return m.OldAddress(ctx)
+	}
+	return nil, fmt.Errorf("unknown SlotHold field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlotHoldMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case slothold.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case slothold.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case slothold.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case slothold.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case slothold.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	case slothold.FieldStaffID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStaffID(v)
+		return nil
+	case slothold.FieldClient:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClient(v)
+		return nil
+	case slothold.FieldAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SlotHold field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SlotHoldMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SlotHoldMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SlotHoldMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SlotHold numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SlotHoldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(slothold.FieldLocationID) {
+		fields = append(fields, slothold.FieldLocationID)
+	}
+	if m.FieldCleared(slothold.FieldStaffID) {
+		fields = append(fields, slothold.FieldStaffID)
+	}
+	if m.FieldCleared(slothold.FieldClient) {
+		fields = append(fields, slothold.FieldClient)
+	}
+	if m.FieldCleared(slothold.FieldAddress) {
+		fields = append(fields, slothold.FieldAddress)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SlotHoldMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SlotHoldMutation) ClearField(name string) error {
+	switch name {
+	case slothold.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	case slothold.FieldStaffID:
+		m.ClearStaffID()
+		return nil
+	case slothold.FieldClient:
+		m.ClearClient()
+		return nil
+	case slothold.FieldAddress:
+		m.ClearAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown SlotHold nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SlotHoldMutation) ResetField(name string) error {
+	switch name {
+	case slothold.FieldToken:
+		m.ResetToken()
+		return nil
+	case slothold.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case slothold.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case slothold.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case slothold.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case slothold.FieldStaffID:
+		m.ResetStaffID()
+		return nil
+	case slothold.FieldClient:
+		m.ResetClient()
+		return nil
+	case slothold.FieldAddress:
+		m.ResetAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown SlotHold field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SlotHoldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.location != nil {
+		edges = append(edges, slothold.EdgeLocation)
+	}
+	if m.staff != nil {
+		edges = append(edges, slothold.EdgeStaff)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SlotHoldMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case slothold.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	case slothold.EdgeStaff:
+		if id := m.staff; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SlotHoldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SlotHoldMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SlotHoldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedlocation {
+		edges = append(edges, slothold.EdgeLocation)
+	}
+	if m.clearedstaff {
+		edges = append(edges, slothold.EdgeStaff)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SlotHoldMutation) EdgeCleared(name string) bool {
+	switch name {
+	case slothold.EdgeLocation:
+		return m.clearedlocation
+	case slothold.EdgeStaff:
+		return m.clearedstaff
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SlotHoldMutation) ClearEdge(name string) error {
+	switch name {
+	case slothold.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	case slothold.EdgeStaff:
+		m.ClearStaff()
+		return nil
+	}
+	return fmt.Errorf("unknown SlotHold unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SlotHoldMutation) ResetEdge(name string) error {
+	switch name {
+	case slothold.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	case slothold.EdgeStaff:
+		m.ResetStaff()
+		return nil
+	}
+	return fmt.Errorf("unknown SlotHold edge %s", name)
+}
+
+// StaffMutation represents an operation that mutates the Staff nodes in the graph.
+type StaffMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	name                 *string
+	types                *[]string
+	appendtypes          []string
+	clearedFields        map[string]struct{}
+	working_hours        map[int]struct{}
+	removedworking_hours map[int]struct{}
+	clearedworking_hours bool
+	appointments         map[int]struct{}
+	removedappointments  map[int]struct{}
+	clearedappointments  bool
+	slot_holds           map[int]struct{}
+	removedslot_holds    map[int]struct{}
+	clearedslot_holds    bool
+	location             *int
+	clearedlocation      bool
+	done                 bool
+	oldValue             func(context.Context) (*Staff, error)
+	predicates           []predicate.Staff
+}
+
+var _ ent.Mutation = (*StaffMutation)(nil)
+
+// staffOption allows management of the mutation configuration using functional options.
+type staffOption func(*StaffMutation)
+
+// newStaffMutation creates new mutation for the Staff entity.
+func newStaffMutation(c config, op Op, opts ...staffOption) *StaffMutation {
+	m := &StaffMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStaff,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStaffID sets the ID field of the mutation.
+func withStaffID(id int) staffOption {
+	return func(m *StaffMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Staff
+		)
+		m.oldValue = func(ctx context.Context) (*Staff, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Staff.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStaff sets the old Staff of the mutation.
+func withStaff(node *Staff) staffOption {
+	return func(m *StaffMutation) {
+		m.oldValue = func(context.Context) (*Staff, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StaffMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StaffMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StaffMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StaffMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Staff.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *StaffMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StaffMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Staff entity.
+// If the Staff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaffMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StaffMutation) ResetName() {
+	m.name = nil
+}
+
+// SetTypes sets the "types" field.
+func (m *StaffMutation) SetTypes(s []string) {
+	m.types = &s
+	m.appendtypes = nil
+}
+
+// Types returns the value of the "types" field in the mutation.
+func (m *StaffMutation) Types() (r []string, exists bool) {
+	v := m.types
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypes returns the old "types" field's value of the Staff entity.
+// If the Staff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaffMutation) OldTypes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypes: %w", err)
+	}
+	return oldValue.Types, nil
+}
+
+// AppendTypes adds s to the "types" field.
+func (m *StaffMutation) AppendTypes(s []string) {
+	m.appendtypes = append(m.appendtypes, s...)
+}
+
+// AppendedTypes returns the list of values that were appended to the "types" field in this mutation.
+func (m *StaffMutation) AppendedTypes() ([]string, bool) {
+	if len(m.appendtypes) == 0 {
+		return nil, false
+	}
+	return m.appendtypes, true
+}
+
+// ClearTypes clears the value of the "types" field.
+func (m *StaffMutation) ClearTypes() {
+	m.types = nil
+	m.appendtypes = nil
+	m.clearedFields[staff.FieldTypes] = struct{}{}
+}
+
+// TypesCleared returns if the "types" field was cleared in this mutation.
+func (m *StaffMutation) TypesCleared() bool {
+	_, ok := m.clearedFields[staff.FieldTypes]
+	return ok
+}
+
+// ResetTypes resets all changes to the "types" field.
+func (m *StaffMutation) ResetTypes() {
+	m.types = nil
+	m.appendtypes = nil
+	delete(m.clearedFields, staff.FieldTypes)
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *StaffMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *StaffMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the Staff entity.
+// If the Staff object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StaffMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *StaffMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[staff.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *StaffMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[staff.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *StaffMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, staff.FieldLocationID)
+}
+
+// AddWorkingHourIDs adds the "working_hours" edge to the WorkingHours entity by ids.
+func (m *StaffMutation) AddWorkingHourIDs(ids ...int) {
+	if m.working_hours == nil {
+		m.working_hours = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.working_hours[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorkingHours clears the "working_hours" edge to the WorkingHours entity.
+func (m *StaffMutation) ClearWorkingHours() {
+	m.clearedworking_hours = true
+}
+
+// WorkingHoursCleared reports if the "working_hours" edge to the WorkingHours entity was cleared.
+func (m *StaffMutation) WorkingHoursCleared() bool {
+	return m.clearedworking_hours
+}
+
+// RemoveWorkingHourIDs removes the "working_hours" edge to the WorkingHours entity by IDs.
+func (m *StaffMutation) RemoveWorkingHourIDs(ids ...int) {
+	if m.removedworking_hours == nil {
+		m.removedworking_hours = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.working_hours, ids[i])
+		m.removedworking_hours[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorkingHours returns the removed IDs of the "working_hours" edge to the WorkingHours entity.
+func (m *StaffMutation) RemovedWorkingHoursIDs() (ids []int) {
+	for id := range m.removedworking_hours {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorkingHoursIDs returns the "working_hours" edge IDs in the mutation.
+func (m *StaffMutation) WorkingHoursIDs() (ids []int) {
+	for id := range m.working_hours {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorkingHours resets all changes to the "working_hours" edge.
+func (m *StaffMutation) ResetWorkingHours() {
+	m.working_hours = nil
+	m.clearedworking_hours = false
+	m.removedworking_hours = nil
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by ids.
+func (m *StaffMutation) AddAppointmentIDs(ids ...int) {
+	if m.appointments == nil {
+		m.appointments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.appointments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAppointments clears the "appointments" edge to the Appointment entity.
+func (m *StaffMutation) ClearAppointments() {
+	m.clearedappointments = true
+}
+
+// AppointmentsCleared reports if the "appointments" edge to the Appointment entity was cleared.
+func (m *StaffMutation) AppointmentsCleared() bool {
+	return m.clearedappointments
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to the Appointment entity by IDs.
+func (m *StaffMutation) RemoveAppointmentIDs(ids ...int) {
+	if m.removedappointments == nil {
+		m.removedappointments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.appointments, ids[i])
+		m.removedappointments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAppointments returns the removed IDs of the "appointments" edge to the Appointment entity.
+func (m *StaffMutation) RemovedAppointmentsIDs() (ids []int) {
+	for id := range m.removedappointments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AppointmentsIDs returns the "appointments" edge IDs in the mutation.
+func (m *StaffMutation) AppointmentsIDs() (ids []int) {
+	for id := range m.appointments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAppointments resets all changes to the "appointments" edge.
+func (m *StaffMutation) ResetAppointments() {
+	m.appointments = nil
+	m.clearedappointments = false
+	m.removedappointments = nil
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by ids.
+func (m *StaffMutation) AddSlotHoldIDs(ids ...int) {
+	if m.slot_holds == nil {
+		m.slot_holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.slot_holds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSlotHolds clears the "slot_holds" edge to the SlotHold entity.
+func (m *StaffMutation) ClearSlotHolds() {
+	m.clearedslot_holds = true
+}
+
+// SlotHoldsCleared reports if the "slot_holds" edge to the SlotHold entity was cleared.
+func (m *StaffMutation) SlotHoldsCleared() bool {
+	return m.clearedslot_holds
+}
+
+// RemoveSlotHoldIDs removes the "slot_holds" edge to the SlotHold entity by IDs.
+func (m *StaffMutation) RemoveSlotHoldIDs(ids ...int) {
+	if m.removedslot_holds == nil {
+		m.removedslot_holds = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.slot_holds, ids[i])
+		m.removedslot_holds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSlotHolds returns the removed IDs of the "slot_holds" edge to the SlotHold entity.
+func (m *StaffMutation) RemovedSlotHoldsIDs() (ids []int) {
+	for id := range m.removedslot_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SlotHoldsIDs returns the "slot_holds" edge IDs in the mutation.
+func (m *StaffMutation) SlotHoldsIDs() (ids []int) {
+	for id := range m.slot_holds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSlotHolds resets all changes to the "slot_holds" edge.
+func (m *StaffMutation) ResetSlotHolds() {
+	m.slot_holds = nil
+	m.clearedslot_holds = false
+	m.removedslot_holds = nil
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *StaffMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[staff.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *StaffMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *StaffMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *StaffMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the StaffMutation builder.
+func (m *StaffMutation) Where(ps ...predicate.Staff) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StaffMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StaffMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Staff, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StaffMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StaffMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Staff).
+func (m *StaffMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StaffMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, staff.FieldName)
+	}
+	if m.types != nil {
+		fields = append(fields, staff.FieldTypes)
+	}
+	if m.location != nil {
+		fields = append(fields, staff.FieldLocationID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StaffMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case staff.FieldName:
+		return m.Name()
+	case staff.FieldTypes:
+		return m.Types()
+	case staff.FieldLocationID:
+		return m.LocationID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StaffMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case staff.FieldName:
+		return m.OldName(ctx)
+	case staff.FieldTypes:
+		return m.OldTypes(ctx)
+	case staff.FieldLocationID:
+		return m.OldLocationID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Staff field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StaffMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case staff.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case staff.FieldTypes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypes(v)
+		return nil
+	case staff.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Staff field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StaffMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StaffMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StaffMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Staff numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StaffMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(staff.FieldTypes) {
+		fields = append(fields, staff.FieldTypes)
+	}
+	if m.FieldCleared(staff.FieldLocationID) {
+		fields = append(fields, staff.FieldLocationID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StaffMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StaffMutation) ClearField(name string) error {
+	switch name {
+	case staff.FieldTypes:
+		m.ClearTypes()
+		return nil
+	case staff.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown Staff nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StaffMutation) ResetField(name string) error {
+	switch name {
+	case staff.FieldName:
+		m.ResetName()
+		return nil
+	case staff.FieldTypes:
+		m.ResetTypes()
+		return nil
+	case staff.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown Staff field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StaffMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.working_hours != nil {
+		edges = append(edges, staff.EdgeWorkingHours)
+	}
+	if m.appointments != nil {
+		edges = append(edges, staff.EdgeAppointments)
+	}
+	if m.slot_holds != nil {
+		edges = append(edges, staff.EdgeSlotHolds)
+	}
+	if m.location != nil {
+		edges = append(edges, staff.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StaffMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case staff.EdgeWorkingHours:
+		ids := make([]ent.Value, 0, len(m.working_hours))
+		for id := range m.working_hours {
+			ids = append(ids, id)
+		}
+		return ids
+	case staff.EdgeAppointments:
+		ids := make([]ent.Value, 0, len(m.appointments))
+		for id := range m.appointments {
+			ids = append(ids, id)
+		}
+		return ids
+	case staff.EdgeSlotHolds:
+		ids := make([]ent.Value, 0, len(m.slot_holds))
+		for id := range m.slot_holds {
+			ids = append(ids, id)
+		}
+		return ids
+	case staff.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StaffMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedworking_hours != nil {
+		edges = append(edges, staff.EdgeWorkingHours)
+	}
+	if m.removedappointments != nil {
+		edges = append(edges, staff.EdgeAppointments)
+	}
+	if m.removedslot_holds != nil {
+		edges = append(edges, staff.EdgeSlotHolds)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StaffMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case staff.EdgeWorkingHours:
+		ids := make([]ent.Value, 0, len(m.removedworking_hours))
+		for id := range m.removedworking_hours {
+			ids = append(ids, id)
+		}
+		return ids
+	case staff.EdgeAppointments:
+		ids := make([]ent.Value, 0, len(m.removedappointments))
+		for id := range m.removedappointments {
+			ids = append(ids, id)
+		}
+		return ids
+	case staff.EdgeSlotHolds:
+		ids := make([]ent.Value, 0, len(m.removedslot_holds))
+		for id := range m.removedslot_holds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StaffMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedworking_hours {
+		edges = append(edges, staff.EdgeWorkingHours)
+	}
+	if m.clearedappointments {
+		edges = append(edges, staff.EdgeAppointments)
+	}
+	if m.clearedslot_holds {
+		edges = append(edges, staff.EdgeSlotHolds)
+	}
+	if m.clearedlocation {
+		edges = append(edges, staff.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StaffMutation) EdgeCleared(name string) bool {
+	switch name {
+	case staff.EdgeWorkingHours:
+		return m.clearedworking_hours
+	case staff.EdgeAppointments:
+		return m.clearedappointments
+	case staff.EdgeSlotHolds:
+		return m.clearedslot_holds
+	case staff.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StaffMutation) ClearEdge(name string) error {
+	switch name {
+	case staff.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Staff unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StaffMutation) ResetEdge(name string) error {
+	switch name {
+	case staff.EdgeWorkingHours:
+		m.ResetWorkingHours()
+		return nil
+	case staff.EdgeAppointments:
+		m.ResetAppointments()
+		return nil
+	case staff.EdgeSlotHolds:
+		m.ResetSlotHolds()
+		return nil
+	case staff.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown Staff edge %s", name)
+}
+
+// WaitlistEntryMutation represents an operation that mutates the WaitlistEntry nodes in the graph.
+type WaitlistEntryMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	email            *string
+	phone            *string
+	date             *string
+	window_start     *string
+	window_end       *string
+	_type            *string
+	status           *waitlistentry.Status
+	token            *string
+	offered_start    *time.Time
+	offered_end      *time.Time
+	offer_expires_at *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	location         *int
+	clearedlocation  bool
+	done             bool
+	oldValue         func(context.Context) (*WaitlistEntry, error)
+	predicates       []predicate.WaitlistEntry
+}
+
+var _ ent.Mutation = (*WaitlistEntryMutation)(nil)
+
+// waitlistentryOption allows management of the mutation configuration using functional options.
+type waitlistentryOption func(*WaitlistEntryMutation)
+
+// newWaitlistEntryMutation creates new mutation for the WaitlistEntry entity.
+func newWaitlistEntryMutation(c config, op Op, opts ...waitlistentryOption) *WaitlistEntryMutation {
+	m := &WaitlistEntryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWaitlistEntry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWaitlistEntryID sets the ID field of the mutation.
+func withWaitlistEntryID(id int) waitlistentryOption {
+	return func(m *WaitlistEntryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WaitlistEntry
+		)
+		m.oldValue = func(ctx context.Context) (*WaitlistEntry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WaitlistEntry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWaitlistEntry sets the old WaitlistEntry of the mutation.
+func withWaitlistEntry(node *WaitlistEntry) waitlistentryOption {
+	return func(m *WaitlistEntryMutation) {
+		m.oldValue = func(context.Context) (*WaitlistEntry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WaitlistEntryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WaitlistEntryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WaitlistEntryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WaitlistEntryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WaitlistEntry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *WaitlistEntryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WaitlistEntryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WaitlistEntryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *WaitlistEntryMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *WaitlistEntryMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *WaitlistEntryMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPhone sets the "phone" field.
+func (m *WaitlistEntryMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *WaitlistEntryMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *WaitlistEntryMutation) ResetPhone() {
+	m.phone = nil
+}
+
+// SetDate sets the "date" field.
+func (m *WaitlistEntryMutation) SetDate(s string) {
+	m.date = &s
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *WaitlistEntryMutation) Date() (r string, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *WaitlistEntryMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetWindowStart sets the "window_start" field.
+func (m *WaitlistEntryMutation) SetWindowStart(s string) {
+	m.window_start = &s
+}
+
+// WindowStart returns the value of the "window_start" field in the mutation.
+func (m *WaitlistEntryMutation) WindowStart() (r string, exists bool) {
+	v := m.window_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWindowStart returns the old "window_start" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldWindowStart(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindowStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindowStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindowStart: %w", err)
+	}
+	return oldValue.WindowStart, nil
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (m *WaitlistEntryMutation) ClearWindowStart() {
+	m.window_start = nil
+	m.clearedFields[waitlistentry.FieldWindowStart] = struct{}{}
+}
+
+// WindowStartCleared returns if the "window_start" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) WindowStartCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldWindowStart]
+	return ok
+}
+
+// ResetWindowStart resets all changes to the "window_start" field.
+func (m *WaitlistEntryMutation) ResetWindowStart() {
+	m.window_start = nil
+	delete(m.clearedFields, waitlistentry.FieldWindowStart)
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (m *WaitlistEntryMutation) SetWindowEnd(s string) {
+	m.window_end = &s
+}
+
+// WindowEnd returns the value of the "window_end" field in the mutation.
+func (m *WaitlistEntryMutation) WindowEnd() (r string, exists bool) {
+	v := m.window_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWindowEnd returns the old "window_end" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldWindowEnd(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindowEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindowEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindowEnd: %w", err)
+	}
+	return oldValue.WindowEnd, nil
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (m *WaitlistEntryMutation) ClearWindowEnd() {
+	m.window_end = nil
+	m.clearedFields[waitlistentry.FieldWindowEnd] = struct{}{}
+}
+
+// WindowEndCleared returns if the "window_end" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) WindowEndCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldWindowEnd]
+	return ok
+}
+
+// ResetWindowEnd resets all changes to the "window_end" field.
+func (m *WaitlistEntryMutation) ResetWindowEnd() {
+	m.window_end = nil
+	delete(m.clearedFields, waitlistentry.FieldWindowEnd)
+}
+
+// SetType sets the "type" field.
+func (m *WaitlistEntryMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *WaitlistEntryMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ClearType clears the value of the "type" field.
+func (m *WaitlistEntryMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[waitlistentry.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the "type" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldType]
+	return ok
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *WaitlistEntryMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, waitlistentry.FieldType)
+}
+
+// SetStatus sets the "status" field.
+func (m *WaitlistEntryMutation) SetStatus(w waitlistentry.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WaitlistEntryMutation) Status() (r waitlistentry.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldStatus(ctx context.Context) (v waitlistentry.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WaitlistEntryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetToken sets the "token" field.
+func (m *WaitlistEntryMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *WaitlistEntryMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ClearToken clears the value of the "token" field.
+func (m *WaitlistEntryMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[waitlistentry.FieldToken] = struct{}{}
+}
+
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldToken]
+	return ok
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *WaitlistEntryMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, waitlistentry.FieldToken)
+}
+
+// SetOfferedStart sets the "offered_start" field.
+func (m *WaitlistEntryMutation) SetOfferedStart(t time.Time) {
+	m.offered_start = &t
+}
+
+// OfferedStart returns the value of the "offered_start" field in the mutation.
+func (m *WaitlistEntryMutation) OfferedStart() (r time.Time, exists bool) {
+	v := m.offered_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfferedStart returns the old "offered_start" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldOfferedStart(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOfferedStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOfferedStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfferedStart: %w", err)
+	}
+	return oldValue.OfferedStart, nil
+}
+
+// ClearOfferedStart clears the value of the "offered_start" field.
+func (m *WaitlistEntryMutation) ClearOfferedStart() {
+	m.offered_start = nil
+	m.clearedFields[waitlistentry.FieldOfferedStart] = struct{}{}
+}
+
+// OfferedStartCleared returns if the "offered_start" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) OfferedStartCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldOfferedStart]
+	return ok
+}
+
+// ResetOfferedStart resets all changes to the "offered_start" field.
+func (m *WaitlistEntryMutation) ResetOfferedStart() {
+	m.offered_start = nil
+	delete(m.clearedFields, waitlistentry.FieldOfferedStart)
+}
+
+// SetOfferedEnd sets the "offered_end" field.
+func (m *WaitlistEntryMutation) SetOfferedEnd(t time.Time) {
+	m.offered_end = &t
+}
+
+// OfferedEnd returns the value of the "offered_end" field in the mutation.
+func (m *WaitlistEntryMutation) OfferedEnd() (r time.Time, exists bool) {
+	v := m.offered_end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfferedEnd returns the old "offered_end" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldOfferedEnd(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOfferedEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOfferedEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfferedEnd: %w", err)
+	}
+	return oldValue.OfferedEnd, nil
+}
+
+// ClearOfferedEnd clears the value of the "offered_end" field.
+func (m *WaitlistEntryMutation) ClearOfferedEnd() {
+	m.offered_end = nil
+	m.clearedFields[waitlistentry.FieldOfferedEnd] = struct{}{}
+}
+
+// OfferedEndCleared returns if the "offered_end" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) OfferedEndCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldOfferedEnd]
+	return ok
+}
+
+// ResetOfferedEnd resets all changes to the "offered_end" field.
+func (m *WaitlistEntryMutation) ResetOfferedEnd() {
+	m.offered_end = nil
+	delete(m.clearedFields, waitlistentry.FieldOfferedEnd)
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (m *WaitlistEntryMutation) SetOfferExpiresAt(t time.Time) {
+	m.offer_expires_at = &t
+}
+
+// OfferExpiresAt returns the value of the "offer_expires_at" field in the mutation.
+func (m *WaitlistEntryMutation) OfferExpiresAt() (r time.Time, exists bool) {
+	v := m.offer_expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOfferExpiresAt returns the old "offer_expires_at" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldOfferExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOfferExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOfferExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOfferExpiresAt: %w", err)
+	}
+	return oldValue.OfferExpiresAt, nil
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (m *WaitlistEntryMutation) ClearOfferExpiresAt() {
+	m.offer_expires_at = nil
+	m.clearedFields[waitlistentry.FieldOfferExpiresAt] = struct{}{}
+}
+
+// OfferExpiresAtCleared returns if the "offer_expires_at" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) OfferExpiresAtCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldOfferExpiresAt]
+	return ok
+}
+
+// ResetOfferExpiresAt resets all changes to the "offer_expires_at" field.
+func (m *WaitlistEntryMutation) ResetOfferExpiresAt() {
+	m.offer_expires_at = nil
+	delete(m.clearedFields, waitlistentry.FieldOfferExpiresAt)
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *WaitlistEntryMutation) SetLocationID(i int) {
+	m.location = &i
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *WaitlistEntryMutation) LocationID() (r int, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldLocationID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *WaitlistEntryMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[waitlistentry.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *WaitlistEntryMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[waitlistentry.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *WaitlistEntryMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, waitlistentry.FieldLocationID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WaitlistEntryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WaitlistEntryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WaitlistEntry entity.
+// If the WaitlistEntry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaitlistEntryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WaitlistEntryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *WaitlistEntryMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[waitlistentry.FieldLocationID] = struct{}{}
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *WaitlistEntryMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *WaitlistEntryMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *WaitlistEntryMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the WaitlistEntryMutation builder.
+func (m *WaitlistEntryMutation) Where(ps ...predicate.WaitlistEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WaitlistEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WaitlistEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WaitlistEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WaitlistEntryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WaitlistEntryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WaitlistEntry).
+func (m *WaitlistEntryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WaitlistEntryMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.name != nil {
+		fields = append(fields, waitlistentry.FieldName)
+	}
+	if m.email != nil {
+		fields = append(fields, waitlistentry.FieldEmail)
+	}
+	if m.phone != nil {
+		fields = append(fields, waitlistentry.FieldPhone)
+	}
+	if m.date != nil {
+		fields = append(fields, waitlistentry.FieldDate)
+	}
+	if m.window_start != nil {
+		fields = append(fields, waitlistentry.FieldWindowStart)
+	}
+	if m.window_end != nil {
+		fields = append(fields, waitlistentry.FieldWindowEnd)
+	}
+	if m._type != nil {
+		fields = append(fields, waitlistentry.FieldType)
+	}
+	if m.status != nil {
+		fields = append(fields, waitlistentry.FieldStatus)
+	}
+	if m.token != nil {
+		fields = append(fields, waitlistentry.FieldToken)
+	}
+	if m.offered_start != nil {
+		fields = append(fields, waitlistentry.FieldOfferedStart)
+	}
+	if m.offered_end != nil {
+		fields = append(fields, waitlistentry.FieldOfferedEnd)
+	}
+	if m.offer_expires_at != nil {
+		fields = append(fields, waitlistentry.FieldOfferExpiresAt)
+	}
+	if m.location != nil {
+		fields = append(fields, waitlistentry.FieldLocationID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, waitlistentry.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WaitlistEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case waitlistentry.FieldName:
+		return m.Name()
+	case waitlistentry.FieldEmail:
+		return m.Email()
+	case waitlistentry.FieldPhone:
+		return m.Phone()
+	case waitlistentry.FieldDate:
+		return m.Date()
+	case waitlistentry.FieldWindowStart:
+		return m.WindowStart()
+	case waitlistentry.FieldWindowEnd:
+		return m.WindowEnd()
+	case waitlistentry.FieldType:
+		return m.GetType()
+	case waitlistentry.FieldStatus:
+		return m.Status()
+	case waitlistentry.FieldToken:
+		return m.Token()
+	case waitlistentry.FieldOfferedStart:
+		return m.OfferedStart()
+	case waitlistentry.FieldOfferedEnd:
+		return m.OfferedEnd()
+	case waitlistentry.FieldOfferExpiresAt:
+		return m.OfferExpiresAt()
+	case waitlistentry.FieldLocationID:
+		return m.LocationID()
+	case waitlistentry.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WaitlistEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case waitlistentry.FieldName:
+		return m.OldName(ctx)
+	case waitlistentry.FieldEmail:
+		return m.OldEmail(ctx)
+	case waitlistentry.FieldPhone:
+		return m.OldPhone(ctx)
+	case waitlistentry.FieldDate:
+		return m.OldDate(ctx)
+	case waitlistentry.FieldWindowStart:
+		return m.OldWindowStart(ctx)
+	case waitlistentry.FieldWindowEnd:
+		return m.OldWindowEnd(ctx)
+	case waitlistentry.FieldType:
+		return m.OldType(ctx)
+	case waitlistentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case waitlistentry.FieldToken:
+		return m.OldToken(ctx)
+	case waitlistentry.FieldOfferedStart:
+		return m.OldOfferedStart(ctx)
+	case waitlistentry.FieldOfferedEnd:
+		return m.OldOfferedEnd(ctx)
+	case waitlistentry.FieldOfferExpiresAt:
+		return m.OldOfferExpiresAt(ctx)
+	case waitlistentry.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case waitlistentry.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WaitlistEntry field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WaitlistEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case waitlistentry.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case waitlistentry.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case waitlistentry.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case waitlistentry.FieldDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case waitlistentry.FieldWindowStart:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowStart(v)
+		return nil
+	case waitlistentry.FieldWindowEnd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowEnd(v)
+		return nil
+	case waitlistentry.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case waitlistentry.FieldStatus:
+		v, ok := value.(waitlistentry.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case waitlistentry.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case waitlistentry.FieldOfferedStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfferedStart(v)
+		return nil
+	case waitlistentry.FieldOfferedEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfferedEnd(v)
+		return nil
+	case waitlistentry.FieldOfferExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOfferExpiresAt(v)
+		return nil
+	case waitlistentry.FieldLocationID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationID(v)
+		return nil
+	case waitlistentry.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WaitlistEntryMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WaitlistEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WaitlistEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown WaitlistEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WaitlistEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(waitlistentry.FieldWindowStart) {
+		fields = append(fields, waitlistentry.FieldWindowStart)
+	}
+	if m.FieldCleared(waitlistentry.FieldWindowEnd) {
+		fields = append(fields, waitlistentry.FieldWindowEnd)
+	}
+	if m.FieldCleared(waitlistentry.FieldType) {
+		fields = append(fields, waitlistentry.FieldType)
+	}
+	if m.FieldCleared(waitlistentry.FieldToken) {
+		fields = append(fields, waitlistentry.FieldToken)
+	}
+	if m.FieldCleared(waitlistentry.FieldOfferedStart) {
+		fields = append(fields, waitlistentry.FieldOfferedStart)
+	}
+	if m.FieldCleared(waitlistentry.FieldOfferedEnd) {
+		fields = append(fields, waitlistentry.FieldOfferedEnd)
+	}
+	if m.FieldCleared(waitlistentry.FieldOfferExpiresAt) {
+		fields = append(fields, waitlistentry.FieldOfferExpiresAt)
+	}
+	if m.FieldCleared(waitlistentry.FieldLocationID) {
+		fields = append(fields, waitlistentry.FieldLocationID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WaitlistEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WaitlistEntryMutation) ClearField(name string) error {
+	switch name {
+	case waitlistentry.FieldWindowStart:
+		m.ClearWindowStart()
+		return nil
+	case waitlistentry.FieldWindowEnd:
+		m.ClearWindowEnd()
+		return nil
+	case waitlistentry.FieldType:
+		m.ClearType()
+		return nil
+	case waitlistentry.FieldToken:
+		m.ClearToken()
+		return nil
+	case waitlistentry.FieldOfferedStart:
+		m.ClearOfferedStart()
+		return nil
+	case waitlistentry.FieldOfferedEnd:
+		m.ClearOfferedEnd()
+		return nil
+	case waitlistentry.FieldOfferExpiresAt:
+		m.ClearOfferExpiresAt()
+		return nil
+	case waitlistentry.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WaitlistEntryMutation) ResetField(name string) error {
+	switch name {
+	case waitlistentry.FieldName:
+		m.ResetName()
+		return nil
+	case waitlistentry.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case waitlistentry.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case waitlistentry.FieldDate:
+		m.ResetDate()
+		return nil
+	case waitlistentry.FieldWindowStart:
+		m.ResetWindowStart()
+		return nil
+	case waitlistentry.FieldWindowEnd:
+		m.ResetWindowEnd()
+		return nil
+	case waitlistentry.FieldType:
+		m.ResetType()
+		return nil
+	case waitlistentry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case waitlistentry.FieldToken:
+		m.ResetToken()
+		return nil
+	case waitlistentry.FieldOfferedStart:
+		m.ResetOfferedStart()
+		return nil
+	case waitlistentry.FieldOfferedEnd:
+		m.ResetOfferedEnd()
+		return nil
+	case waitlistentry.FieldOfferExpiresAt:
+		m.ResetOfferExpiresAt()
+		return nil
+	case waitlistentry.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case waitlistentry.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WaitlistEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.location != nil {
+		edges = append(edges, waitlistentry.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WaitlistEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case waitlistentry.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WaitlistEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WaitlistEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WaitlistEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedlocation {
+		edges = append(edges, waitlistentry.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WaitlistEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case waitlistentry.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WaitlistEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case waitlistentry.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WaitlistEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case waitlistentry.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown WaitlistEntry edge %s", name)
+}
+
+// WorkingHoursMutation represents an operation that mutates the WorkingHours nodes in the graph.
+type WorkingHoursMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	weekday       *int
+	addweekday    *int
+	start         *string
+	end           *string
+	clearedFields map[string]struct{}
+	staff         *int
+	clearedstaff  bool
+	done          bool
+	oldValue      func(context.Context) (*WorkingHours, error)
+	predicates    []predicate.WorkingHours
+}
+
+var _ ent.Mutation = (*WorkingHoursMutation)(nil)
+
+// workinghoursOption allows management of the mutation configuration using functional options.
+type workinghoursOption func(*WorkingHoursMutation)
+
+// newWorkingHoursMutation creates new mutation for the WorkingHours entity.
+func newWorkingHoursMutation(c config, op Op, opts ...workinghoursOption) *WorkingHoursMutation {
+	m := &WorkingHoursMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkingHours,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkingHoursID sets the ID field of the mutation.
+func withWorkingHoursID(id int) workinghoursOption {
+	return func(m *WorkingHoursMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WorkingHours
+		)
+		m.oldValue = func(ctx context.Context) (*WorkingHours, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WorkingHours.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkingHours sets the old WorkingHours of the mutation.
+func withWorkingHours(node *WorkingHours) workinghoursOption {
+	return func(m *WorkingHoursMutation) {
+		m.oldValue = func(context.Context) (*WorkingHours, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkingHoursMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkingHoursMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WorkingHoursMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WorkingHoursMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WorkingHours.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWeekday sets the "weekday" field.
+func (m *WorkingHoursMutation) SetWeekday(i int) {
+	m.weekday = &i
+	m.addweekday = nil
+}
+
+// Weekday returns the value of the "weekday" field in the mutation.
+func (m *WorkingHoursMutation) Weekday() (r int, exists bool) {
+	v := m.weekday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeekday returns the old "weekday" field's value of the WorkingHours entity.
+// If the WorkingHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkingHoursMutation) OldWeekday(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeekday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeekday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeekday: %w", err)
+	}
+	return oldValue.Weekday, nil
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (m *WorkingHoursMutation) AddWeekday(i int) {
+	if m.addweekday != nil {
+		*m.addweekday += i
+	} else {
+		m.addweekday = &i
+	}
+}
+
+// AddedWeekday returns the value that was added to the "weekday" field in this mutation.
+func (m *WorkingHoursMutation) AddedWeekday() (r int, exists bool) {
+	v := m.addweekday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeekday resets all changes to the "weekday" field.
+func (m *WorkingHoursMutation) ResetWeekday() {
+	m.weekday = nil
+	m.addweekday = nil
+}
+
+// SetStart sets the "start" field.
+func (m *WorkingHoursMutation) SetStart(s string) {
+	m.start = &s
+}
+
+// Start returns the value of the "start" field in the mutation.
+func (m *WorkingHoursMutation) Start() (r string, exists bool) {
+	v := m.start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStart returns the old "start" field's value of the WorkingHours entity.
+// If the WorkingHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkingHoursMutation) OldStart(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStart: %w", err)
+	}
+	return oldValue.Start, nil
+}
+
+// ResetStart resets all changes to the "start" field.
+func (m *WorkingHoursMutation) ResetStart() {
+	m.start = nil
+}
+
+// SetEnd sets the "end" field.
+func (m *WorkingHoursMutation) SetEnd(s string) {
+	m.end = &s
+}
+
+// End returns the value of the "end" field in the mutation.
+func (m *WorkingHoursMutation) End() (r string, exists bool) {
+	v := m.end
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnd returns the old "end" field's value of the WorkingHours entity.
+// If the WorkingHours object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkingHoursMutation) OldEnd(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnd: %w", err)
+	}
+	return oldValue.End, nil
+}
+
+// ResetEnd resets all changes to the "end" field.
+func (m *WorkingHoursMutation) ResetEnd() {
+	m.end = nil
+}
+
+// SetStaffID sets the "staff" edge to the Staff entity by id.
+func (m *WorkingHoursMutation) SetStaffID(id int) {
+	m.staff = &id
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (m *WorkingHoursMutation) ClearStaff() {
+	m.clearedstaff = true
+}
+
+// StaffCleared reports if the "staff" edge to the Staff entity was cleared.
+func (m *WorkingHoursMutation) StaffCleared() bool {
+	return m.clearedstaff
+}
+
+// StaffID returns the "staff" edge ID in the mutation.
+func (m *WorkingHoursMutation) StaffID() (id int, exists bool) {
+	if m.staff != nil {
+		return *m.staff, true
+	}
+	return
+}
+
+// StaffIDs returns the "staff" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StaffID instead. It exists only for internal usage by the builders.
+func (m *WorkingHoursMutation) StaffIDs() (ids []int) {
+	if id := m.staff; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStaff resets all changes to the "staff" edge.
+func (m *WorkingHoursMutation) ResetStaff() {
+	m.staff = nil
+	m.clearedstaff = false
+}
+
+// Where appends a list predicates to the WorkingHoursMutation builder.
+func (m *WorkingHoursMutation) Where(ps ...predicate.WorkingHours) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WorkingHoursMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WorkingHoursMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WorkingHours, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WorkingHoursMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WorkingHoursMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WorkingHours).
+func (m *WorkingHoursMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkingHoursMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.weekday != nil {
+		fields = append(fields, workinghours.FieldWeekday)
+	}
+	if m.start != nil {
+		fields = append(fields, workinghours.FieldStart)
+	}
+	if m.end != nil {
+		fields = append(fields, workinghours.FieldEnd)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkingHoursMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workinghours.FieldWeekday:
+		return m.Weekday()
+	case workinghours.FieldStart:
+		return m.Start()
+	case workinghours.FieldEnd:
+		return m.End()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkingHoursMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workinghours.FieldWeekday:
+		return m.OldWeekday(ctx)
+	case workinghours.FieldStart:
+		return m.OldStart(ctx)
+	case workinghours.FieldEnd:
+		return m.OldEnd(ctx)
+	}
+	return nil, fmt.Errorf("unknown WorkingHours field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkingHoursMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workinghours.FieldWeekday:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeekday(v)
+		return nil
+	case workinghours.FieldStart:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStart(v)
+		return nil
+	case workinghours.FieldEnd:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkingHours field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WorkingHoursMutation) AddedFields() []string {
+	var fields []string
+	if m.addweekday != nil {
+		fields = append(fields, workinghours.FieldWeekday)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WorkingHoursMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case workinghours.FieldWeekday:
+		return m.AddedWeekday()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkingHoursMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case workinghours.FieldWeekday:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeekday(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkingHours numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WorkingHoursMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WorkingHoursMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WorkingHoursMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WorkingHours nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WorkingHoursMutation) ResetField(name string) error {
+	switch name {
+	case workinghours.FieldWeekday:
+		m.ResetWeekday()
+		return nil
+	case workinghours.FieldStart:
+		m.ResetStart()
+		return nil
+	case workinghours.FieldEnd:
+		m.ResetEnd()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkingHours field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WorkingHoursMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.staff != nil {
+		edges = append(edges, workinghours.EdgeStaff)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WorkingHoursMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case workinghours.EdgeStaff:
+		if id := m.staff; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WorkingHoursMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WorkingHoursMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WorkingHoursMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstaff {
+		edges = append(edges, workinghours.EdgeStaff)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WorkingHoursMutation) EdgeCleared(name string) bool {
+	switch name {
+	case workinghours.EdgeStaff:
+		return m.clearedstaff
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WorkingHoursMutation) ClearEdge(name string) error {
+	switch name {
+	case workinghours.EdgeStaff:
+		m.ClearStaff()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkingHours unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WorkingHoursMutation) ResetEdge(name string) error {
+	switch name {
+	case workinghours.EdgeStaff:
+		m.ResetStaff()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkingHours edge %s", name)
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OpeningHours is the model entity for the OpeningHours schema.
+type OpeningHours struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Weekday holds the value of the "weekday" field.
+	Weekday int `json:"weekday,omitempty"`
+	// Open holds the value of the "open" field.
+	Open string `json:"open,omitempty"`
+	// Close holds the value of the "close" field.
+	Close string `json:"close,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OpeningHoursQuery when eager-loading is set.
+	Edges        OpeningHoursEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OpeningHoursEdges holds the relations/edges for other nodes in the graph.
+type OpeningHoursEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OpeningHoursEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OpeningHours) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case openinghours.FieldID, openinghours.FieldWeekday, openinghours.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case openinghours.FieldOpen, openinghours.FieldClose:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OpeningHours fields.
+func (oh *OpeningHours) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case openinghours.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oh.ID = int(value.Int64)
+		case openinghours.FieldWeekday:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weekday", values[i])
+			} else if value.Valid {
+				oh.Weekday = int(value.Int64)
+			}
+		case openinghours.FieldOpen:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open", values[i])
+			} else if value.Valid {
+				oh.Open = value.String
+			}
+		case openinghours.FieldClose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field close", values[i])
+			} else if value.Valid {
+				oh.Close = value.String
+			}
+		case openinghours.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				oh.LocationID = new(int)
+				*oh.LocationID = int(value.Int64)
+			}
+		default:
+			oh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OpeningHours.
+// This includes values selected through modifiers, order, etc.
+func (oh *OpeningHours) Value(name string) (ent.Value, error) {
+	return oh.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the OpeningHours entity.
+func (oh *OpeningHours) QueryLocation() *LocationQuery {
+	return NewOpeningHoursClient(oh.config).QueryLocation(oh)
+}
+
+// Update returns a builder for updating this OpeningHours.
+// Note that you need to call OpeningHours.Unwrap() before calling this method if this OpeningHours
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oh *OpeningHours) Update() *OpeningHoursUpdateOne {
+	return NewOpeningHoursClient(oh.config).UpdateOne(oh)
+}
+
+// Unwrap unwraps the OpeningHours entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oh *OpeningHours) Unwrap() *OpeningHours {
+	_tx, ok := oh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OpeningHours is not a transactional entity")
+	}
+	oh.config.driver = _tx.drv
+	return oh
+}
+
+// String implements the fmt.Stringer.
+func (oh *OpeningHours) String() string {
+	var builder strings.Builder
+	builder.WriteString("OpeningHours(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oh.ID))
+	builder.WriteString("weekday=")
+	builder.WriteString(fmt.Sprintf("%v", oh.Weekday))
+	builder.WriteString(", ")
+	builder.WriteString("open=")
+	builder.WriteString(oh.Open)
+	builder.WriteString(", ")
+	builder.WriteString("close=")
+	builder.WriteString(oh.Close)
+	builder.WriteString(", ")
+	if v := oh.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OpeningHoursSlice is a parsable slice of OpeningHours.
+type OpeningHoursSlice []*OpeningHours
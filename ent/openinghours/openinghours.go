@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package openinghours
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the openinghours type in the database.
+	Label = "opening_hours"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWeekday holds the string denoting the weekday field in the database.
+	FieldWeekday = "weekday"
+	// FieldOpen holds the string denoting the open field in the database.
+	FieldOpen = "open"
+	// FieldClose holds the string denoting the close field in the database.
+	FieldClose = "close"
+	// FieldLocationID holds the string denoting the location_id field in the database.
+	FieldLocationID = "location_id"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// Table holds the table name of the openinghours in the database.
+	Table = "opening_hours"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "opening_hours"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "location_id"
+)
+
+// Columns holds all SQL columns for openinghours fields.
+var Columns = []string{
+	FieldID,
+	FieldWeekday,
+	FieldOpen,
+	FieldClose,
+	FieldLocationID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// WeekdayValidator is a validator for the "weekday" field. It is called by the builders before save.
+	WeekdayValidator func(int) error
+	// OpenValidator is a validator for the "open" field. It is called by the builders before save.
+	OpenValidator func(string) error
+	// CloseValidator is a validator for the "close" field. It is called by the builders before save.
+	CloseValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the OpeningHours queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWeekday orders the results by the weekday field.
+func ByWeekday(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeekday, opts...).ToFunc()
+}
+
+// ByOpen orders the results by the open field.
+func ByOpen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpen, opts...).ToFunc()
+}
+
+// ByClose orders the results by the close field.
+func ByClose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClose, opts...).ToFunc()
+}
+
+// ByLocationID orders the results by the location_id field.
+func ByLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationID, opts...).ToFunc()
+}
+
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+	)
+}
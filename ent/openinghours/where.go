@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package openinghours
+
+import (
+	"TerminSystem/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLTE(FieldID, id))
+}
+
+// Weekday applies equality check predicate on the "weekday" field. It's identical to WeekdayEQ.
+func Weekday(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldWeekday, v))
+}
+
+// Open applies equality check predicate on the "open" field. It's identical to OpenEQ.
+func Open(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldOpen, v))
+}
+
+// Close applies equality check predicate on the "close" field. It's identical to CloseEQ.
+func Close(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldClose, v))
+}
+
+// LocationID applies equality check predicate on the "location_id" field. It's identical to LocationIDEQ.
+func LocationID(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldLocationID, v))
+}
+
+// WeekdayEQ applies the EQ predicate on the "weekday" field.
+func WeekdayEQ(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldWeekday, v))
+}
+
+// WeekdayNEQ applies the NEQ predicate on the "weekday" field.
+func WeekdayNEQ(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNEQ(FieldWeekday, v))
+}
+
+// WeekdayIn applies the In predicate on the "weekday" field.
+func WeekdayIn(vs ...int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldIn(FieldWeekday, vs...))
+}
+
+// WeekdayNotIn applies the NotIn predicate on the "weekday" field.
+func WeekdayNotIn(vs ...int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNotIn(FieldWeekday, vs...))
+}
+
+// WeekdayGT applies the GT predicate on the "weekday" field.
+func WeekdayGT(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGT(FieldWeekday, v))
+}
+
+// WeekdayGTE applies the GTE predicate on the "weekday" field.
+func WeekdayGTE(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGTE(FieldWeekday, v))
+}
+
+// WeekdayLT applies the LT predicate on the "weekday" field.
+func WeekdayLT(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLT(FieldWeekday, v))
+}
+
+// WeekdayLTE applies the LTE predicate on the "weekday" field.
+func WeekdayLTE(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLTE(FieldWeekday, v))
+}
+
+// OpenEQ applies the EQ predicate on the "open" field.
+func OpenEQ(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldOpen, v))
+}
+
+// OpenNEQ applies the NEQ predicate on the "open" field.
+func OpenNEQ(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNEQ(FieldOpen, v))
+}
+
+// OpenIn applies the In predicate on the "open" field.
+func OpenIn(vs ...string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldIn(FieldOpen, vs...))
+}
+
+// OpenNotIn applies the NotIn predicate on the "open" field.
+func OpenNotIn(vs ...string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNotIn(FieldOpen, vs...))
+}
+
+// OpenGT applies the GT predicate on the "open" field.
+func OpenGT(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGT(FieldOpen, v))
+}
+
+// OpenGTE applies the GTE predicate on the "open" field.
+func OpenGTE(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGTE(FieldOpen, v))
+}
+
+// OpenLT applies the LT predicate on the "open" field.
+func OpenLT(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLT(FieldOpen, v))
+}
+
+// OpenLTE applies the LTE predicate on the "open" field.
+func OpenLTE(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLTE(FieldOpen, v))
+}
+
+// OpenContains applies the Contains predicate on the "open" field.
+func OpenContains(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldContains(FieldOpen, v))
+}
+
+// OpenHasPrefix applies the HasPrefix predicate on the "open" field.
+func OpenHasPrefix(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldHasPrefix(FieldOpen, v))
+}
+
+// OpenHasSuffix applies the HasSuffix predicate on the "open" field.
+func OpenHasSuffix(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldHasSuffix(FieldOpen, v))
+}
+
+// OpenEqualFold applies the EqualFold predicate on the "open" field.
+func OpenEqualFold(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEqualFold(FieldOpen, v))
+}
+
+// OpenContainsFold applies the ContainsFold predicate on the "open" field.
+func OpenContainsFold(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldContainsFold(FieldOpen, v))
+}
+
+// CloseEQ applies the EQ predicate on the "close" field.
+func CloseEQ(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldClose, v))
+}
+
+// CloseNEQ applies the NEQ predicate on the "close" field.
+func CloseNEQ(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNEQ(FieldClose, v))
+}
+
+// CloseIn applies the In predicate on the "close" field.
+func CloseIn(vs ...string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldIn(FieldClose, vs...))
+}
+
+// CloseNotIn applies the NotIn predicate on the "close" field.
+func CloseNotIn(vs ...string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNotIn(FieldClose, vs...))
+}
+
+// CloseGT applies the GT predicate on the "close" field.
+func CloseGT(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGT(FieldClose, v))
+}
+
+// CloseGTE applies the GTE predicate on the "close" field.
+func CloseGTE(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldGTE(FieldClose, v))
+}
+
+// CloseLT applies the LT predicate on the "close" field.
+func CloseLT(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLT(FieldClose, v))
+}
+
+// CloseLTE applies the LTE predicate on the "close" field.
+func CloseLTE(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldLTE(FieldClose, v))
+}
+
+// CloseContains applies the Contains predicate on the "close" field.
+func CloseContains(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldContains(FieldClose, v))
+}
+
+// CloseHasPrefix applies the HasPrefix predicate on the "close" field.
+func CloseHasPrefix(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldHasPrefix(FieldClose, v))
+}
+
+// CloseHasSuffix applies the HasSuffix predicate on the "close" field.
+func CloseHasSuffix(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldHasSuffix(FieldClose, v))
+}
+
+// CloseEqualFold applies the EqualFold predicate on the "close" field.
+func CloseEqualFold(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEqualFold(FieldClose, v))
+}
+
+// CloseContainsFold applies the ContainsFold predicate on the "close" field.
+func CloseContainsFold(v string) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldContainsFold(FieldClose, v))
+}
+
+// LocationIDEQ applies the EQ predicate on the "location_id" field.
+func LocationIDEQ(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldEQ(FieldLocationID, v))
+}
+
+// LocationIDNEQ applies the NEQ predicate on the "location_id" field.
+func LocationIDNEQ(v int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNEQ(FieldLocationID, v))
+}
+
+// LocationIDIn applies the In predicate on the "location_id" field.
+func LocationIDIn(vs ...int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldIn(FieldLocationID, vs...))
+}
+
+// LocationIDNotIn applies the NotIn predicate on the "location_id" field.
+func LocationIDNotIn(vs ...int) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNotIn(FieldLocationID, vs...))
+}
+
+// LocationIDIsNil applies the IsNil predicate on the "location_id" field.
+func LocationIDIsNil() predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldIsNull(FieldLocationID))
+}
+
+// LocationIDNotNil applies the NotNil predicate on the "location_id" field.
+func LocationIDNotNil() predicate.OpeningHours {
+	return predicate.OpeningHours(sql.FieldNotNull(FieldLocationID))
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.OpeningHours {
+	return predicate.OpeningHours(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.OpeningHours {
+	return predicate.OpeningHours(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OpeningHours) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OpeningHours) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OpeningHours) predicate.OpeningHours {
+	return predicate.OpeningHours(sql.NotPredicates(p))
+}
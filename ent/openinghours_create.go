@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursCreate is the builder for creating a OpeningHours entity.
+type OpeningHoursCreate struct {
+	config
+	mutation *OpeningHoursMutation
+	hooks    []Hook
+}
+
+// SetWeekday sets the "weekday" field.
+func (ohc *OpeningHoursCreate) SetWeekday(i int) *OpeningHoursCreate {
+	ohc.mutation.SetWeekday(i)
+	return ohc
+}
+
+// SetOpen sets the "open" field.
+func (ohc *OpeningHoursCreate) SetOpen(s string) *OpeningHoursCreate {
+	ohc.mutation.SetOpen(s)
+	return ohc
+}
+
+// SetClose sets the "close" field.
+func (ohc *OpeningHoursCreate) SetClose(s string) *OpeningHoursCreate {
+	ohc.mutation.SetClose(s)
+	return ohc
+}
+
+// SetLocationID sets the "location_id" field.
+func (ohc *OpeningHoursCreate) SetLocationID(i int) *OpeningHoursCreate {
+	ohc.mutation.SetLocationID(i)
+	return ohc
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ohc *OpeningHoursCreate) SetNillableLocationID(i *int) *OpeningHoursCreate {
+	if i != nil {
+		ohc.SetLocationID(*i)
+	}
+	return ohc
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ohc *OpeningHoursCreate) SetLocation(l *Location) *OpeningHoursCreate {
+	return ohc.SetLocationID(l.ID)
+}
+
+// Mutation returns the OpeningHoursMutation object of the builder.
+func (ohc *OpeningHoursCreate) Mutation() *OpeningHoursMutation {
+	return ohc.mutation
+}
+
+// Save creates the OpeningHours in the database.
+func (ohc *OpeningHoursCreate) Save(ctx context.Context) (*OpeningHours, error) {
+	return withHooks(ctx, ohc.sqlSave, ohc.mutation, ohc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ohc *OpeningHoursCreate) SaveX(ctx context.Context) *OpeningHours {
+	v, err := ohc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ohc *OpeningHoursCreate) Exec(ctx context.Context) error {
+	_, err := ohc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohc *OpeningHoursCreate) ExecX(ctx context.Context) {
+	if err := ohc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ohc *OpeningHoursCreate) check() error {
+	if _, ok := ohc.mutation.Weekday(); !ok {
+		return &ValidationError{Name: "weekday", err: errors.New(`ent: missing required field "OpeningHours.weekday"`)}
+	}
+	if v, ok := ohc.mutation.Weekday(); ok {
+		if err := openinghours.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.weekday": %w`, err)}
+		}
+	}
+	if _, ok := ohc.mutation.Open(); !ok {
+		return &ValidationError{Name: "open", err: errors.New(`ent: missing required field "OpeningHours.open"`)}
+	}
+	if v, ok := ohc.mutation.Open(); ok {
+		if err := openinghours.OpenValidator(v); err != nil {
+			return &ValidationError{Name: "open", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.open": %w`, err)}
+		}
+	}
+	if _, ok := ohc.mutation.Close(); !ok {
+		return &ValidationError{Name: "close", err: errors.New(`ent: missing required field "OpeningHours.close"`)}
+	}
+	if v, ok := ohc.mutation.Close(); ok {
+		if err := openinghours.CloseValidator(v); err != nil {
+			return &ValidationError{Name: "close", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.close": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ohc *OpeningHoursCreate) sqlSave(ctx context.Context) (*OpeningHours, error) {
+	if err := ohc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ohc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ohc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ohc.mutation.id = &_node.ID
+	ohc.mutation.done = true
+	return _node, nil
+}
+
+func (ohc *OpeningHoursCreate) createSpec() (*OpeningHours, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OpeningHours{config: ohc.config}
+		_spec = sqlgraph.NewCreateSpec(openinghours.Table, sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt))
+	)
+	if value, ok := ohc.mutation.Weekday(); ok {
+		_spec.SetField(openinghours.FieldWeekday, field.TypeInt, value)
+		_node.Weekday = value
+	}
+	if value, ok := ohc.mutation.Open(); ok {
+		_spec.SetField(openinghours.FieldOpen, field.TypeString, value)
+		_node.Open = value
+	}
+	if value, ok := ohc.mutation.Close(); ok {
+		_spec.SetField(openinghours.FieldClose, field.TypeString, value)
+		_node.Close = value
+	}
+	if nodes := ohc.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghours.LocationTable,
+			Columns: []string{openinghours.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OpeningHoursCreateBulk is the builder for creating many OpeningHours entities in bulk.
+type OpeningHoursCreateBulk struct {
+	config
+	err      error
+	builders []*OpeningHoursCreate
+}
+
+// Save creates the OpeningHours entities in the database.
+func (ohcb *OpeningHoursCreateBulk) Save(ctx context.Context) ([]*OpeningHours, error) {
+	if ohcb.err != nil {
+		return nil, ohcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ohcb.builders))
+	nodes := make([]*OpeningHours, len(ohcb.builders))
+	mutators := make([]Mutator, len(ohcb.builders))
+	for i := range ohcb.builders {
+		func(i int, root context.Context) {
+			builder := ohcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OpeningHoursMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ohcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ohcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ohcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohcb *OpeningHoursCreateBulk) SaveX(ctx context.Context) []*OpeningHours {
+	v, err := ohcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ohcb *OpeningHoursCreateBulk) Exec(ctx context.Context) error {
+	_, err := ohcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohcb *OpeningHoursCreateBulk) ExecX(ctx context.Context) {
+	if err := ohcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
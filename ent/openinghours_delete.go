@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursDelete is the builder for deleting a OpeningHours entity.
+type OpeningHoursDelete struct {
+	config
+	hooks    []Hook
+	mutation *OpeningHoursMutation
+}
+
+// Where appends a list predicates to the OpeningHoursDelete builder.
+func (ohd *OpeningHoursDelete) Where(ps ...predicate.OpeningHours) *OpeningHoursDelete {
+	ohd.mutation.Where(ps...)
+	return ohd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ohd *OpeningHoursDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ohd.sqlExec, ohd.mutation, ohd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohd *OpeningHoursDelete) ExecX(ctx context.Context) int {
+	n, err := ohd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ohd *OpeningHoursDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(openinghours.Table, sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt))
+	if ps := ohd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ohd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ohd.mutation.done = true
+	return affected, err
+}
+
+// OpeningHoursDeleteOne is the builder for deleting a single OpeningHours entity.
+type OpeningHoursDeleteOne struct {
+	ohd *OpeningHoursDelete
+}
+
+// Where appends a list predicates to the OpeningHoursDelete builder.
+func (ohdo *OpeningHoursDeleteOne) Where(ps ...predicate.OpeningHours) *OpeningHoursDeleteOne {
+	ohdo.ohd.mutation.Where(ps...)
+	return ohdo
+}
+
+// Exec executes the deletion query.
+func (ohdo *OpeningHoursDeleteOne) Exec(ctx context.Context) error {
+	n, err := ohdo.ohd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{openinghours.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohdo *OpeningHoursDeleteOne) ExecX(ctx context.Context) {
+	if err := ohdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursQuery is the builder for querying OpeningHours entities.
+type OpeningHoursQuery struct {
+	config
+	ctx          *QueryContext
+	order        []openinghours.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.OpeningHours
+	withLocation *LocationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OpeningHoursQuery builder.
+func (ohq *OpeningHoursQuery) Where(ps ...predicate.OpeningHours) *OpeningHoursQuery {
+	ohq.predicates = append(ohq.predicates, ps...)
+	return ohq
+}
+
+// Limit the number of records to be returned by this query.
+func (ohq *OpeningHoursQuery) Limit(limit int) *OpeningHoursQuery {
+	ohq.ctx.Limit = &limit
+	return ohq
+}
+
+// Offset to start from.
+func (ohq *OpeningHoursQuery) Offset(offset int) *OpeningHoursQuery {
+	ohq.ctx.Offset = &offset
+	return ohq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ohq *OpeningHoursQuery) Unique(unique bool) *OpeningHoursQuery {
+	ohq.ctx.Unique = &unique
+	return ohq
+}
+
+// Order specifies how the records should be ordered.
+func (ohq *OpeningHoursQuery) Order(o ...openinghours.OrderOption) *OpeningHoursQuery {
+	ohq.order = append(ohq.order, o...)
+	return ohq
+}
+
+// QueryLocation chains the current query on the "location" edge.
+func (ohq *OpeningHoursQuery) QueryLocation() *LocationQuery {
+	query := (&LocationClient{config: ohq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ohq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ohq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(openinghours.Table, openinghours.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, openinghours.LocationTable, openinghours.LocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ohq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OpeningHours entity from the query.
+// Returns a *NotFoundError when no OpeningHours was found.
+func (ohq *OpeningHoursQuery) First(ctx context.Context) (*OpeningHours, error) {
+	nodes, err := ohq.Limit(1).All(setContextOp(ctx, ohq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{openinghours.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) FirstX(ctx context.Context) *OpeningHours {
+	node, err := ohq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OpeningHours ID from the query.
+// Returns a *NotFoundError when no OpeningHours ID was found.
+func (ohq *OpeningHoursQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ohq.Limit(1).IDs(setContextOp(ctx, ohq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{openinghours.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) FirstIDX(ctx context.Context) int {
+	id, err := ohq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OpeningHours entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OpeningHours entity is found.
+// Returns a *NotFoundError when no OpeningHours entities are found.
+func (ohq *OpeningHoursQuery) Only(ctx context.Context) (*OpeningHours, error) {
+	nodes, err := ohq.Limit(2).All(setContextOp(ctx, ohq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{openinghours.Label}
+	default:
+		return nil, &NotSingularError{openinghours.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) OnlyX(ctx context.Context) *OpeningHours {
+	node, err := ohq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OpeningHours ID in the query.
+// Returns a *NotSingularError when more than one OpeningHours ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ohq *OpeningHoursQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ohq.Limit(2).IDs(setContextOp(ctx, ohq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{openinghours.Label}
+	default:
+		err = &NotSingularError{openinghours.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ohq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OpeningHoursSlice.
+func (ohq *OpeningHoursQuery) All(ctx context.Context) ([]*OpeningHours, error) {
+	ctx = setContextOp(ctx, ohq.ctx, ent.OpQueryAll)
+	if err := ohq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OpeningHours, *OpeningHoursQuery]()
+	return withInterceptors[[]*OpeningHours](ctx, ohq, qr, ohq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) AllX(ctx context.Context) []*OpeningHours {
+	nodes, err := ohq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OpeningHours IDs.
+func (ohq *OpeningHoursQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ohq.ctx.Unique == nil && ohq.path != nil {
+		ohq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ohq.ctx, ent.OpQueryIDs)
+	if err = ohq.Select(openinghours.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) IDsX(ctx context.Context) []int {
+	ids, err := ohq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ohq *OpeningHoursQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ohq.ctx, ent.OpQueryCount)
+	if err := ohq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ohq, querierCount[*OpeningHoursQuery](), ohq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) CountX(ctx context.Context) int {
+	count, err := ohq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ohq *OpeningHoursQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ohq.ctx, ent.OpQueryExist)
+	switch _, err := ohq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ohq *OpeningHoursQuery) ExistX(ctx context.Context) bool {
+	exist, err := ohq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OpeningHoursQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ohq *OpeningHoursQuery) Clone() *OpeningHoursQuery {
+	if ohq == nil {
+		return nil
+	}
+	return &OpeningHoursQuery{
+		config:       ohq.config,
+		ctx:          ohq.ctx.Clone(),
+		order:        append([]openinghours.OrderOption{}, ohq.order...),
+		inters:       append([]Interceptor{}, ohq.inters...),
+		predicates:   append([]predicate.OpeningHours{}, ohq.predicates...),
+		withLocation: ohq.withLocation.Clone(),
+		// clone intermediate query.
+		sql:  ohq.sql.Clone(),
+		path: ohq.path,
+	}
+}
+
+// WithLocation tells the query-builder to eager-load the nodes that are connected to
+// the "location" edge. The optional arguments are used to configure the query builder of the edge.
+func (ohq *OpeningHoursQuery) WithLocation(opts ...func(*LocationQuery)) *OpeningHoursQuery {
+	query := (&LocationClient{config: ohq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ohq.withLocation = query
+	return ohq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Weekday int `json:"weekday,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OpeningHours.Query().
+//		GroupBy(openinghours.FieldWeekday).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ohq *OpeningHoursQuery) GroupBy(field string, fields ...string) *OpeningHoursGroupBy {
+	ohq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OpeningHoursGroupBy{build: ohq}
+	grbuild.flds = &ohq.ctx.Fields
+	grbuild.label = openinghours.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Weekday int `json:"weekday,omitempty"`
+//	}
+//
+//	client.OpeningHours.Query().
+//		Select(openinghours.FieldWeekday).
+//		Scan(ctx, &v)
+func (ohq *OpeningHoursQuery) Select(fields ...string) *OpeningHoursSelect {
+	ohq.ctx.Fields = append(ohq.ctx.Fields, fields...)
+	sbuild := &OpeningHoursSelect{OpeningHoursQuery: ohq}
+	sbuild.label = openinghours.Label
+	sbuild.flds, sbuild.scan = &ohq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OpeningHoursSelect configured with the given aggregations.
+func (ohq *OpeningHoursQuery) Aggregate(fns ...AggregateFunc) *OpeningHoursSelect {
+	return ohq.Select().Aggregate(fns...)
+}
+
+func (ohq *OpeningHoursQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ohq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ohq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ohq.ctx.Fields {
+		if !openinghours.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ohq.path != nil {
+		prev, err := ohq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ohq.sql = prev
+	}
+	return nil
+}
+
+func (ohq *OpeningHoursQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OpeningHours, error) {
+	var (
+		nodes       = []*OpeningHours{}
+		_spec       = ohq.querySpec()
+		loadedTypes = [1]bool{
+			ohq.withLocation != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OpeningHours).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OpeningHours{config: ohq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ohq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ohq.withLocation; query != nil {
+		if err := ohq.loadLocation(ctx, query, nodes, nil,
+			func(n *OpeningHours, e *Location) { n.Edges.Location = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ohq *OpeningHoursQuery) loadLocation(ctx context.Context, query *LocationQuery, nodes []*OpeningHours, init func(*OpeningHours), assign func(*OpeningHours, *Location)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OpeningHours)
+	for i := range nodes {
+		if nodes[i].LocationID == nil {
+			continue
+		}
+		fk := *nodes[i].LocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ohq *OpeningHoursQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ohq.querySpec()
+	_spec.Node.Columns = ohq.ctx.Fields
+	if len(ohq.ctx.Fields) > 0 {
+		_spec.Unique = ohq.ctx.Unique != nil && *ohq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ohq.driver, _spec)
+}
+
+func (ohq *OpeningHoursQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(openinghours.Table, openinghours.Columns, sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt))
+	_spec.From = ohq.sql
+	if unique := ohq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ohq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ohq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, openinghours.FieldID)
+		for i := range fields {
+			if fields[i] != openinghours.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ohq.withLocation != nil {
+			_spec.Node.AddColumnOnce(openinghours.FieldLocationID)
+		}
+	}
+	if ps := ohq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ohq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ohq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ohq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ohq *OpeningHoursQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ohq.driver.Dialect())
+	t1 := builder.Table(openinghours.Table)
+	columns := ohq.ctx.Fields
+	if len(columns) == 0 {
+		columns = openinghours.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ohq.sql != nil {
+		selector = ohq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ohq.ctx.Unique != nil && *ohq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ohq.predicates {
+		p(selector)
+	}
+	for _, p := range ohq.order {
+		p(selector)
+	}
+	if offset := ohq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ohq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OpeningHoursGroupBy is the group-by builder for OpeningHours entities.
+type OpeningHoursGroupBy struct {
+	selector
+	build *OpeningHoursQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ohgb *OpeningHoursGroupBy) Aggregate(fns ...AggregateFunc) *OpeningHoursGroupBy {
+	ohgb.fns = append(ohgb.fns, fns...)
+	return ohgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ohgb *OpeningHoursGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ohgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ohgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OpeningHoursQuery, *OpeningHoursGroupBy](ctx, ohgb.build, ohgb, ohgb.build.inters, v)
+}
+
+func (ohgb *OpeningHoursGroupBy) sqlScan(ctx context.Context, root *OpeningHoursQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ohgb.fns))
+	for _, fn := range ohgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ohgb.flds)+len(ohgb.fns))
+		for _, f := range *ohgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ohgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ohgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OpeningHoursSelect is the builder for selecting fields of OpeningHours entities.
+type OpeningHoursSelect struct {
+	*OpeningHoursQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ohs *OpeningHoursSelect) Aggregate(fns ...AggregateFunc) *OpeningHoursSelect {
+	ohs.fns = append(ohs.fns, fns...)
+	return ohs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ohs *OpeningHoursSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ohs.ctx, ent.OpQuerySelect)
+	if err := ohs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OpeningHoursQuery, *OpeningHoursSelect](ctx, ohs.OpeningHoursQuery, ohs, ohs.inters, v)
+}
+
+func (ohs *OpeningHoursSelect) sqlScan(ctx context.Context, root *OpeningHoursQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ohs.fns))
+	for _, fn := range ohs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ohs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ohs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
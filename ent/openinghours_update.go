@@ -0,0 +1,464 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursUpdate is the builder for updating OpeningHours entities.
+type OpeningHoursUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OpeningHoursMutation
+}
+
+// Where appends a list predicates to the OpeningHoursUpdate builder.
+func (ohu *OpeningHoursUpdate) Where(ps ...predicate.OpeningHours) *OpeningHoursUpdate {
+	ohu.mutation.Where(ps...)
+	return ohu
+}
+
+// SetWeekday sets the "weekday" field.
+func (ohu *OpeningHoursUpdate) SetWeekday(i int) *OpeningHoursUpdate {
+	ohu.mutation.ResetWeekday()
+	ohu.mutation.SetWeekday(i)
+	return ohu
+}
+
+// SetNillableWeekday sets the "weekday" field if the given value is not nil.
+func (ohu *OpeningHoursUpdate) SetNillableWeekday(i *int) *OpeningHoursUpdate {
+	if i != nil {
+		ohu.SetWeekday(*i)
+	}
+	return ohu
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (ohu *OpeningHoursUpdate) AddWeekday(i int) *OpeningHoursUpdate {
+	ohu.mutation.AddWeekday(i)
+	return ohu
+}
+
+// SetOpen sets the "open" field.
+func (ohu *OpeningHoursUpdate) SetOpen(s string) *OpeningHoursUpdate {
+	ohu.mutation.SetOpen(s)
+	return ohu
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (ohu *OpeningHoursUpdate) SetNillableOpen(s *string) *OpeningHoursUpdate {
+	if s != nil {
+		ohu.SetOpen(*s)
+	}
+	return ohu
+}
+
+// SetClose sets the "close" field.
+func (ohu *OpeningHoursUpdate) SetClose(s string) *OpeningHoursUpdate {
+	ohu.mutation.SetClose(s)
+	return ohu
+}
+
+// SetNillableClose sets the "close" field if the given value is not nil.
+func (ohu *OpeningHoursUpdate) SetNillableClose(s *string) *OpeningHoursUpdate {
+	if s != nil {
+		ohu.SetClose(*s)
+	}
+	return ohu
+}
+
+// SetLocationID sets the "location_id" field.
+func (ohu *OpeningHoursUpdate) SetLocationID(i int) *OpeningHoursUpdate {
+	ohu.mutation.SetLocationID(i)
+	return ohu
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ohu *OpeningHoursUpdate) SetNillableLocationID(i *int) *OpeningHoursUpdate {
+	if i != nil {
+		ohu.SetLocationID(*i)
+	}
+	return ohu
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (ohu *OpeningHoursUpdate) ClearLocationID() *OpeningHoursUpdate {
+	ohu.mutation.ClearLocationID()
+	return ohu
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ohu *OpeningHoursUpdate) SetLocation(l *Location) *OpeningHoursUpdate {
+	return ohu.SetLocationID(l.ID)
+}
+
+// Mutation returns the OpeningHoursMutation object of the builder.
+func (ohu *OpeningHoursUpdate) Mutation() *OpeningHoursMutation {
+	return ohu.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (ohu *OpeningHoursUpdate) ClearLocation() *OpeningHoursUpdate {
+	ohu.mutation.ClearLocation()
+	return ohu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ohu *OpeningHoursUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ohu.sqlSave, ohu.mutation, ohu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohu *OpeningHoursUpdate) SaveX(ctx context.Context) int {
+	affected, err := ohu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ohu *OpeningHoursUpdate) Exec(ctx context.Context) error {
+	_, err := ohu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohu *OpeningHoursUpdate) ExecX(ctx context.Context) {
+	if err := ohu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ohu *OpeningHoursUpdate) check() error {
+	if v, ok := ohu.mutation.Weekday(); ok {
+		if err := openinghours.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.weekday": %w`, err)}
+		}
+	}
+	if v, ok := ohu.mutation.Open(); ok {
+		if err := openinghours.OpenValidator(v); err != nil {
+			return &ValidationError{Name: "open", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.open": %w`, err)}
+		}
+	}
+	if v, ok := ohu.mutation.Close(); ok {
+		if err := openinghours.CloseValidator(v); err != nil {
+			return &ValidationError{Name: "close", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.close": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ohu *OpeningHoursUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ohu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(openinghours.Table, openinghours.Columns, sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt))
+	if ps := ohu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ohu.mutation.Weekday(); ok {
+		_spec.SetField(openinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := ohu.mutation.AddedWeekday(); ok {
+		_spec.AddField(openinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := ohu.mutation.Open(); ok {
+		_spec.SetField(openinghours.FieldOpen, field.TypeString, value)
+	}
+	if value, ok := ohu.mutation.Close(); ok {
+		_spec.SetField(openinghours.FieldClose, field.TypeString, value)
+	}
+	if ohu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghours.LocationTable,
+			Columns: []string{openinghours.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ohu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghours.LocationTable,
+			Columns: []string{openinghours.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ohu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{openinghours.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ohu.mutation.done = true
+	return n, nil
+}
+
+// OpeningHoursUpdateOne is the builder for updating a single OpeningHours entity.
+type OpeningHoursUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OpeningHoursMutation
+}
+
+// SetWeekday sets the "weekday" field.
+func (ohuo *OpeningHoursUpdateOne) SetWeekday(i int) *OpeningHoursUpdateOne {
+	ohuo.mutation.ResetWeekday()
+	ohuo.mutation.SetWeekday(i)
+	return ohuo
+}
+
+// SetNillableWeekday sets the "weekday" field if the given value is not nil.
+func (ohuo *OpeningHoursUpdateOne) SetNillableWeekday(i *int) *OpeningHoursUpdateOne {
+	if i != nil {
+		ohuo.SetWeekday(*i)
+	}
+	return ohuo
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (ohuo *OpeningHoursUpdateOne) AddWeekday(i int) *OpeningHoursUpdateOne {
+	ohuo.mutation.AddWeekday(i)
+	return ohuo
+}
+
+// SetOpen sets the "open" field.
+func (ohuo *OpeningHoursUpdateOne) SetOpen(s string) *OpeningHoursUpdateOne {
+	ohuo.mutation.SetOpen(s)
+	return ohuo
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (ohuo *OpeningHoursUpdateOne) SetNillableOpen(s *string) *OpeningHoursUpdateOne {
+	if s != nil {
+		ohuo.SetOpen(*s)
+	}
+	return ohuo
+}
+
+// SetClose sets the "close" field.
+func (ohuo *OpeningHoursUpdateOne) SetClose(s string) *OpeningHoursUpdateOne {
+	ohuo.mutation.SetClose(s)
+	return ohuo
+}
+
+// SetNillableClose sets the "close" field if the given value is not nil.
+func (ohuo *OpeningHoursUpdateOne) SetNillableClose(s *string) *OpeningHoursUpdateOne {
+	if s != nil {
+		ohuo.SetClose(*s)
+	}
+	return ohuo
+}
+
+// SetLocationID sets the "location_id" field.
+func (ohuo *OpeningHoursUpdateOne) SetLocationID(i int) *OpeningHoursUpdateOne {
+	ohuo.mutation.SetLocationID(i)
+	return ohuo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ohuo *OpeningHoursUpdateOne) SetNillableLocationID(i *int) *OpeningHoursUpdateOne {
+	if i != nil {
+		ohuo.SetLocationID(*i)
+	}
+	return ohuo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (ohuo *OpeningHoursUpdateOne) ClearLocationID() *OpeningHoursUpdateOne {
+	ohuo.mutation.ClearLocationID()
+	return ohuo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ohuo *OpeningHoursUpdateOne) SetLocation(l *Location) *OpeningHoursUpdateOne {
+	return ohuo.SetLocationID(l.ID)
+}
+
+// Mutation returns the OpeningHoursMutation object of the builder.
+func (ohuo *OpeningHoursUpdateOne) Mutation() *OpeningHoursMutation {
+	return ohuo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (ohuo *OpeningHoursUpdateOne) ClearLocation() *OpeningHoursUpdateOne {
+	ohuo.mutation.ClearLocation()
+	return ohuo
+}
+
+// Where appends a list predicates to the OpeningHoursUpdate builder.
+func (ohuo *OpeningHoursUpdateOne) Where(ps ...predicate.OpeningHours) *OpeningHoursUpdateOne {
+	ohuo.mutation.Where(ps...)
+	return ohuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ohuo *OpeningHoursUpdateOne) Select(field string, fields ...string) *OpeningHoursUpdateOne {
+	ohuo.fields = append([]string{field}, fields...)
+	return ohuo
+}
+
+// Save executes the query and returns the updated OpeningHours entity.
+func (ohuo *OpeningHoursUpdateOne) Save(ctx context.Context) (*OpeningHours, error) {
+	return withHooks(ctx, ohuo.sqlSave, ohuo.mutation, ohuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohuo *OpeningHoursUpdateOne) SaveX(ctx context.Context) *OpeningHours {
+	node, err := ohuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ohuo *OpeningHoursUpdateOne) Exec(ctx context.Context) error {
+	_, err := ohuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohuo *OpeningHoursUpdateOne) ExecX(ctx context.Context) {
+	if err := ohuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ohuo *OpeningHoursUpdateOne) check() error {
+	if v, ok := ohuo.mutation.Weekday(); ok {
+		if err := openinghours.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.weekday": %w`, err)}
+		}
+	}
+	if v, ok := ohuo.mutation.Open(); ok {
+		if err := openinghours.OpenValidator(v); err != nil {
+			return &ValidationError{Name: "open", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.open": %w`, err)}
+		}
+	}
+	if v, ok := ohuo.mutation.Close(); ok {
+		if err := openinghours.CloseValidator(v); err != nil {
+			return &ValidationError{Name: "close", err: fmt.Errorf(`ent: validator failed for field "OpeningHours.close": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ohuo *OpeningHoursUpdateOne) sqlSave(ctx context.Context) (_node *OpeningHours, err error) {
+	if err := ohuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(openinghours.Table, openinghours.Columns, sqlgraph.NewFieldSpec(openinghours.FieldID, field.TypeInt))
+	id, ok := ohuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OpeningHours.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ohuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, openinghours.FieldID)
+		for _, f := range fields {
+			if !openinghours.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != openinghours.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ohuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ohuo.mutation.Weekday(); ok {
+		_spec.SetField(openinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := ohuo.mutation.AddedWeekday(); ok {
+		_spec.AddField(openinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := ohuo.mutation.Open(); ok {
+		_spec.SetField(openinghours.FieldOpen, field.TypeString, value)
+	}
+	if value, ok := ohuo.mutation.Close(); ok {
+		_spec.SetField(openinghours.FieldClose, field.TypeString, value)
+	}
+	if ohuo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghours.LocationTable,
+			Columns: []string{openinghours.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ohuo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghours.LocationTable,
+			Columns: []string{openinghours.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OpeningHours{config: ohuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ohuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{openinghours.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ohuo.mutation.done = true
+	return _node, nil
+}
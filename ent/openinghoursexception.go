@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghoursexception"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// OpeningHoursException is the model entity for the OpeningHoursException schema.
+type OpeningHoursException struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Date holds the value of the "date" field.
+	Date string `json:"date,omitempty"`
+	// Open holds the value of the "open" field.
+	Open string `json:"open,omitempty"`
+	// Close holds the value of the "close" field.
+	Close string `json:"close,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OpeningHoursExceptionQuery when eager-loading is set.
+	Edges        OpeningHoursExceptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OpeningHoursExceptionEdges holds the relations/edges for other nodes in the graph.
+type OpeningHoursExceptionEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OpeningHoursExceptionEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OpeningHoursException) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case openinghoursexception.FieldID, openinghoursexception.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case openinghoursexception.FieldDate, openinghoursexception.FieldOpen, openinghoursexception.FieldClose:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OpeningHoursException fields.
+func (ohe *OpeningHoursException) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case openinghoursexception.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ohe.ID = int(value.Int64)
+		case openinghoursexception.FieldDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				ohe.Date = value.String
+			}
+		case openinghoursexception.FieldOpen:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open", values[i])
+			} else if value.Valid {
+				ohe.Open = value.String
+			}
+		case openinghoursexception.FieldClose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field close", values[i])
+			} else if value.Valid {
+				ohe.Close = value.String
+			}
+		case openinghoursexception.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				ohe.LocationID = new(int)
+				*ohe.LocationID = int(value.Int64)
+			}
+		default:
+			ohe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OpeningHoursException.
+// This includes values selected through modifiers, order, etc.
+func (ohe *OpeningHoursException) Value(name string) (ent.Value, error) {
+	return ohe.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the OpeningHoursException entity.
+func (ohe *OpeningHoursException) QueryLocation() *LocationQuery {
+	return NewOpeningHoursExceptionClient(ohe.config).QueryLocation(ohe)
+}
+
+// Update returns a builder for updating this OpeningHoursException.
+// Note that you need to call OpeningHoursException.Unwrap() before calling this method if this OpeningHoursException
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ohe *OpeningHoursException) Update() *OpeningHoursExceptionUpdateOne {
+	return NewOpeningHoursExceptionClient(ohe.config).UpdateOne(ohe)
+}
+
+// Unwrap unwraps the OpeningHoursException entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ohe *OpeningHoursException) Unwrap() *OpeningHoursException {
+	_tx, ok := ohe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OpeningHoursException is not a transactional entity")
+	}
+	ohe.config.driver = _tx.drv
+	return ohe
+}
+
+// String implements the fmt.Stringer.
+func (ohe *OpeningHoursException) String() string {
+	var builder strings.Builder
+	builder.WriteString("OpeningHoursException(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ohe.ID))
+	builder.WriteString("date=")
+	builder.WriteString(ohe.Date)
+	builder.WriteString(", ")
+	builder.WriteString("open=")
+	builder.WriteString(ohe.Open)
+	builder.WriteString(", ")
+	builder.WriteString("close=")
+	builder.WriteString(ohe.Close)
+	builder.WriteString(", ")
+	if v := ohe.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OpeningHoursExceptions is a parsable slice of OpeningHoursException.
+type OpeningHoursExceptions []*OpeningHoursException
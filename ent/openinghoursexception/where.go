@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package openinghoursexception
+
+import (
+	"TerminSystem/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLTE(FieldID, id))
+}
+
+// Date applies equality check predicate on the "date" field. It's identical to DateEQ.
+func Date(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldDate, v))
+}
+
+// Open applies equality check predicate on the "open" field. It's identical to OpenEQ.
+func Open(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldOpen, v))
+}
+
+// Close applies equality check predicate on the "close" field. It's identical to CloseEQ.
+func Close(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldClose, v))
+}
+
+// LocationID applies equality check predicate on the "location_id" field. It's identical to LocationIDEQ.
+func LocationID(v int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldLocationID, v))
+}
+
+// DateEQ applies the EQ predicate on the "date" field.
+func DateEQ(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldDate, v))
+}
+
+// DateNEQ applies the NEQ predicate on the "date" field.
+func DateNEQ(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNEQ(FieldDate, v))
+}
+
+// DateIn applies the In predicate on the "date" field.
+func DateIn(vs ...string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldIn(FieldDate, vs...))
+}
+
+// DateNotIn applies the NotIn predicate on the "date" field.
+func DateNotIn(vs ...string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNotIn(FieldDate, vs...))
+}
+
+// DateGT applies the GT predicate on the "date" field.
+func DateGT(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGT(FieldDate, v))
+}
+
+// DateGTE applies the GTE predicate on the "date" field.
+func DateGTE(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGTE(FieldDate, v))
+}
+
+// DateLT applies the LT predicate on the "date" field.
+func DateLT(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLT(FieldDate, v))
+}
+
+// DateLTE applies the LTE predicate on the "date" field.
+func DateLTE(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLTE(FieldDate, v))
+}
+
+// DateContains applies the Contains predicate on the "date" field.
+func DateContains(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldContains(FieldDate, v))
+}
+
+// DateHasPrefix applies the HasPrefix predicate on the "date" field.
+func DateHasPrefix(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldHasPrefix(FieldDate, v))
+}
+
+// DateHasSuffix applies the HasSuffix predicate on the "date" field.
+func DateHasSuffix(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldHasSuffix(FieldDate, v))
+}
+
+// DateEqualFold applies the EqualFold predicate on the "date" field.
+func DateEqualFold(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEqualFold(FieldDate, v))
+}
+
+// DateContainsFold applies the ContainsFold predicate on the "date" field.
+func DateContainsFold(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldContainsFold(FieldDate, v))
+}
+
+// OpenEQ applies the EQ predicate on the "open" field.
+func OpenEQ(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldOpen, v))
+}
+
+// OpenNEQ applies the NEQ predicate on the "open" field.
+func OpenNEQ(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNEQ(FieldOpen, v))
+}
+
+// OpenIn applies the In predicate on the "open" field.
+func OpenIn(vs ...string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldIn(FieldOpen, vs...))
+}
+
+// OpenNotIn applies the NotIn predicate on the "open" field.
+func OpenNotIn(vs ...string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNotIn(FieldOpen, vs...))
+}
+
+// OpenGT applies the GT predicate on the "open" field.
+func OpenGT(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGT(FieldOpen, v))
+}
+
+// OpenGTE applies the GTE predicate on the "open" field.
+func OpenGTE(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGTE(FieldOpen, v))
+}
+
+// OpenLT applies the LT predicate on the "open" field.
+func OpenLT(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLT(FieldOpen, v))
+}
+
+// OpenLTE applies the LTE predicate on the "open" field.
+func OpenLTE(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLTE(FieldOpen, v))
+}
+
+// OpenContains applies the Contains predicate on the "open" field.
+func OpenContains(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldContains(FieldOpen, v))
+}
+
+// OpenHasPrefix applies the HasPrefix predicate on the "open" field.
+func OpenHasPrefix(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldHasPrefix(FieldOpen, v))
+}
+
+// OpenHasSuffix applies the HasSuffix predicate on the "open" field.
+func OpenHasSuffix(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldHasSuffix(FieldOpen, v))
+}
+
+// OpenEqualFold applies the EqualFold predicate on the "open" field.
+func OpenEqualFold(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEqualFold(FieldOpen, v))
+}
+
+// OpenContainsFold applies the ContainsFold predicate on the "open" field.
+func OpenContainsFold(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldContainsFold(FieldOpen, v))
+}
+
+// CloseEQ applies the EQ predicate on the "close" field.
+func CloseEQ(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldClose, v))
+}
+
+// CloseNEQ applies the NEQ predicate on the "close" field.
+func CloseNEQ(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNEQ(FieldClose, v))
+}
+
+// CloseIn applies the In predicate on the "close" field.
+func CloseIn(vs ...string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldIn(FieldClose, vs...))
+}
+
+// CloseNotIn applies the NotIn predicate on the "close" field.
+func CloseNotIn(vs ...string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNotIn(FieldClose, vs...))
+}
+
+// CloseGT applies the GT predicate on the "close" field.
+func CloseGT(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGT(FieldClose, v))
+}
+
+// CloseGTE applies the GTE predicate on the "close" field.
+func CloseGTE(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldGTE(FieldClose, v))
+}
+
+// CloseLT applies the LT predicate on the "close" field.
+func CloseLT(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLT(FieldClose, v))
+}
+
+// CloseLTE applies the LTE predicate on the "close" field.
+func CloseLTE(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldLTE(FieldClose, v))
+}
+
+// CloseContains applies the Contains predicate on the "close" field.
+func CloseContains(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldContains(FieldClose, v))
+}
+
+// CloseHasPrefix applies the HasPrefix predicate on the "close" field.
+func CloseHasPrefix(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldHasPrefix(FieldClose, v))
+}
+
+// CloseHasSuffix applies the HasSuffix predicate on the "close" field.
+func CloseHasSuffix(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldHasSuffix(FieldClose, v))
+}
+
+// CloseEqualFold applies the EqualFold predicate on the "close" field.
+func CloseEqualFold(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEqualFold(FieldClose, v))
+}
+
+// CloseContainsFold applies the ContainsFold predicate on the "close" field.
+func CloseContainsFold(v string) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldContainsFold(FieldClose, v))
+}
+
+// LocationIDEQ applies the EQ predicate on the "location_id" field.
+func LocationIDEQ(v int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldEQ(FieldLocationID, v))
+}
+
+// LocationIDNEQ applies the NEQ predicate on the "location_id" field.
+func LocationIDNEQ(v int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNEQ(FieldLocationID, v))
+}
+
+// LocationIDIn applies the In predicate on the "location_id" field.
+func LocationIDIn(vs ...int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldIn(FieldLocationID, vs...))
+}
+
+// LocationIDNotIn applies the NotIn predicate on the "location_id" field.
+func LocationIDNotIn(vs ...int) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNotIn(FieldLocationID, vs...))
+}
+
+// LocationIDIsNil applies the IsNil predicate on the "location_id" field.
+func LocationIDIsNil() predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldIsNull(FieldLocationID))
+}
+
+// LocationIDNotNil applies the NotNil predicate on the "location_id" field.
+func LocationIDNotNil() predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.FieldNotNull(FieldLocationID))
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OpeningHoursException) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OpeningHoursException) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OpeningHoursException) predicate.OpeningHoursException {
+	return predicate.OpeningHoursException(sql.NotPredicates(p))
+}
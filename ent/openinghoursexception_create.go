@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghoursexception"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursExceptionCreate is the builder for creating a OpeningHoursException entity.
+type OpeningHoursExceptionCreate struct {
+	config
+	mutation *OpeningHoursExceptionMutation
+	hooks    []Hook
+}
+
+// SetDate sets the "date" field.
+func (ohec *OpeningHoursExceptionCreate) SetDate(s string) *OpeningHoursExceptionCreate {
+	ohec.mutation.SetDate(s)
+	return ohec
+}
+
+// SetOpen sets the "open" field.
+func (ohec *OpeningHoursExceptionCreate) SetOpen(s string) *OpeningHoursExceptionCreate {
+	ohec.mutation.SetOpen(s)
+	return ohec
+}
+
+// SetClose sets the "close" field.
+func (ohec *OpeningHoursExceptionCreate) SetClose(s string) *OpeningHoursExceptionCreate {
+	ohec.mutation.SetClose(s)
+	return ohec
+}
+
+// SetLocationID sets the "location_id" field.
+func (ohec *OpeningHoursExceptionCreate) SetLocationID(i int) *OpeningHoursExceptionCreate {
+	ohec.mutation.SetLocationID(i)
+	return ohec
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (ohec *OpeningHoursExceptionCreate) SetNillableLocationID(i *int) *OpeningHoursExceptionCreate {
+	if i != nil {
+		ohec.SetLocationID(*i)
+	}
+	return ohec
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (ohec *OpeningHoursExceptionCreate) SetLocation(l *Location) *OpeningHoursExceptionCreate {
+	return ohec.SetLocationID(l.ID)
+}
+
+// Mutation returns the OpeningHoursExceptionMutation object of the builder.
+func (ohec *OpeningHoursExceptionCreate) Mutation() *OpeningHoursExceptionMutation {
+	return ohec.mutation
+}
+
+// Save creates the OpeningHoursException in the database.
+func (ohec *OpeningHoursExceptionCreate) Save(ctx context.Context) (*OpeningHoursException, error) {
+	return withHooks(ctx, ohec.sqlSave, ohec.mutation, ohec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ohec *OpeningHoursExceptionCreate) SaveX(ctx context.Context) *OpeningHoursException {
+	v, err := ohec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ohec *OpeningHoursExceptionCreate) Exec(ctx context.Context) error {
+	_, err := ohec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohec *OpeningHoursExceptionCreate) ExecX(ctx context.Context) {
+	if err := ohec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ohec *OpeningHoursExceptionCreate) check() error {
+	if _, ok := ohec.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "OpeningHoursException.date"`)}
+	}
+	if v, ok := ohec.mutation.Date(); ok {
+		if err := openinghoursexception.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.date": %w`, err)}
+		}
+	}
+	if _, ok := ohec.mutation.Open(); !ok {
+		return &ValidationError{Name: "open", err: errors.New(`ent: missing required field "OpeningHoursException.open"`)}
+	}
+	if v, ok := ohec.mutation.Open(); ok {
+		if err := openinghoursexception.OpenValidator(v); err != nil {
+			return &ValidationError{Name: "open", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.open": %w`, err)}
+		}
+	}
+	if _, ok := ohec.mutation.Close(); !ok {
+		return &ValidationError{Name: "close", err: errors.New(`ent: missing required field "OpeningHoursException.close"`)}
+	}
+	if v, ok := ohec.mutation.Close(); ok {
+		if err := openinghoursexception.CloseValidator(v); err != nil {
+			return &ValidationError{Name: "close", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.close": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ohec *OpeningHoursExceptionCreate) sqlSave(ctx context.Context) (*OpeningHoursException, error) {
+	if err := ohec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ohec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ohec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ohec.mutation.id = &_node.ID
+	ohec.mutation.done = true
+	return _node, nil
+}
+
+func (ohec *OpeningHoursExceptionCreate) createSpec() (*OpeningHoursException, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OpeningHoursException{config: ohec.config}
+		_spec = sqlgraph.NewCreateSpec(openinghoursexception.Table, sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt))
+	)
+	if value, ok := ohec.mutation.Date(); ok {
+		_spec.SetField(openinghoursexception.FieldDate, field.TypeString, value)
+		_node.Date = value
+	}
+	if value, ok := ohec.mutation.Open(); ok {
+		_spec.SetField(openinghoursexception.FieldOpen, field.TypeString, value)
+		_node.Open = value
+	}
+	if value, ok := ohec.mutation.Close(); ok {
+		_spec.SetField(openinghoursexception.FieldClose, field.TypeString, value)
+		_node.Close = value
+	}
+	if nodes := ohec.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghoursexception.LocationTable,
+			Columns: []string{openinghoursexception.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OpeningHoursExceptionCreateBulk is the builder for creating many OpeningHoursException entities in bulk.
+type OpeningHoursExceptionCreateBulk struct {
+	config
+	err      error
+	builders []*OpeningHoursExceptionCreate
+}
+
+// Save creates the OpeningHoursException entities in the database.
+func (ohecb *OpeningHoursExceptionCreateBulk) Save(ctx context.Context) ([]*OpeningHoursException, error) {
+	if ohecb.err != nil {
+		return nil, ohecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ohecb.builders))
+	nodes := make([]*OpeningHoursException, len(ohecb.builders))
+	mutators := make([]Mutator, len(ohecb.builders))
+	for i := range ohecb.builders {
+		func(i int, root context.Context) {
+			builder := ohecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OpeningHoursExceptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ohecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ohecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ohecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ohecb *OpeningHoursExceptionCreateBulk) SaveX(ctx context.Context) []*OpeningHoursException {
+	v, err := ohecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ohecb *OpeningHoursExceptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ohecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohecb *OpeningHoursExceptionCreateBulk) ExecX(ctx context.Context) {
+	if err := ohecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/predicate"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursExceptionDelete is the builder for deleting a OpeningHoursException entity.
+type OpeningHoursExceptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *OpeningHoursExceptionMutation
+}
+
+// Where appends a list predicates to the OpeningHoursExceptionDelete builder.
+func (ohed *OpeningHoursExceptionDelete) Where(ps ...predicate.OpeningHoursException) *OpeningHoursExceptionDelete {
+	ohed.mutation.Where(ps...)
+	return ohed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ohed *OpeningHoursExceptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ohed.sqlExec, ohed.mutation, ohed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohed *OpeningHoursExceptionDelete) ExecX(ctx context.Context) int {
+	n, err := ohed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ohed *OpeningHoursExceptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(openinghoursexception.Table, sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt))
+	if ps := ohed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ohed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ohed.mutation.done = true
+	return affected, err
+}
+
+// OpeningHoursExceptionDeleteOne is the builder for deleting a single OpeningHoursException entity.
+type OpeningHoursExceptionDeleteOne struct {
+	ohed *OpeningHoursExceptionDelete
+}
+
+// Where appends a list predicates to the OpeningHoursExceptionDelete builder.
+func (ohedo *OpeningHoursExceptionDeleteOne) Where(ps ...predicate.OpeningHoursException) *OpeningHoursExceptionDeleteOne {
+	ohedo.ohed.mutation.Where(ps...)
+	return ohedo
+}
+
+// Exec executes the deletion query.
+func (ohedo *OpeningHoursExceptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := ohedo.ohed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{openinghoursexception.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ohedo *OpeningHoursExceptionDeleteOne) ExecX(ctx context.Context) {
+	if err := ohedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/predicate"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursExceptionQuery is the builder for querying OpeningHoursException entities.
+type OpeningHoursExceptionQuery struct {
+	config
+	ctx          *QueryContext
+	order        []openinghoursexception.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.OpeningHoursException
+	withLocation *LocationQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OpeningHoursExceptionQuery builder.
+func (oheq *OpeningHoursExceptionQuery) Where(ps ...predicate.OpeningHoursException) *OpeningHoursExceptionQuery {
+	oheq.predicates = append(oheq.predicates, ps...)
+	return oheq
+}
+
+// Limit the number of records to be returned by this query.
+func (oheq *OpeningHoursExceptionQuery) Limit(limit int) *OpeningHoursExceptionQuery {
+	oheq.ctx.Limit = &limit
+	return oheq
+}
+
+// Offset to start from.
+func (oheq *OpeningHoursExceptionQuery) Offset(offset int) *OpeningHoursExceptionQuery {
+	oheq.ctx.Offset = &offset
+	return oheq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oheq *OpeningHoursExceptionQuery) Unique(unique bool) *OpeningHoursExceptionQuery {
+	oheq.ctx.Unique = &unique
+	return oheq
+}
+
+// Order specifies how the records should be ordered.
+func (oheq *OpeningHoursExceptionQuery) Order(o ...openinghoursexception.OrderOption) *OpeningHoursExceptionQuery {
+	oheq.order = append(oheq.order, o...)
+	return oheq
+}
+
+// QueryLocation chains the current query on the "location" edge.
+func (oheq *OpeningHoursExceptionQuery) QueryLocation() *LocationQuery {
+	query := (&LocationClient{config: oheq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oheq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oheq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(openinghoursexception.Table, openinghoursexception.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, openinghoursexception.LocationTable, openinghoursexception.LocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oheq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OpeningHoursException entity from the query.
+// Returns a *NotFoundError when no OpeningHoursException was found.
+func (oheq *OpeningHoursExceptionQuery) First(ctx context.Context) (*OpeningHoursException, error) {
+	nodes, err := oheq.Limit(1).All(setContextOp(ctx, oheq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{openinghoursexception.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) FirstX(ctx context.Context) *OpeningHoursException {
+	node, err := oheq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OpeningHoursException ID from the query.
+// Returns a *NotFoundError when no OpeningHoursException ID was found.
+func (oheq *OpeningHoursExceptionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oheq.Limit(1).IDs(setContextOp(ctx, oheq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{openinghoursexception.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) FirstIDX(ctx context.Context) int {
+	id, err := oheq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OpeningHoursException entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OpeningHoursException entity is found.
+// Returns a *NotFoundError when no OpeningHoursException entities are found.
+func (oheq *OpeningHoursExceptionQuery) Only(ctx context.Context) (*OpeningHoursException, error) {
+	nodes, err := oheq.Limit(2).All(setContextOp(ctx, oheq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{openinghoursexception.Label}
+	default:
+		return nil, &NotSingularError{openinghoursexception.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) OnlyX(ctx context.Context) *OpeningHoursException {
+	node, err := oheq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OpeningHoursException ID in the query.
+// Returns a *NotSingularError when more than one OpeningHoursException ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oheq *OpeningHoursExceptionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = oheq.Limit(2).IDs(setContextOp(ctx, oheq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{openinghoursexception.Label}
+	default:
+		err = &NotSingularError{openinghoursexception.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := oheq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OpeningHoursExceptions.
+func (oheq *OpeningHoursExceptionQuery) All(ctx context.Context) ([]*OpeningHoursException, error) {
+	ctx = setContextOp(ctx, oheq.ctx, ent.OpQueryAll)
+	if err := oheq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OpeningHoursException, *OpeningHoursExceptionQuery]()
+	return withInterceptors[[]*OpeningHoursException](ctx, oheq, qr, oheq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) AllX(ctx context.Context) []*OpeningHoursException {
+	nodes, err := oheq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OpeningHoursException IDs.
+func (oheq *OpeningHoursExceptionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if oheq.ctx.Unique == nil && oheq.path != nil {
+		oheq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oheq.ctx, ent.OpQueryIDs)
+	if err = oheq.Select(openinghoursexception.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) IDsX(ctx context.Context) []int {
+	ids, err := oheq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oheq *OpeningHoursExceptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oheq.ctx, ent.OpQueryCount)
+	if err := oheq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oheq, querierCount[*OpeningHoursExceptionQuery](), oheq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) CountX(ctx context.Context) int {
+	count, err := oheq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oheq *OpeningHoursExceptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oheq.ctx, ent.OpQueryExist)
+	switch _, err := oheq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oheq *OpeningHoursExceptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := oheq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OpeningHoursExceptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oheq *OpeningHoursExceptionQuery) Clone() *OpeningHoursExceptionQuery {
+	if oheq == nil {
+		return nil
+	}
+	return &OpeningHoursExceptionQuery{
+		config:       oheq.config,
+		ctx:          oheq.ctx.Clone(),
+		order:        append([]openinghoursexception.OrderOption{}, oheq.order...),
+		inters:       append([]Interceptor{}, oheq.inters...),
+		predicates:   append([]predicate.OpeningHoursException{}, oheq.predicates...),
+		withLocation: oheq.withLocation.Clone(),
+		// clone intermediate query.
+		sql:  oheq.sql.Clone(),
+		path: oheq.path,
+	}
+}
+
+// WithLocation tells the query-builder to eager-load the nodes that are connected to
+// the "location" edge. The optional arguments are used to configure the query builder of the edge.
+func (oheq *OpeningHoursExceptionQuery) WithLocation(opts ...func(*LocationQuery)) *OpeningHoursExceptionQuery {
+	query := (&LocationClient{config: oheq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oheq.withLocation = query
+	return oheq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Date string `json:"date,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OpeningHoursException.Query().
+//		GroupBy(openinghoursexception.FieldDate).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oheq *OpeningHoursExceptionQuery) GroupBy(field string, fields ...string) *OpeningHoursExceptionGroupBy {
+	oheq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OpeningHoursExceptionGroupBy{build: oheq}
+	grbuild.flds = &oheq.ctx.Fields
+	grbuild.label = openinghoursexception.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Date string `json:"date,omitempty"`
+//	}
+//
+//	client.OpeningHoursException.Query().
+//		Select(openinghoursexception.FieldDate).
+//		Scan(ctx, &v)
+func (oheq *OpeningHoursExceptionQuery) Select(fields ...string) *OpeningHoursExceptionSelect {
+	oheq.ctx.Fields = append(oheq.ctx.Fields, fields...)
+	sbuild := &OpeningHoursExceptionSelect{OpeningHoursExceptionQuery: oheq}
+	sbuild.label = openinghoursexception.Label
+	sbuild.flds, sbuild.scan = &oheq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OpeningHoursExceptionSelect configured with the given aggregations.
+func (oheq *OpeningHoursExceptionQuery) Aggregate(fns ...AggregateFunc) *OpeningHoursExceptionSelect {
+	return oheq.Select().Aggregate(fns...)
+}
+
+func (oheq *OpeningHoursExceptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oheq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oheq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oheq.ctx.Fields {
+		if !openinghoursexception.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oheq.path != nil {
+		prev, err := oheq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oheq.sql = prev
+	}
+	return nil
+}
+
+func (oheq *OpeningHoursExceptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OpeningHoursException, error) {
+	var (
+		nodes       = []*OpeningHoursException{}
+		_spec       = oheq.querySpec()
+		loadedTypes = [1]bool{
+			oheq.withLocation != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OpeningHoursException).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OpeningHoursException{config: oheq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oheq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oheq.withLocation; query != nil {
+		if err := oheq.loadLocation(ctx, query, nodes, nil,
+			func(n *OpeningHoursException, e *Location) { n.Edges.Location = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oheq *OpeningHoursExceptionQuery) loadLocation(ctx context.Context, query *LocationQuery, nodes []*OpeningHoursException, init func(*OpeningHoursException), assign func(*OpeningHoursException, *Location)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*OpeningHoursException)
+	for i := range nodes {
+		if nodes[i].LocationID == nil {
+			continue
+		}
+		fk := *nodes[i].LocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oheq *OpeningHoursExceptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oheq.querySpec()
+	_spec.Node.Columns = oheq.ctx.Fields
+	if len(oheq.ctx.Fields) > 0 {
+		_spec.Unique = oheq.ctx.Unique != nil && *oheq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oheq.driver, _spec)
+}
+
+func (oheq *OpeningHoursExceptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(openinghoursexception.Table, openinghoursexception.Columns, sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt))
+	_spec.From = oheq.sql
+	if unique := oheq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oheq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oheq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, openinghoursexception.FieldID)
+		for i := range fields {
+			if fields[i] != openinghoursexception.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if oheq.withLocation != nil {
+			_spec.Node.AddColumnOnce(openinghoursexception.FieldLocationID)
+		}
+	}
+	if ps := oheq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oheq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oheq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oheq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oheq *OpeningHoursExceptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oheq.driver.Dialect())
+	t1 := builder.Table(openinghoursexception.Table)
+	columns := oheq.ctx.Fields
+	if len(columns) == 0 {
+		columns = openinghoursexception.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oheq.sql != nil {
+		selector = oheq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oheq.ctx.Unique != nil && *oheq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range oheq.predicates {
+		p(selector)
+	}
+	for _, p := range oheq.order {
+		p(selector)
+	}
+	if offset := oheq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oheq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OpeningHoursExceptionGroupBy is the group-by builder for OpeningHoursException entities.
+type OpeningHoursExceptionGroupBy struct {
+	selector
+	build *OpeningHoursExceptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ohegb *OpeningHoursExceptionGroupBy) Aggregate(fns ...AggregateFunc) *OpeningHoursExceptionGroupBy {
+	ohegb.fns = append(ohegb.fns, fns...)
+	return ohegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ohegb *OpeningHoursExceptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ohegb.build.ctx, ent.OpQueryGroupBy)
+	if err := ohegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OpeningHoursExceptionQuery, *OpeningHoursExceptionGroupBy](ctx, ohegb.build, ohegb, ohegb.build.inters, v)
+}
+
+func (ohegb *OpeningHoursExceptionGroupBy) sqlScan(ctx context.Context, root *OpeningHoursExceptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ohegb.fns))
+	for _, fn := range ohegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ohegb.flds)+len(ohegb.fns))
+		for _, f := range *ohegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ohegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ohegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OpeningHoursExceptionSelect is the builder for selecting fields of OpeningHoursException entities.
+type OpeningHoursExceptionSelect struct {
+	*OpeningHoursExceptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ohes *OpeningHoursExceptionSelect) Aggregate(fns ...AggregateFunc) *OpeningHoursExceptionSelect {
+	ohes.fns = append(ohes.fns, fns...)
+	return ohes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ohes *OpeningHoursExceptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ohes.ctx, ent.OpQuerySelect)
+	if err := ohes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OpeningHoursExceptionQuery, *OpeningHoursExceptionSelect](ctx, ohes.OpeningHoursExceptionQuery, ohes, ohes.inters, v)
+}
+
+func (ohes *OpeningHoursExceptionSelect) sqlScan(ctx context.Context, root *OpeningHoursExceptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ohes.fns))
+	for _, fn := range ohes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ohes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ohes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
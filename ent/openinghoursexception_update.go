@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghoursexception"
+	"TerminSystem/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// OpeningHoursExceptionUpdate is the builder for updating OpeningHoursException entities.
+type OpeningHoursExceptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OpeningHoursExceptionMutation
+}
+
+// Where appends a list predicates to the OpeningHoursExceptionUpdate builder.
+func (oheu *OpeningHoursExceptionUpdate) Where(ps ...predicate.OpeningHoursException) *OpeningHoursExceptionUpdate {
+	oheu.mutation.Where(ps...)
+	return oheu
+}
+
+// SetDate sets the "date" field.
+func (oheu *OpeningHoursExceptionUpdate) SetDate(s string) *OpeningHoursExceptionUpdate {
+	oheu.mutation.SetDate(s)
+	return oheu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (oheu *OpeningHoursExceptionUpdate) SetNillableDate(s *string) *OpeningHoursExceptionUpdate {
+	if s != nil {
+		oheu.SetDate(*s)
+	}
+	return oheu
+}
+
+// SetOpen sets the "open" field.
+func (oheu *OpeningHoursExceptionUpdate) SetOpen(s string) *OpeningHoursExceptionUpdate {
+	oheu.mutation.SetOpen(s)
+	return oheu
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (oheu *OpeningHoursExceptionUpdate) SetNillableOpen(s *string) *OpeningHoursExceptionUpdate {
+	if s != nil {
+		oheu.SetOpen(*s)
+	}
+	return oheu
+}
+
+// SetClose sets the "close" field.
+func (oheu *OpeningHoursExceptionUpdate) SetClose(s string) *OpeningHoursExceptionUpdate {
+	oheu.mutation.SetClose(s)
+	return oheu
+}
+
+// SetNillableClose sets the "close" field if the given value is not nil.
+func (oheu *OpeningHoursExceptionUpdate) SetNillableClose(s *string) *OpeningHoursExceptionUpdate {
+	if s != nil {
+		oheu.SetClose(*s)
+	}
+	return oheu
+}
+
+// SetLocationID sets the "location_id" field.
+func (oheu *OpeningHoursExceptionUpdate) SetLocationID(i int) *OpeningHoursExceptionUpdate {
+	oheu.mutation.SetLocationID(i)
+	return oheu
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (oheu *OpeningHoursExceptionUpdate) SetNillableLocationID(i *int) *OpeningHoursExceptionUpdate {
+	if i != nil {
+		oheu.SetLocationID(*i)
+	}
+	return oheu
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (oheu *OpeningHoursExceptionUpdate) ClearLocationID() *OpeningHoursExceptionUpdate {
+	oheu.mutation.ClearLocationID()
+	return oheu
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (oheu *OpeningHoursExceptionUpdate) SetLocation(l *Location) *OpeningHoursExceptionUpdate {
+	return oheu.SetLocationID(l.ID)
+}
+
+// Mutation returns the OpeningHoursExceptionMutation object of the builder.
+func (oheu *OpeningHoursExceptionUpdate) Mutation() *OpeningHoursExceptionMutation {
+	return oheu.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (oheu *OpeningHoursExceptionUpdate) ClearLocation() *OpeningHoursExceptionUpdate {
+	oheu.mutation.ClearLocation()
+	return oheu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oheu *OpeningHoursExceptionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oheu.sqlSave, oheu.mutation, oheu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oheu *OpeningHoursExceptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := oheu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oheu *OpeningHoursExceptionUpdate) Exec(ctx context.Context) error {
+	_, err := oheu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oheu *OpeningHoursExceptionUpdate) ExecX(ctx context.Context) {
+	if err := oheu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oheu *OpeningHoursExceptionUpdate) check() error {
+	if v, ok := oheu.mutation.Date(); ok {
+		if err := openinghoursexception.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.date": %w`, err)}
+		}
+	}
+	if v, ok := oheu.mutation.Open(); ok {
+		if err := openinghoursexception.OpenValidator(v); err != nil {
+			return &ValidationError{Name: "open", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.open": %w`, err)}
+		}
+	}
+	if v, ok := oheu.mutation.Close(); ok {
+		if err := openinghoursexception.CloseValidator(v); err != nil {
+			return &ValidationError{Name: "close", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.close": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oheu *OpeningHoursExceptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oheu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(openinghoursexception.Table, openinghoursexception.Columns, sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt))
+	if ps := oheu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oheu.mutation.Date(); ok {
+		_spec.SetField(openinghoursexception.FieldDate, field.TypeString, value)
+	}
+	if value, ok := oheu.mutation.Open(); ok {
+		_spec.SetField(openinghoursexception.FieldOpen, field.TypeString, value)
+	}
+	if value, ok := oheu.mutation.Close(); ok {
+		_spec.SetField(openinghoursexception.FieldClose, field.TypeString, value)
+	}
+	if oheu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghoursexception.LocationTable,
+			Columns: []string{openinghoursexception.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oheu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghoursexception.LocationTable,
+			Columns: []string{openinghoursexception.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oheu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{openinghoursexception.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oheu.mutation.done = true
+	return n, nil
+}
+
+// OpeningHoursExceptionUpdateOne is the builder for updating a single OpeningHoursException entity.
+type OpeningHoursExceptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OpeningHoursExceptionMutation
+}
+
+// SetDate sets the "date" field.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetDate(s string) *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.SetDate(s)
+	return oheuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetNillableDate(s *string) *OpeningHoursExceptionUpdateOne {
+	if s != nil {
+		oheuo.SetDate(*s)
+	}
+	return oheuo
+}
+
+// SetOpen sets the "open" field.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetOpen(s string) *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.SetOpen(s)
+	return oheuo
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetNillableOpen(s *string) *OpeningHoursExceptionUpdateOne {
+	if s != nil {
+		oheuo.SetOpen(*s)
+	}
+	return oheuo
+}
+
+// SetClose sets the "close" field.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetClose(s string) *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.SetClose(s)
+	return oheuo
+}
+
+// SetNillableClose sets the "close" field if the given value is not nil.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetNillableClose(s *string) *OpeningHoursExceptionUpdateOne {
+	if s != nil {
+		oheuo.SetClose(*s)
+	}
+	return oheuo
+}
+
+// SetLocationID sets the "location_id" field.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetLocationID(i int) *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.SetLocationID(i)
+	return oheuo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetNillableLocationID(i *int) *OpeningHoursExceptionUpdateOne {
+	if i != nil {
+		oheuo.SetLocationID(*i)
+	}
+	return oheuo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (oheuo *OpeningHoursExceptionUpdateOne) ClearLocationID() *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.ClearLocationID()
+	return oheuo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (oheuo *OpeningHoursExceptionUpdateOne) SetLocation(l *Location) *OpeningHoursExceptionUpdateOne {
+	return oheuo.SetLocationID(l.ID)
+}
+
+// Mutation returns the OpeningHoursExceptionMutation object of the builder.
+func (oheuo *OpeningHoursExceptionUpdateOne) Mutation() *OpeningHoursExceptionMutation {
+	return oheuo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (oheuo *OpeningHoursExceptionUpdateOne) ClearLocation() *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.ClearLocation()
+	return oheuo
+}
+
+// Where appends a list predicates to the OpeningHoursExceptionUpdate builder.
+func (oheuo *OpeningHoursExceptionUpdateOne) Where(ps ...predicate.OpeningHoursException) *OpeningHoursExceptionUpdateOne {
+	oheuo.mutation.Where(ps...)
+	return oheuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oheuo *OpeningHoursExceptionUpdateOne) Select(field string, fields ...string) *OpeningHoursExceptionUpdateOne {
+	oheuo.fields = append([]string{field}, fields...)
+	return oheuo
+}
+
+// Save executes the query and returns the updated OpeningHoursException entity.
+func (oheuo *OpeningHoursExceptionUpdateOne) Save(ctx context.Context) (*OpeningHoursException, error) {
+	return withHooks(ctx, oheuo.sqlSave, oheuo.mutation, oheuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oheuo *OpeningHoursExceptionUpdateOne) SaveX(ctx context.Context) *OpeningHoursException {
+	node, err := oheuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oheuo *OpeningHoursExceptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := oheuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oheuo *OpeningHoursExceptionUpdateOne) ExecX(ctx context.Context) {
+	if err := oheuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oheuo *OpeningHoursExceptionUpdateOne) check() error {
+	if v, ok := oheuo.mutation.Date(); ok {
+		if err := openinghoursexception.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.date": %w`, err)}
+		}
+	}
+	if v, ok := oheuo.mutation.Open(); ok {
+		if err := openinghoursexception.OpenValidator(v); err != nil {
+			return &ValidationError{Name: "open", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.open": %w`, err)}
+		}
+	}
+	if v, ok := oheuo.mutation.Close(); ok {
+		if err := openinghoursexception.CloseValidator(v); err != nil {
+			return &ValidationError{Name: "close", err: fmt.Errorf(`ent: validator failed for field "OpeningHoursException.close": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oheuo *OpeningHoursExceptionUpdateOne) sqlSave(ctx context.Context) (_node *OpeningHoursException, err error) {
+	if err := oheuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(openinghoursexception.Table, openinghoursexception.Columns, sqlgraph.NewFieldSpec(openinghoursexception.FieldID, field.TypeInt))
+	id, ok := oheuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OpeningHoursException.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oheuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, openinghoursexception.FieldID)
+		for _, f := range fields {
+			if !openinghoursexception.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != openinghoursexception.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oheuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oheuo.mutation.Date(); ok {
+		_spec.SetField(openinghoursexception.FieldDate, field.TypeString, value)
+	}
+	if value, ok := oheuo.mutation.Open(); ok {
+		_spec.SetField(openinghoursexception.FieldOpen, field.TypeString, value)
+	}
+	if value, ok := oheuo.mutation.Close(); ok {
+		_spec.SetField(openinghoursexception.FieldClose, field.TypeString, value)
+	}
+	if oheuo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghoursexception.LocationTable,
+			Columns: []string{openinghoursexception.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oheuo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   openinghoursexception.LocationTable,
+			Columns: []string{openinghoursexception.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OpeningHoursException{config: oheuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oheuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{openinghoursexception.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oheuo.mutation.done = true
+	return _node, nil
+}
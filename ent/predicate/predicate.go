@@ -8,3 +8,33 @@ import (
 
 // Appointment is the predicate function for appointment builders.
 type Appointment func(*sql.Selector)
+
+// BlockedTime is the predicate function for blockedtime builders.
+type BlockedTime func(*sql.Selector)
+
+// ClosureDay is the predicate function for closureday builders.
+type ClosureDay func(*sql.Selector)
+
+// Location is the predicate function for location builders.
+type Location func(*sql.Selector)
+
+// OpeningHours is the predicate function for openinghours builders.
+type OpeningHours func(*sql.Selector)
+
+// OpeningHoursException is the predicate function for openinghoursexception builders.
+type OpeningHoursException func(*sql.Selector)
+
+// Reminder is the predicate function for reminder builders.
+type Reminder func(*sql.Selector)
+
+// SlotHold is the predicate function for slothold builders.
+type SlotHold func(*sql.Selector)
+
+// Staff is the predicate function for staff builders.
+type Staff func(*sql.Selector)
+
+// WaitlistEntry is the predicate function for waitlistentry builders.
+type WaitlistEntry func(*sql.Selector)
+
+// WorkingHours is the predicate function for workinghours builders.
+type WorkingHours func(*sql.Selector)
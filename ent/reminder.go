@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/reminder"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reminder is the model entity for the Reminder schema.
+type Reminder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// AppointmentID holds the value of the "appointment_id" field.
+	AppointmentID int `json:"appointment_id,omitempty"`
+	// Offset holds the value of the "offset" field.
+	Offset time.Duration `json:"offset,omitempty"`
+	// SentAt holds the value of the "sent_at" field.
+	SentAt time.Time `json:"sent_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReminderQuery when eager-loading is set.
+	Edges        ReminderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReminderEdges holds the relations/edges for other nodes in the graph.
+type ReminderEdges struct {
+	// Appointment holds the value of the appointment edge.
+	Appointment *Appointment `json:"appointment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AppointmentOrErr returns the Appointment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReminderEdges) AppointmentOrErr() (*Appointment, error) {
+	if e.Appointment != nil {
+		return e.Appointment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: appointment.Label}
+	}
+	return nil, &NotLoadedError{edge: "appointment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reminder) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reminder.FieldID, reminder.FieldAppointmentID, reminder.FieldOffset:
+			values[i] = new(sql.NullInt64)
+		case reminder.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reminder fields.
+func (r *Reminder) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reminder.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			r.ID = int(value.Int64)
+		case reminder.FieldAppointmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field appointment_id", values[i])
+			} else if value.Valid {
+				r.AppointmentID = int(value.Int64)
+			}
+		case reminder.FieldOffset:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				r.Offset = time.Duration(value.Int64)
+			}
+		case reminder.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				r.SentAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reminder.
+// This includes values selected through modifiers, order, etc.
+func (r *Reminder) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// QueryAppointment queries the "appointment" edge of the Reminder entity.
+func (r *Reminder) QueryAppointment() *AppointmentQuery {
+	return NewReminderClient(r.config).QueryAppointment(r)
+}
+
+// Update returns a builder for updating this Reminder.
+// Note that you need to call Reminder.Unwrap() before calling this method if this Reminder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reminder) Update() *ReminderUpdateOne {
+	return NewReminderClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reminder entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reminder) Unwrap() *Reminder {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reminder is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reminder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reminder(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("appointment_id=")
+	builder.WriteString(fmt.Sprintf("%v", r.AppointmentID))
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", r.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("sent_at=")
+	builder.WriteString(r.SentAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reminders is a parsable slice of Reminder.
+type Reminders []*Reminder
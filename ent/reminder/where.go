@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reminder
+
+import (
+	"TerminSystem/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldID, id))
+}
+
+// AppointmentID applies equality check predicate on the "appointment_id" field. It's identical to AppointmentIDEQ.
+func AppointmentID(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// Offset applies equality check predicate on the "offset" field. It's identical to OffsetEQ.
+func Offset(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldEQ(FieldOffset, vc))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldSentAt, v))
+}
+
+// AppointmentIDEQ applies the EQ predicate on the "appointment_id" field.
+func AppointmentIDEQ(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDNEQ applies the NEQ predicate on the "appointment_id" field.
+func AppointmentIDNEQ(v int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldAppointmentID, v))
+}
+
+// AppointmentIDIn applies the In predicate on the "appointment_id" field.
+func AppointmentIDIn(vs ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldAppointmentID, vs...))
+}
+
+// AppointmentIDNotIn applies the NotIn predicate on the "appointment_id" field.
+func AppointmentIDNotIn(vs ...int) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldAppointmentID, vs...))
+}
+
+// OffsetEQ applies the EQ predicate on the "offset" field.
+func OffsetEQ(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldEQ(FieldOffset, vc))
+}
+
+// OffsetNEQ applies the NEQ predicate on the "offset" field.
+func OffsetNEQ(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldNEQ(FieldOffset, vc))
+}
+
+// OffsetIn applies the In predicate on the "offset" field.
+func OffsetIn(vs ...time.Duration) predicate.Reminder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Reminder(sql.FieldIn(FieldOffset, v...))
+}
+
+// OffsetNotIn applies the NotIn predicate on the "offset" field.
+func OffsetNotIn(vs ...time.Duration) predicate.Reminder {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Reminder(sql.FieldNotIn(FieldOffset, v...))
+}
+
+// OffsetGT applies the GT predicate on the "offset" field.
+func OffsetGT(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldGT(FieldOffset, vc))
+}
+
+// OffsetGTE applies the GTE predicate on the "offset" field.
+func OffsetGTE(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldGTE(FieldOffset, vc))
+}
+
+// OffsetLT applies the LT predicate on the "offset" field.
+func OffsetLT(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldLT(FieldOffset, vc))
+}
+
+// OffsetLTE applies the LTE predicate on the "offset" field.
+func OffsetLTE(v time.Duration) predicate.Reminder {
+	vc := int64(v)
+	return predicate.Reminder(sql.FieldLTE(FieldOffset, vc))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.Reminder {
+	return predicate.Reminder(sql.FieldLTE(FieldSentAt, v))
+}
+
+// HasAppointment applies the HasEdge predicate on the "appointment" edge.
+func HasAppointment() predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AppointmentTable, AppointmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppointmentWith applies the HasEdge predicate on the "appointment" edge with a given conditions (other predicates).
+func HasAppointmentWith(preds ...predicate.Appointment) predicate.Reminder {
+	return predicate.Reminder(func(s *sql.Selector) {
+		step := newAppointmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reminder) predicate.Reminder {
+	return predicate.Reminder(sql.NotPredicates(p))
+}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/reminder"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderCreate is the builder for creating a Reminder entity.
+type ReminderCreate struct {
+	config
+	mutation *ReminderMutation
+	hooks    []Hook
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (rc *ReminderCreate) SetAppointmentID(i int) *ReminderCreate {
+	rc.mutation.SetAppointmentID(i)
+	return rc
+}
+
+// SetOffset sets the "offset" field.
+func (rc *ReminderCreate) SetOffset(t time.Duration) *ReminderCreate {
+	rc.mutation.SetOffset(t)
+	return rc
+}
+
+// SetSentAt sets the "sent_at" field.
+func (rc *ReminderCreate) SetSentAt(t time.Time) *ReminderCreate {
+	rc.mutation.SetSentAt(t)
+	return rc
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (rc *ReminderCreate) SetAppointment(a *Appointment) *ReminderCreate {
+	return rc.SetAppointmentID(a.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (rc *ReminderCreate) Mutation() *ReminderMutation {
+	return rc.mutation
+}
+
+// Save creates the Reminder in the database.
+func (rc *ReminderCreate) Save(ctx context.Context) (*Reminder, error) {
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReminderCreate) SaveX(ctx context.Context) *Reminder {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReminderCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReminderCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReminderCreate) check() error {
+	if _, ok := rc.mutation.AppointmentID(); !ok {
+		return &ValidationError{Name: "appointment_id", err: errors.New(`ent: missing required field "Reminder.appointment_id"`)}
+	}
+	if _, ok := rc.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "Reminder.offset"`)}
+	}
+	if _, ok := rc.mutation.SentAt(); !ok {
+		return &ValidationError{Name: "sent_at", err: errors.New(`ent: missing required field "Reminder.sent_at"`)}
+	}
+	if len(rc.mutation.AppointmentIDs()) == 0 {
+		return &ValidationError{Name: "appointment", err: errors.New(`ent: missing required edge "Reminder.appointment"`)}
+	}
+	return nil
+}
+
+func (rc *ReminderCreate) sqlSave(ctx context.Context) (*Reminder, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReminderCreate) createSpec() (*Reminder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reminder{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reminder.Table, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt))
+	)
+	if value, ok := rc.mutation.Offset(); ok {
+		_spec.SetField(reminder.FieldOffset, field.TypeInt64, value)
+		_node.Offset = value
+	}
+	if value, ok := rc.mutation.SentAt(); ok {
+		_spec.SetField(reminder.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = value
+	}
+	if nodes := rc.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.AppointmentTable,
+			Columns: []string{reminder.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AppointmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReminderCreateBulk is the builder for creating many Reminder entities in bulk.
+type ReminderCreateBulk struct {
+	config
+	err      error
+	builders []*ReminderCreate
+}
+
+// Save creates the Reminder entities in the database.
+func (rcb *ReminderCreateBulk) Save(ctx context.Context) ([]*Reminder, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reminder, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReminderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReminderCreateBulk) SaveX(ctx context.Context) []*Reminder {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReminderCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReminderCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
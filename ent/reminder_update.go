@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/reminder"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReminderUpdate is the builder for updating Reminder entities.
+type ReminderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReminderMutation
+}
+
+// Where appends a list predicates to the ReminderUpdate builder.
+func (ru *ReminderUpdate) Where(ps ...predicate.Reminder) *ReminderUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (ru *ReminderUpdate) SetAppointmentID(i int) *ReminderUpdate {
+	ru.mutation.SetAppointmentID(i)
+	return ru
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableAppointmentID(i *int) *ReminderUpdate {
+	if i != nil {
+		ru.SetAppointmentID(*i)
+	}
+	return ru
+}
+
+// SetOffset sets the "offset" field.
+func (ru *ReminderUpdate) SetOffset(t time.Duration) *ReminderUpdate {
+	ru.mutation.ResetOffset()
+	ru.mutation.SetOffset(t)
+	return ru
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableOffset(t *time.Duration) *ReminderUpdate {
+	if t != nil {
+		ru.SetOffset(*t)
+	}
+	return ru
+}
+
+// AddOffset adds t to the "offset" field.
+func (ru *ReminderUpdate) AddOffset(t time.Duration) *ReminderUpdate {
+	ru.mutation.AddOffset(t)
+	return ru
+}
+
+// SetSentAt sets the "sent_at" field.
+func (ru *ReminderUpdate) SetSentAt(t time.Time) *ReminderUpdate {
+	ru.mutation.SetSentAt(t)
+	return ru
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (ru *ReminderUpdate) SetNillableSentAt(t *time.Time) *ReminderUpdate {
+	if t != nil {
+		ru.SetSentAt(*t)
+	}
+	return ru
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (ru *ReminderUpdate) SetAppointment(a *Appointment) *ReminderUpdate {
+	return ru.SetAppointmentID(a.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (ru *ReminderUpdate) Mutation() *ReminderMutation {
+	return ru.mutation
+}
+
+// ClearAppointment clears the "appointment" edge to the Appointment entity.
+func (ru *ReminderUpdate) ClearAppointment() *ReminderUpdate {
+	ru.mutation.ClearAppointment()
+	return ru
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReminderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReminderUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReminderUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReminderUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReminderUpdate) check() error {
+	if ru.mutation.AppointmentCleared() && len(ru.mutation.AppointmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reminder.appointment"`)
+	}
+	return nil
+}
+
+func (ru *ReminderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminder.Table, reminder.Columns, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.Offset(); ok {
+		_spec.SetField(reminder.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.AddedOffset(); ok {
+		_spec.AddField(reminder.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ru.mutation.SentAt(); ok {
+		_spec.SetField(reminder.FieldSentAt, field.TypeTime, value)
+	}
+	if ru.mutation.AppointmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.AppointmentTable,
+			Columns: []string{reminder.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ru.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.AppointmentTable,
+			Columns: []string{reminder.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReminderUpdateOne is the builder for updating a single Reminder entity.
+type ReminderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReminderMutation
+}
+
+// SetAppointmentID sets the "appointment_id" field.
+func (ruo *ReminderUpdateOne) SetAppointmentID(i int) *ReminderUpdateOne {
+	ruo.mutation.SetAppointmentID(i)
+	return ruo
+}
+
+// SetNillableAppointmentID sets the "appointment_id" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableAppointmentID(i *int) *ReminderUpdateOne {
+	if i != nil {
+		ruo.SetAppointmentID(*i)
+	}
+	return ruo
+}
+
+// SetOffset sets the "offset" field.
+func (ruo *ReminderUpdateOne) SetOffset(t time.Duration) *ReminderUpdateOne {
+	ruo.mutation.ResetOffset()
+	ruo.mutation.SetOffset(t)
+	return ruo
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableOffset(t *time.Duration) *ReminderUpdateOne {
+	if t != nil {
+		ruo.SetOffset(*t)
+	}
+	return ruo
+}
+
+// AddOffset adds t to the "offset" field.
+func (ruo *ReminderUpdateOne) AddOffset(t time.Duration) *ReminderUpdateOne {
+	ruo.mutation.AddOffset(t)
+	return ruo
+}
+
+// SetSentAt sets the "sent_at" field.
+func (ruo *ReminderUpdateOne) SetSentAt(t time.Time) *ReminderUpdateOne {
+	ruo.mutation.SetSentAt(t)
+	return ruo
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (ruo *ReminderUpdateOne) SetNillableSentAt(t *time.Time) *ReminderUpdateOne {
+	if t != nil {
+		ruo.SetSentAt(*t)
+	}
+	return ruo
+}
+
+// SetAppointment sets the "appointment" edge to the Appointment entity.
+func (ruo *ReminderUpdateOne) SetAppointment(a *Appointment) *ReminderUpdateOne {
+	return ruo.SetAppointmentID(a.ID)
+}
+
+// Mutation returns the ReminderMutation object of the builder.
+func (ruo *ReminderUpdateOne) Mutation() *ReminderMutation {
+	return ruo.mutation
+}
+
+// ClearAppointment clears the "appointment" edge to the Appointment entity.
+func (ruo *ReminderUpdateOne) ClearAppointment() *ReminderUpdateOne {
+	ruo.mutation.ClearAppointment()
+	return ruo
+}
+
+// Where appends a list predicates to the ReminderUpdate builder.
+func (ruo *ReminderUpdateOne) Where(ps ...predicate.Reminder) *ReminderUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReminderUpdateOne) Select(field string, fields ...string) *ReminderUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reminder entity.
+func (ruo *ReminderUpdateOne) Save(ctx context.Context) (*Reminder, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReminderUpdateOne) SaveX(ctx context.Context) *Reminder {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReminderUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReminderUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReminderUpdateOne) check() error {
+	if ruo.mutation.AppointmentCleared() && len(ruo.mutation.AppointmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Reminder.appointment"`)
+	}
+	return nil
+}
+
+func (ruo *ReminderUpdateOne) sqlSave(ctx context.Context) (_node *Reminder, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reminder.Table, reminder.Columns, sqlgraph.NewFieldSpec(reminder.FieldID, field.TypeInt))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reminder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reminder.FieldID)
+		for _, f := range fields {
+			if !reminder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reminder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.Offset(); ok {
+		_spec.SetField(reminder.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.AddedOffset(); ok {
+		_spec.AddField(reminder.FieldOffset, field.TypeInt64, value)
+	}
+	if value, ok := ruo.mutation.SentAt(); ok {
+		_spec.SetField(reminder.FieldSentAt, field.TypeTime, value)
+	}
+	if ruo.mutation.AppointmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.AppointmentTable,
+			Columns: []string{reminder.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ruo.mutation.AppointmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   reminder.AppointmentTable,
+			Columns: []string{reminder.AppointmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Reminder{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reminder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
@@ -4,7 +4,17 @@ package ent
 
 import (
 	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/blockedtime"
+	"TerminSystem/ent/closureday"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/openinghours"
+	"TerminSystem/ent/openinghoursexception"
 	"TerminSystem/ent/schema"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/waitlistentry"
+	"TerminSystem/ent/workinghours"
+	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -29,4 +39,136 @@ func init() {
 	appointmentDescDelkey := appointmentFields[4].Descriptor()
 	// appointment.DelkeyValidator is a validator for the "delkey" field. It is called by the builders before save.
 	appointment.DelkeyValidator = appointmentDescDelkey.Validators[0].(func(string) error)
+	// appointmentDescCounter is the schema descriptor for counter field.
+	appointmentDescCounter := appointmentFields[12].Descriptor()
+	// appointment.CounterValidator is a validator for the "counter" field. It is called by the builders before save.
+	appointment.CounterValidator = appointmentDescCounter.Validators[0].(func(int) error)
+	// appointmentDescUpdatedAt is the schema descriptor for updated_at field.
+	appointmentDescUpdatedAt := appointmentFields[15].Descriptor()
+	// appointment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	appointment.DefaultUpdatedAt = appointmentDescUpdatedAt.Default.(func() time.Time)
+	// appointment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	appointment.UpdateDefaultUpdatedAt = appointmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	blockedtimeFields := schema.BlockedTime{}.Fields()
+	_ = blockedtimeFields
+	// blockedtimeDescName is the schema descriptor for name field.
+	blockedtimeDescName := blockedtimeFields[0].Descriptor()
+	// blockedtime.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	blockedtime.NameValidator = blockedtimeDescName.Validators[0].(func(string) error)
+	// blockedtimeDescUID is the schema descriptor for uid field.
+	blockedtimeDescUID := blockedtimeFields[1].Descriptor()
+	// blockedtime.UIDValidator is a validator for the "uid" field. It is called by the builders before save.
+	blockedtime.UIDValidator = blockedtimeDescUID.Validators[0].(func(string) error)
+	// blockedtimeDescUpdatedAt is the schema descriptor for updated_at field.
+	blockedtimeDescUpdatedAt := blockedtimeFields[6].Descriptor()
+	// blockedtime.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blockedtime.DefaultUpdatedAt = blockedtimeDescUpdatedAt.Default.(func() time.Time)
+	// blockedtime.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blockedtime.UpdateDefaultUpdatedAt = blockedtimeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	closuredayFields := schema.ClosureDay{}.Fields()
+	_ = closuredayFields
+	// closuredayDescDate is the schema descriptor for date field.
+	closuredayDescDate := closuredayFields[0].Descriptor()
+	// closureday.DateValidator is a validator for the "date" field. It is called by the builders before save.
+	closureday.DateValidator = closuredayDescDate.Validators[0].(func(string) error)
+	locationFields := schema.Location{}.Fields()
+	_ = locationFields
+	// locationDescName is the schema descriptor for name field.
+	locationDescName := locationFields[0].Descriptor()
+	// location.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	location.NameValidator = locationDescName.Validators[0].(func(string) error)
+	// locationDescTimezone is the schema descriptor for timezone field.
+	locationDescTimezone := locationFields[2].Descriptor()
+	// location.DefaultTimezone holds the default value on creation for the timezone field.
+	location.DefaultTimezone = locationDescTimezone.Default.(string)
+	// location.TimezoneValidator is a validator for the "timezone" field. It is called by the builders before save.
+	location.TimezoneValidator = locationDescTimezone.Validators[0].(func(string) error)
+	// locationDescState is the schema descriptor for state field.
+	locationDescState := locationFields[3].Descriptor()
+	// location.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	location.StateValidator = locationDescState.Validators[0].(func(string) error)
+	openinghoursFields := schema.OpeningHours{}.Fields()
+	_ = openinghoursFields
+	// openinghoursDescWeekday is the schema descriptor for weekday field.
+	openinghoursDescWeekday := openinghoursFields[0].Descriptor()
+	// openinghours.WeekdayValidator is a validator for the "weekday" field. It is called by the builders before save.
+	openinghours.WeekdayValidator = openinghoursDescWeekday.Validators[0].(func(int) error)
+	// openinghoursDescOpen is the schema descriptor for open field.
+	openinghoursDescOpen := openinghoursFields[1].Descriptor()
+	// openinghours.OpenValidator is a validator for the "open" field. It is called by the builders before save.
+	openinghours.OpenValidator = openinghoursDescOpen.Validators[0].(func(string) error)
+	// openinghoursDescClose is the schema descriptor for close field.
+	openinghoursDescClose := openinghoursFields[2].Descriptor()
+	// openinghours.CloseValidator is a validator for the "close" field. It is called by the builders before save.
+	openinghours.CloseValidator = openinghoursDescClose.Validators[0].(func(string) error)
+	openinghoursexceptionFields := schema.OpeningHoursException{}.Fields()
+	_ = openinghoursexceptionFields
+	// openinghoursexceptionDescDate is the schema descriptor for date field.
+	openinghoursexceptionDescDate := openinghoursexceptionFields[0].Descriptor()
+	// openinghoursexception.DateValidator is a validator for the "date" field. It is called by the builders before save.
+	openinghoursexception.DateValidator = openinghoursexceptionDescDate.Validators[0].(func(string) error)
+	// openinghoursexceptionDescOpen is the schema descriptor for open field.
+	openinghoursexceptionDescOpen := openinghoursexceptionFields[1].Descriptor()
+	// openinghoursexception.OpenValidator is a validator for the "open" field. It is called by the builders before save.
+	openinghoursexception.OpenValidator = openinghoursexceptionDescOpen.Validators[0].(func(string) error)
+	// openinghoursexceptionDescClose is the schema descriptor for close field.
+	openinghoursexceptionDescClose := openinghoursexceptionFields[2].Descriptor()
+	// openinghoursexception.CloseValidator is a validator for the "close" field. It is called by the builders before save.
+	openinghoursexception.CloseValidator = openinghoursexceptionDescClose.Validators[0].(func(string) error)
+	slotholdFields := schema.SlotHold{}.Fields()
+	_ = slotholdFields
+	// slotholdDescToken is the schema descriptor for token field.
+	slotholdDescToken := slotholdFields[0].Descriptor()
+	// slothold.TokenValidator is a validator for the "token" field. It is called by the builders before save.
+	slothold.TokenValidator = slotholdDescToken.Validators[0].(func(string) error)
+	staffFields := schema.Staff{}.Fields()
+	_ = staffFields
+	// staffDescName is the schema descriptor for name field.
+	staffDescName := staffFields[0].Descriptor()
+	// staff.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	staff.NameValidator = staffDescName.Validators[0].(func(string) error)
+	waitlistentryFields := schema.WaitlistEntry{}.Fields()
+	_ = waitlistentryFields
+	// waitlistentryDescName is the schema descriptor for name field.
+	waitlistentryDescName := waitlistentryFields[0].Descriptor()
+	// waitlistentry.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	waitlistentry.NameValidator = waitlistentryDescName.Validators[0].(func(string) error)
+	// waitlistentryDescEmail is the schema descriptor for email field.
+	waitlistentryDescEmail := waitlistentryFields[1].Descriptor()
+	// waitlistentry.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	waitlistentry.EmailValidator = waitlistentryDescEmail.Validators[0].(func(string) error)
+	// waitlistentryDescPhone is the schema descriptor for phone field.
+	waitlistentryDescPhone := waitlistentryFields[2].Descriptor()
+	// waitlistentry.PhoneValidator is a validator for the "phone" field. It is called by the builders before save.
+	waitlistentry.PhoneValidator = waitlistentryDescPhone.Validators[0].(func(string) error)
+	// waitlistentryDescDate is the schema descriptor for date field.
+	waitlistentryDescDate := waitlistentryFields[3].Descriptor()
+	// waitlistentry.DateValidator is a validator for the "date" field. It is called by the builders before save.
+	waitlistentry.DateValidator = waitlistentryDescDate.Validators[0].(func(string) error)
+	// waitlistentryDescWindowStart is the schema descriptor for window_start field.
+	waitlistentryDescWindowStart := waitlistentryFields[4].Descriptor()
+	// waitlistentry.WindowStartValidator is a validator for the "window_start" field. It is called by the builders before save.
+	waitlistentry.WindowStartValidator = waitlistentryDescWindowStart.Validators[0].(func(string) error)
+	// waitlistentryDescWindowEnd is the schema descriptor for window_end field.
+	waitlistentryDescWindowEnd := waitlistentryFields[5].Descriptor()
+	// waitlistentry.WindowEndValidator is a validator for the "window_end" field. It is called by the builders before save.
+	waitlistentry.WindowEndValidator = waitlistentryDescWindowEnd.Validators[0].(func(string) error)
+	// waitlistentryDescCreatedAt is the schema descriptor for created_at field.
+	waitlistentryDescCreatedAt := waitlistentryFields[13].Descriptor()
+	// waitlistentry.DefaultCreatedAt holds the default value on creation for the created_at field.
+	waitlistentry.DefaultCreatedAt = waitlistentryDescCreatedAt.Default.(func() time.Time)
+	workinghoursFields := schema.WorkingHours{}.Fields()
+	_ = workinghoursFields
+	// workinghoursDescWeekday is the schema descriptor for weekday field.
+	workinghoursDescWeekday := workinghoursFields[0].Descriptor()
+	// workinghours.WeekdayValidator is a validator for the "weekday" field. It is called by the builders before save.
+	workinghours.WeekdayValidator = workinghoursDescWeekday.Validators[0].(func(int) error)
+	// workinghoursDescStart is the schema descriptor for start field.
+	workinghoursDescStart := workinghoursFields[1].Descriptor()
+	// workinghours.StartValidator is a validator for the "start" field. It is called by the builders before save.
+	workinghours.StartValidator = workinghoursDescStart.Validators[0].(func(string) error)
+	// workinghoursDescEnd is the schema descriptor for end field.
+	workinghoursDescEnd := workinghoursFields[2].Descriptor()
+	// workinghours.EndValidator is a validator for the "end" field. It is called by the builders before save.
+	workinghours.EndValidator = workinghoursDescEnd.Validators[0].(func(string) error)
 }
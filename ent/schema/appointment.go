@@ -1,8 +1,13 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Appointment struct {
@@ -29,9 +34,72 @@ func (Appointment) Fields() []ent.Field {
 		field.Time("start_time"),
 		field.Time("end_time"),
 		field.String("description"),
+		// status follows the lifecycle of the appointment. Cancelled
+		// appointments are kept for the history but free their slot.
+		field.Enum("status").
+			Values("pending", "confirmed", "checked_in", "completed", "no_show", "cancelled").
+			Default("confirmed"),
+		field.Time("cancelled_at").
+			Optional().
+			Nillable(),
+		field.String("cancel_reason").
+			Optional(),
+		// confirm_by is when a pending appointment is released unless the
+		// customer confirmed it by then.
+		field.Time("confirm_by").
+			Optional().
+			Nillable(),
+		// counter is the shop counter serving the appointment when no staff
+		// members are configured.
+		field.Int("counter").
+			Positive().
+			Optional().
+			Nillable(),
+		field.Int("staff_id").
+			Optional().
+			Nillable(),
+		// location_id is the branch the appointment is at, nil for the main shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+		// updated_at is when the appointment was last changed. It is nil for
+		// appointments that were not changed since it was introduced.
+		field.Time("updated_at").
+			Optional().
+			Nillable().
+			Default(func() time.Time { return time.Now().UTC() }).
+			UpdateDefault(func() time.Time { return time.Now().UTC() }),
 	}
 }
 
 func (Appointment) Edges() []ent.Edge {
-	return nil
+	return []ent.Edge{
+		edge.From("staff", Staff.Type).
+			Ref("appointments").
+			Field("staff_id").
+			Unique(),
+		edge.From("location", Location.Type).
+			Ref("appointments").
+			Field("location_id").
+			Unique(),
+		edge.To("reminders", Reminder.Type),
+	}
+}
+
+func (Appointment) Indexes() []ent.Index {
+	return []ent.Index{
+		// Counters are numbered per location, so they are unique within
+		// the main shop and within each branch. Cancelled appointments
+		// give their counter or staff member free again.
+		index.Fields("start_time", "counter").
+			Unique().
+			Annotations(entsql.IndexWhere("location_id IS NULL AND status <> 'cancelled'")),
+		index.Fields("start_time", "location_id", "counter").
+			Unique().
+			Annotations(entsql.IndexWhere("location_id IS NOT NULL AND status <> 'cancelled'")),
+		index.Fields("start_time", "staff_id").
+			Unique().
+			Annotations(entsql.IndexWhere("status <> 'cancelled'")),
+		index.Fields("status"),
+	}
 }
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BlockedTime is time the shop owner blocked in their calendar app, e.g. for
+// a dentist appointment. No appointments can be booked during it.
+type BlockedTime struct {
+	ent.Schema
+}
+
+func (BlockedTime) Fields() []ent.Field {
+	return []ent.Field{
+		// name is the resource name the calendar app stored the event under,
+		// unique within the calendar of a location.
+		field.String("name").
+			NotEmpty(),
+		field.String("uid").
+			NotEmpty(),
+		field.String("summary").
+			Optional(),
+		field.Time("start_time"),
+		field.Time("end_time"),
+		// location_id is the branch the time is blocked at, nil for the main
+		// shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(func() time.Time { return time.Now().UTC() }).
+			UpdateDefault(func() time.Time { return time.Now().UTC() }),
+	}
+}
+
+func (BlockedTime) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("blocked_times").
+			Field("location_id").
+			Unique(),
+	}
+}
+
+func (BlockedTime) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("start_time", "end_time"),
+		// SQLite treats NULLs as distinct, so the main shop's calendar
+		// needs its own index.
+		index.Fields("name").
+			Unique().
+			Annotations(entsql.IndexWhere("location_id IS NULL")),
+		index.Fields("location_id", "name").
+			Unique().
+			Annotations(entsql.IndexWhere("location_id IS NOT NULL")),
+	}
+}
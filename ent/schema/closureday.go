@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// datePattern matches a calendar date in the "2006-01-02" layout.
+var datePattern = regexp.MustCompile(`^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])$`)
+
+// ClosureDay is a day the shop or one of its branches is closed on besides
+// public holidays, e.g. for vacation or inventory.
+type ClosureDay struct {
+	ent.Schema
+}
+
+func (ClosureDay) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("date").
+			Match(datePattern),
+		field.String("reason").
+			Optional(),
+		// location_id is the branch that is closed, nil for the main shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ClosureDay) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("closure_days").
+			Field("location_id").
+			Unique(),
+	}
+}
+
+func (ClosureDay) Indexes() []ent.Index {
+	return []ent.Index{
+		// SQLite treats NULLs as distinct, so the main shop's closures need
+		// their own index.
+		index.Fields("date").
+			Unique().
+			Annotations(entsql.IndexWhere("location_id IS NULL")),
+		index.Fields("location_id", "date").
+			Unique().
+			Annotations(entsql.IndexWhere("location_id IS NOT NULL")),
+	}
+}
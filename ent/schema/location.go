@@ -0,0 +1,59 @@
+package schema
+
+import (
+	holidays "TerminSystem/Holidays"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Location is a branch of the shop with its own address, time zone, opening
+// hours, staff and appointments. Rows of those without a location belong to
+// the main shop.
+type Location struct {
+	ent.Schema
+}
+
+func (Location) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("address").
+			Optional(),
+		// timezone is the IANA time zone the location's opening hours and
+		// appointments are given in.
+		field.String("timezone").
+			Default("Europe/Berlin").
+			Validate(func(name string) error {
+				_, err := time.LoadLocation(name)
+				return err
+			}),
+		// state is the Bundesland whose public holidays the location is
+		// closed on, nil for that of the main shop.
+		field.String("state").
+			Optional().
+			Nillable().
+			Validate(func(state string) error {
+				if !holidays.State(state).Valid() {
+					return fmt.Errorf("invalid state %q", state)
+				}
+				return nil
+			}),
+	}
+}
+
+func (Location) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("appointments", Appointment.Type),
+		edge.To("opening_hours", OpeningHours.Type),
+		edge.To("opening_hours_exceptions", OpeningHoursException.Type),
+		edge.To("staff", Staff.Type),
+		edge.To("waitlist_entries", WaitlistEntry.Type),
+		edge.To("slot_holds", SlotHold.Type),
+		edge.To("blocked_times", BlockedTime.Type),
+		edge.To("closure_days", ClosureDay.Type),
+	}
+}
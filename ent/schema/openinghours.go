@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OpeningHours holds a regular opening interval of the shop on a weekday. A
+// weekday can have several intervals, e.g. around a lunch break. Weekdays
+// without a row are closed.
+type OpeningHours struct {
+	ent.Schema
+}
+
+func (OpeningHours) Fields() []ent.Field {
+	return []ent.Field{
+		// weekday follows time.Weekday, 0 being Sunday.
+		field.Int("weekday").
+			Range(0, 6),
+		field.String("open").
+			Match(clockPattern),
+		field.String("close").
+			Match(clockPattern),
+		// location_id is the branch the opening hours are of, nil for the main shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (OpeningHours) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("opening_hours").
+			Field("location_id").
+			Unique(),
+	}
+}
+
+func (OpeningHours) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("location_id", "weekday"),
+	}
+}
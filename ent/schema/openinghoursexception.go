@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OpeningHoursException is an opening interval replacing the weekly opening
+// hours on a single calendar date, e.g. longer hours before Christmas.
+type OpeningHoursException struct {
+	ent.Schema
+}
+
+func (OpeningHoursException) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("date").
+			Match(datePattern),
+		field.String("open").
+			Match(clockPattern),
+		field.String("close").
+			Match(clockPattern),
+		// location_id is the branch the exception applies to, nil for the main shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (OpeningHoursException) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("opening_hours_exceptions").
+			Field("location_id").
+			Unique(),
+	}
+}
+
+func (OpeningHoursException) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("location_id", "date"),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reminder records that the customer was reminded of their appointment, so
+// no reminder is sent twice.
+type Reminder struct {
+	ent.Schema
+}
+
+func (Reminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("appointment_id"),
+		// offset is how long before the start of the appointment the
+		// reminder was due.
+		field.Int64("offset").
+			GoType(time.Duration(0)),
+		field.Time("sent_at"),
+	}
+}
+
+func (Reminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("appointment", Appointment.Type).
+			Ref("reminders").
+			Field("appointment_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (Reminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("appointment_id", "offset").
+			Unique(),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SlotHold keeps a slot free for a few minutes while the customer fills in
+// the booking form. Booking with its token takes the held slot.
+type SlotHold struct {
+	ent.Schema
+}
+
+func (SlotHold) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token").
+			NotEmpty().
+			Unique(),
+		field.Time("start_time"),
+		field.Time("end_time"),
+		field.Time("expires_at"),
+		// location_id is the branch the slot is held at, nil for the main
+		// shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+		// staff_id is the staff member the slot is held with, nil if any
+		// can serve it.
+		field.Int("staff_id").
+			Optional().
+			Nillable(),
+		// client identifies the browser that took the hold, whose next hold
+		// replaces it.
+		field.String("client").
+			Optional(),
+		// address is the IP address the hold was taken from, to cap the
+		// holds taken from one address at a time.
+		field.String("address").
+			Optional(),
+	}
+}
+
+func (SlotHold) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("slot_holds").
+			Field("location_id").
+			Unique(),
+		edge.From("staff", Staff.Type).
+			Ref("slot_holds").
+			Field("staff_id").
+			Unique(),
+	}
+}
+
+func (SlotHold) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+		index.Fields("client"),
+		index.Fields("address"),
+	}
+}
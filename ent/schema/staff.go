@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+type Staff struct {
+	ent.Schema
+}
+
+func (Staff) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		// types lists the appointment types the staff member can serve.
+		// An empty list means every type.
+		field.Strings("types").
+			Optional(),
+		// location_id is the branch the staff member works at, nil for the main shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Staff) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("working_hours", WorkingHours.Type),
+		edge.To("appointments", Appointment.Type),
+		edge.To("slot_holds", SlotHold.Type),
+		edge.From("location", Location.Type).
+			Ref("staff").
+			Field("location_id").
+			Unique(),
+	}
+}
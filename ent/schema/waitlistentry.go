@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WaitlistEntry is a customer waiting for a slot on a fully booked date. When
+// an appointment is cancelled, the first matching entry is offered the slot
+// and can claim it with the token until the offer expires.
+type WaitlistEntry struct {
+	ent.Schema
+}
+
+func (WaitlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty(),
+		field.String("email").
+			NotEmpty(),
+		field.String("phone").
+			NotEmpty(),
+		field.String("date").
+			Match(datePattern),
+		// window_start and window_end limit the wall clock times the
+		// customer can come at.
+		field.String("window_start").
+			Optional().
+			Match(clockPattern),
+		field.String("window_end").
+			Optional().
+			Match(clockPattern),
+		// type is the appointment type the customer wants, any if empty.
+		field.String("type").
+			Optional(),
+		field.Enum("status").
+			Values("waiting", "offered", "claimed", "expired").
+			Default("waiting"),
+		// token claims the offered slot.
+		field.String("token").
+			Optional().
+			Nillable().
+			Unique(),
+		field.Time("offered_start").
+			Optional().
+			Nillable(),
+		field.Time("offered_end").
+			Optional().
+			Nillable(),
+		field.Time("offer_expires_at").
+			Optional().
+			Nillable(),
+		// location_id is the branch the customer waits for, nil for the
+		// main shop.
+		field.Int("location_id").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(func() time.Time { return time.Now().UTC() }).
+			Immutable(),
+	}
+}
+
+func (WaitlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).
+			Ref("waitlist_entries").
+			Field("location_id").
+			Unique(),
+	}
+}
+
+func (WaitlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("date", "status"),
+		index.Fields("status", "offer_expires_at"),
+	}
+}
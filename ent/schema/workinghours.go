@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// clockPattern matches a wall clock time in the "15:04" layout.
+var clockPattern = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// WorkingHours is a single shift of a staff member on a weekday.
+type WorkingHours struct {
+	ent.Schema
+}
+
+func (WorkingHours) Fields() []ent.Field {
+	return []ent.Field{
+		// weekday follows time.Weekday, 0 being Sunday.
+		field.Int("weekday").
+			Range(0, 6),
+		field.String("start").
+			Match(clockPattern),
+		field.String("end").
+			Match(clockPattern),
+	}
+}
+
+func (WorkingHours) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("staff", Staff.Type).
+			Ref("working_hours").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,237 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SlotHold is the model entity for the SlotHold schema.
+type SlotHold struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Token holds the value of the "token" field.
+	Token string `json:"token,omitempty"`
+	// StartTime holds the value of the "start_time" field.
+	StartTime time.Time `json:"start_time,omitempty"`
+	// EndTime holds the value of the "end_time" field.
+	EndTime time.Time `json:"end_time,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// StaffID holds the value of the "staff_id" field.
+	StaffID *int `json:"staff_id,omitempty"`
+	// Client holds the value of the "client" field.
+	Client string `json:"client,omitempty"`
+	// Address holds the value of the "address" field.
+	Address string `json:"address,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SlotHoldQuery when eager-loading is set.
+	Edges        SlotHoldEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SlotHoldEdges holds the relations/edges for other nodes in the graph.
+type SlotHoldEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// Staff holds the value of the staff edge.
+	Staff *Staff `json:"staff,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SlotHoldEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// StaffOrErr returns the Staff value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SlotHoldEdges) StaffOrErr() (*Staff, error) {
+	if e.Staff != nil {
+		return e.Staff, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: staff.Label}
+	}
+	return nil, &NotLoadedError{edge: "staff"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SlotHold) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case slothold.FieldID, slothold.FieldLocationID, slothold.FieldStaffID:
+			values[i] = new(sql.NullInt64)
+		case slothold.FieldToken, slothold.FieldClient, slothold.FieldAddress:
+			values[i] = new(sql.NullString)
+		case slothold.FieldStartTime, slothold.FieldEndTime, slothold.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SlotHold fields.
+func (sh *SlotHold) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case slothold.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sh.ID = int(value.Int64)
+		case slothold.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				sh.Token = value.String
+			}
+		case slothold.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				sh.StartTime = value.Time
+			}
+		case slothold.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				sh.EndTime = value.Time
+			}
+		case slothold.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sh.ExpiresAt = value.Time
+			}
+		case slothold.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				sh.LocationID = new(int)
+				*sh.LocationID = int(value.Int64)
+			}
+		case slothold.FieldStaffID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field staff_id", values[i])
+			} else if value.Valid {
+				sh.StaffID = new(int)
+				*sh.StaffID = int(value.Int64)
+			}
+		case slothold.FieldClient:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client", values[i])
+			} else if value.Valid {
+				sh.Client = value.String
+			}
+		case slothold.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				sh.Address = value.String
+			}
+		default:
+			sh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SlotHold.
+// This includes values selected through modifiers, order, etc.
+func (sh *SlotHold) Value(name string) (ent.Value, error) {
+	return sh.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the SlotHold entity.
+func (sh *SlotHold) QueryLocation() *LocationQuery {
+	return NewSlotHoldClient(sh.config).QueryLocation(sh)
+}
+
+// QueryStaff queries the "staff" edge of the SlotHold entity.
+func (sh *SlotHold) QueryStaff() *StaffQuery {
+	return NewSlotHoldClient(sh.config).QueryStaff(sh)
+}
+
+// Update returns a builder for updating this SlotHold.
+// Note that you need to call SlotHold.Unwrap() before calling this method if this SlotHold
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sh *SlotHold) Update() *SlotHoldUpdateOne {
+	return NewSlotHoldClient(sh.config).UpdateOne(sh)
+}
+
+// Unwrap unwraps the SlotHold entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sh *SlotHold) Unwrap() *SlotHold {
+	_tx, ok := sh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SlotHold is not a transactional entity")
+	}
+	sh.config.driver = _tx.drv
+	return sh
+}
+
+// String implements the fmt.Stringer.
+func (sh *SlotHold) String() string {
+	var builder strings.Builder
+	builder.WriteString("SlotHold(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sh.ID))
+	builder.WriteString("token=")
+	builder.WriteString(sh.Token)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(sh.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(sh.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(sh.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := sh.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := sh.StaffID; v != nil {
+		builder.WriteString("staff_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client=")
+	builder.WriteString(sh.Client)
+	builder.WriteString(", ")
+	builder.WriteString("address=")
+	builder.WriteString(sh.Address)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SlotHolds is a parsable slice of SlotHold.
+type SlotHolds []*SlotHold
@@ -0,0 +1,338 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SlotHoldCreate is the builder for creating a SlotHold entity.
+type SlotHoldCreate struct {
+	config
+	mutation *SlotHoldMutation
+	hooks    []Hook
+}
+
+// SetToken sets the "token" field.
+func (shc *SlotHoldCreate) SetToken(s string) *SlotHoldCreate {
+	shc.mutation.SetToken(s)
+	return shc
+}
+
+// SetStartTime sets the "start_time" field.
+func (shc *SlotHoldCreate) SetStartTime(t time.Time) *SlotHoldCreate {
+	shc.mutation.SetStartTime(t)
+	return shc
+}
+
+// SetEndTime sets the "end_time" field.
+func (shc *SlotHoldCreate) SetEndTime(t time.Time) *SlotHoldCreate {
+	shc.mutation.SetEndTime(t)
+	return shc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (shc *SlotHoldCreate) SetExpiresAt(t time.Time) *SlotHoldCreate {
+	shc.mutation.SetExpiresAt(t)
+	return shc
+}
+
+// SetLocationID sets the "location_id" field.
+func (shc *SlotHoldCreate) SetLocationID(i int) *SlotHoldCreate {
+	shc.mutation.SetLocationID(i)
+	return shc
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (shc *SlotHoldCreate) SetNillableLocationID(i *int) *SlotHoldCreate {
+	if i != nil {
+		shc.SetLocationID(*i)
+	}
+	return shc
+}
+
+// SetStaffID sets the "staff_id" field.
+func (shc *SlotHoldCreate) SetStaffID(i int) *SlotHoldCreate {
+	shc.mutation.SetStaffID(i)
+	return shc
+}
+
+// SetNillableStaffID sets the "staff_id" field if the given value is not nil.
+func (shc *SlotHoldCreate) SetNillableStaffID(i *int) *SlotHoldCreate {
+	if i != nil {
+		shc.SetStaffID(*i)
+	}
+	return shc
+}
+
+// SetClient sets the "client" field.
+func (shc *SlotHoldCreate) SetClient(s string) *SlotHoldCreate {
+	shc.mutation.SetClient(s)
+	return shc
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (shc *SlotHoldCreate) SetNillableClient(s *string) *SlotHoldCreate {
+	if s != nil {
+		shc.SetClient(*s)
+	}
+	return shc
+}
+
+// SetAddress sets the "address" field.
+func (shc *SlotHoldCreate) SetAddress(s string) *SlotHoldCreate {
+	shc.mutation.SetAddress(s)
+	return shc
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (shc *SlotHoldCreate) SetNillableAddress(s *string) *SlotHoldCreate {
+	if s != nil {
+		shc.SetAddress(*s)
+	}
+	return shc
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (shc *SlotHoldCreate) SetLocation(l *Location) *SlotHoldCreate {
+	return shc.SetLocationID(l.ID)
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (shc *SlotHoldCreate) SetStaff(s *Staff) *SlotHoldCreate {
+	return shc.SetStaffID(s.ID)
+}
+
+// Mutation returns the SlotHoldMutation object of the builder.
+func (shc *SlotHoldCreate) Mutation() *SlotHoldMutation {
+	return shc.mutation
+}
+
+// Save creates the SlotHold in the database.
+func (shc *SlotHoldCreate) Save(ctx context.Context) (*SlotHold, error) {
+	return withHooks(ctx, shc.sqlSave, shc.mutation, shc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (shc *SlotHoldCreate) SaveX(ctx context.Context) *SlotHold {
+	v, err := shc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (shc *SlotHoldCreate) Exec(ctx context.Context) error {
+	_, err := shc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shc *SlotHoldCreate) ExecX(ctx context.Context) {
+	if err := shc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (shc *SlotHoldCreate) check() error {
+	if _, ok := shc.mutation.Token(); !ok {
+		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "SlotHold.token"`)}
+	}
+	if v, ok := shc.mutation.Token(); ok {
+		if err := slothold.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SlotHold.token": %w`, err)}
+		}
+	}
+	if _, ok := shc.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "SlotHold.start_time"`)}
+	}
+	if _, ok := shc.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "SlotHold.end_time"`)}
+	}
+	if _, ok := shc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "SlotHold.expires_at"`)}
+	}
+	return nil
+}
+
+func (shc *SlotHoldCreate) sqlSave(ctx context.Context) (*SlotHold, error) {
+	if err := shc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := shc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, shc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	shc.mutation.id = &_node.ID
+	shc.mutation.done = true
+	return _node, nil
+}
+
+func (shc *SlotHoldCreate) createSpec() (*SlotHold, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SlotHold{config: shc.config}
+		_spec = sqlgraph.NewCreateSpec(slothold.Table, sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt))
+	)
+	if value, ok := shc.mutation.Token(); ok {
+		_spec.SetField(slothold.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := shc.mutation.StartTime(); ok {
+		_spec.SetField(slothold.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := shc.mutation.EndTime(); ok {
+		_spec.SetField(slothold.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := shc.mutation.ExpiresAt(); ok {
+		_spec.SetField(slothold.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := shc.mutation.GetClient(); ok {
+		_spec.SetField(slothold.FieldClient, field.TypeString, value)
+		_node.Client = value
+	}
+	if value, ok := shc.mutation.Address(); ok {
+		_spec.SetField(slothold.FieldAddress, field.TypeString, value)
+		_node.Address = value
+	}
+	if nodes := shc.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.LocationTable,
+			Columns: []string{slothold.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := shc.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.StaffTable,
+			Columns: []string{slothold.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StaffID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SlotHoldCreateBulk is the builder for creating many SlotHold entities in bulk.
+type SlotHoldCreateBulk struct {
+	config
+	err      error
+	builders []*SlotHoldCreate
+}
+
+// Save creates the SlotHold entities in the database.
+func (shcb *SlotHoldCreateBulk) Save(ctx context.Context) ([]*SlotHold, error) {
+	if shcb.err != nil {
+		return nil, shcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(shcb.builders))
+	nodes := make([]*SlotHold, len(shcb.builders))
+	mutators := make([]Mutator, len(shcb.builders))
+	for i := range shcb.builders {
+		func(i int, root context.Context) {
+			builder := shcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SlotHoldMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, shcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, shcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, shcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shcb *SlotHoldCreateBulk) SaveX(ctx context.Context) []*SlotHold {
+	v, err := shcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (shcb *SlotHoldCreateBulk) Exec(ctx context.Context) error {
+	_, err := shcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shcb *SlotHoldCreateBulk) ExecX(ctx context.Context) {
+	if err := shcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
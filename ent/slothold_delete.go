@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SlotHoldDelete is the builder for deleting a SlotHold entity.
+type SlotHoldDelete struct {
+	config
+	hooks    []Hook
+	mutation *SlotHoldMutation
+}
+
+// Where appends a list predicates to the SlotHoldDelete builder.
+func (shd *SlotHoldDelete) Where(ps ...predicate.SlotHold) *SlotHoldDelete {
+	shd.mutation.Where(ps...)
+	return shd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (shd *SlotHoldDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, shd.sqlExec, shd.mutation, shd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shd *SlotHoldDelete) ExecX(ctx context.Context) int {
+	n, err := shd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (shd *SlotHoldDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(slothold.Table, sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt))
+	if ps := shd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, shd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	shd.mutation.done = true
+	return affected, err
+}
+
+// SlotHoldDeleteOne is the builder for deleting a single SlotHold entity.
+type SlotHoldDeleteOne struct {
+	shd *SlotHoldDelete
+}
+
+// Where appends a list predicates to the SlotHoldDelete builder.
+func (shdo *SlotHoldDeleteOne) Where(ps ...predicate.SlotHold) *SlotHoldDeleteOne {
+	shdo.shd.mutation.Where(ps...)
+	return shdo
+}
+
+// Exec executes the deletion query.
+func (shdo *SlotHoldDeleteOne) Exec(ctx context.Context) error {
+	n, err := shdo.shd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{slothold.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shdo *SlotHoldDeleteOne) ExecX(ctx context.Context) {
+	if err := shdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
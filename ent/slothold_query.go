@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SlotHoldQuery is the builder for querying SlotHold entities.
+type SlotHoldQuery struct {
+	config
+	ctx          *QueryContext
+	order        []slothold.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.SlotHold
+	withLocation *LocationQuery
+	withStaff    *StaffQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SlotHoldQuery builder.
+func (shq *SlotHoldQuery) Where(ps ...predicate.SlotHold) *SlotHoldQuery {
+	shq.predicates = append(shq.predicates, ps...)
+	return shq
+}
+
+// Limit the number of records to be returned by this query.
+func (shq *SlotHoldQuery) Limit(limit int) *SlotHoldQuery {
+	shq.ctx.Limit = &limit
+	return shq
+}
+
+// Offset to start from.
+func (shq *SlotHoldQuery) Offset(offset int) *SlotHoldQuery {
+	shq.ctx.Offset = &offset
+	return shq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (shq *SlotHoldQuery) Unique(unique bool) *SlotHoldQuery {
+	shq.ctx.Unique = &unique
+	return shq
+}
+
+// Order specifies how the records should be ordered.
+func (shq *SlotHoldQuery) Order(o ...slothold.OrderOption) *SlotHoldQuery {
+	shq.order = append(shq.order, o...)
+	return shq
+}
+
+// QueryLocation chains the current query on the "location" edge.
+func (shq *SlotHoldQuery) QueryLocation() *LocationQuery {
+	query := (&LocationClient{config: shq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := shq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := shq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slothold.Table, slothold.FieldID, selector),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slothold.LocationTable, slothold.LocationColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(shq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStaff chains the current query on the "staff" edge.
+func (shq *SlotHoldQuery) QueryStaff() *StaffQuery {
+	query := (&StaffClient{config: shq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := shq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := shq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(slothold.Table, slothold.FieldID, selector),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, slothold.StaffTable, slothold.StaffColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(shq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SlotHold entity from the query.
+// Returns a *NotFoundError when no SlotHold was found.
+func (shq *SlotHoldQuery) First(ctx context.Context) (*SlotHold, error) {
+	nodes, err := shq.Limit(1).All(setContextOp(ctx, shq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{slothold.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (shq *SlotHoldQuery) FirstX(ctx context.Context) *SlotHold {
+	node, err := shq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SlotHold ID from the query.
+// Returns a *NotFoundError when no SlotHold ID was found.
+func (shq *SlotHoldQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = shq.Limit(1).IDs(setContextOp(ctx, shq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{slothold.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (shq *SlotHoldQuery) FirstIDX(ctx context.Context) int {
+	id, err := shq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SlotHold entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SlotHold entity is found.
+// Returns a *NotFoundError when no SlotHold entities are found.
+func (shq *SlotHoldQuery) Only(ctx context.Context) (*SlotHold, error) {
+	nodes, err := shq.Limit(2).All(setContextOp(ctx, shq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{slothold.Label}
+	default:
+		return nil, &NotSingularError{slothold.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (shq *SlotHoldQuery) OnlyX(ctx context.Context) *SlotHold {
+	node, err := shq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SlotHold ID in the query.
+// Returns a *NotSingularError when more than one SlotHold ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (shq *SlotHoldQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = shq.Limit(2).IDs(setContextOp(ctx, shq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{slothold.Label}
+	default:
+		err = &NotSingularError{slothold.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (shq *SlotHoldQuery) OnlyIDX(ctx context.Context) int {
+	id, err := shq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SlotHolds.
+func (shq *SlotHoldQuery) All(ctx context.Context) ([]*SlotHold, error) {
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryAll)
+	if err := shq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SlotHold, *SlotHoldQuery]()
+	return withInterceptors[[]*SlotHold](ctx, shq, qr, shq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (shq *SlotHoldQuery) AllX(ctx context.Context) []*SlotHold {
+	nodes, err := shq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SlotHold IDs.
+func (shq *SlotHoldQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if shq.ctx.Unique == nil && shq.path != nil {
+		shq.Unique(true)
+	}
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryIDs)
+	if err = shq.Select(slothold.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (shq *SlotHoldQuery) IDsX(ctx context.Context) []int {
+	ids, err := shq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (shq *SlotHoldQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryCount)
+	if err := shq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, shq, querierCount[*SlotHoldQuery](), shq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (shq *SlotHoldQuery) CountX(ctx context.Context) int {
+	count, err := shq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (shq *SlotHoldQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, shq.ctx, ent.OpQueryExist)
+	switch _, err := shq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (shq *SlotHoldQuery) ExistX(ctx context.Context) bool {
+	exist, err := shq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SlotHoldQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (shq *SlotHoldQuery) Clone() *SlotHoldQuery {
+	if shq == nil {
+		return nil
+	}
+	return &SlotHoldQuery{
+		config:       shq.config,
+		ctx:          shq.ctx.Clone(),
+		order:        append([]slothold.OrderOption{}, shq.order...),
+		inters:       append([]Interceptor{}, shq.inters...),
+		predicates:   append([]predicate.SlotHold{}, shq.predicates...),
+		withLocation: shq.withLocation.Clone(),
+		withStaff:    shq.withStaff.Clone(),
+		// clone intermediate query.
+		sql:  shq.sql.Clone(),
+		path: shq.path,
+	}
+}
+
+// WithLocation tells the query-builder to eager-load the nodes that are connected to
+// the "location" edge. The optional arguments are used to configure the query builder of the edge.
+func (shq *SlotHoldQuery) WithLocation(opts ...func(*LocationQuery)) *SlotHoldQuery {
+	query := (&LocationClient{config: shq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	shq.withLocation = query
+	return shq
+}
+
+// WithStaff tells the query-builder to eager-load the nodes that are connected to
+// the "staff" edge. The optional arguments are used to configure the query builder of the edge.
+func (shq *SlotHoldQuery) WithStaff(opts ...func(*StaffQuery)) *SlotHoldQuery {
+	query := (&StaffClient{config: shq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	shq.withStaff = query
+	return shq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SlotHold.Query().
+//		GroupBy(slothold.FieldToken).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (shq *SlotHoldQuery) GroupBy(field string, fields ...string) *SlotHoldGroupBy {
+	shq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SlotHoldGroupBy{build: shq}
+	grbuild.flds = &shq.ctx.Fields
+	grbuild.label = slothold.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Token string `json:"token,omitempty"`
+//	}
+//
+//	client.SlotHold.Query().
+//		Select(slothold.FieldToken).
+//		Scan(ctx, &v)
+func (shq *SlotHoldQuery) Select(fields ...string) *SlotHoldSelect {
+	shq.ctx.Fields = append(shq.ctx.Fields, fields...)
+	sbuild := &SlotHoldSelect{SlotHoldQuery: shq}
+	sbuild.label = slothold.Label
+	sbuild.flds, sbuild.scan = &shq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SlotHoldSelect configured with the given aggregations.
+func (shq *SlotHoldQuery) Aggregate(fns ...AggregateFunc) *SlotHoldSelect {
+	return shq.Select().Aggregate(fns...)
+}
+
+func (shq *SlotHoldQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range shq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, shq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range shq.ctx.Fields {
+		if !slothold.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if shq.path != nil {
+		prev, err := shq.path(ctx)
+		if err != nil {
+			return err
+		}
+		shq.sql = prev
+	}
+	return nil
+}
+
+func (shq *SlotHoldQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SlotHold, error) {
+	var (
+		nodes       = []*SlotHold{}
+		_spec       = shq.querySpec()
+		loadedTypes = [2]bool{
+			shq.withLocation != nil,
+			shq.withStaff != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SlotHold).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SlotHold{config: shq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, shq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := shq.withLocation; query != nil {
+		if err := shq.loadLocation(ctx, query, nodes, nil,
+			func(n *SlotHold, e *Location) { n.Edges.Location = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := shq.withStaff; query != nil {
+		if err := shq.loadStaff(ctx, query, nodes, nil,
+			func(n *SlotHold, e *Staff) { n.Edges.Staff = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (shq *SlotHoldQuery) loadLocation(ctx context.Context, query *LocationQuery, nodes []*SlotHold, init func(*SlotHold), assign func(*SlotHold, *Location)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SlotHold)
+	for i := range nodes {
+		if nodes[i].LocationID == nil {
+			continue
+		}
+		fk := *nodes[i].LocationID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(location.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "location_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (shq *SlotHoldQuery) loadStaff(ctx context.Context, query *StaffQuery, nodes []*SlotHold, init func(*SlotHold), assign func(*SlotHold, *Staff)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*SlotHold)
+	for i := range nodes {
+		if nodes[i].StaffID == nil {
+			continue
+		}
+		fk := *nodes[i].StaffID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(staff.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "staff_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (shq *SlotHoldQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := shq.querySpec()
+	_spec.Node.Columns = shq.ctx.Fields
+	if len(shq.ctx.Fields) > 0 {
+		_spec.Unique = shq.ctx.Unique != nil && *shq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, shq.driver, _spec)
+}
+
+func (shq *SlotHoldQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(slothold.Table, slothold.Columns, sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt))
+	_spec.From = shq.sql
+	if unique := shq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if shq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := shq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slothold.FieldID)
+		for i := range fields {
+			if fields[i] != slothold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if shq.withLocation != nil {
+			_spec.Node.AddColumnOnce(slothold.FieldLocationID)
+		}
+		if shq.withStaff != nil {
+			_spec.Node.AddColumnOnce(slothold.FieldStaffID)
+		}
+	}
+	if ps := shq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := shq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := shq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := shq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (shq *SlotHoldQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(shq.driver.Dialect())
+	t1 := builder.Table(slothold.Table)
+	columns := shq.ctx.Fields
+	if len(columns) == 0 {
+		columns = slothold.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if shq.sql != nil {
+		selector = shq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if shq.ctx.Unique != nil && *shq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range shq.predicates {
+		p(selector)
+	}
+	for _, p := range shq.order {
+		p(selector)
+	}
+	if offset := shq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := shq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SlotHoldGroupBy is the group-by builder for SlotHold entities.
+type SlotHoldGroupBy struct {
+	selector
+	build *SlotHoldQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (shgb *SlotHoldGroupBy) Aggregate(fns ...AggregateFunc) *SlotHoldGroupBy {
+	shgb.fns = append(shgb.fns, fns...)
+	return shgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (shgb *SlotHoldGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, shgb.build.ctx, ent.OpQueryGroupBy)
+	if err := shgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SlotHoldQuery, *SlotHoldGroupBy](ctx, shgb.build, shgb, shgb.build.inters, v)
+}
+
+func (shgb *SlotHoldGroupBy) sqlScan(ctx context.Context, root *SlotHoldQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(shgb.fns))
+	for _, fn := range shgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*shgb.flds)+len(shgb.fns))
+		for _, f := range *shgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*shgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := shgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SlotHoldSelect is the builder for selecting fields of SlotHold entities.
+type SlotHoldSelect struct {
+	*SlotHoldQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (shs *SlotHoldSelect) Aggregate(fns ...AggregateFunc) *SlotHoldSelect {
+	shs.fns = append(shs.fns, fns...)
+	return shs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (shs *SlotHoldSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, shs.ctx, ent.OpQuerySelect)
+	if err := shs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SlotHoldQuery, *SlotHoldSelect](ctx, shs.SlotHoldQuery, shs, shs.inters, v)
+}
+
+func (shs *SlotHoldSelect) sqlScan(ctx context.Context, root *SlotHoldQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(shs.fns))
+	for _, fn := range shs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*shs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := shs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SlotHoldUpdate is the builder for updating SlotHold entities.
+type SlotHoldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SlotHoldMutation
+}
+
+// Where appends a list predicates to the SlotHoldUpdate builder.
+func (shu *SlotHoldUpdate) Where(ps ...predicate.SlotHold) *SlotHoldUpdate {
+	shu.mutation.Where(ps...)
+	return shu
+}
+
+// SetToken sets the "token" field.
+func (shu *SlotHoldUpdate) SetToken(s string) *SlotHoldUpdate {
+	shu.mutation.SetToken(s)
+	return shu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableToken(s *string) *SlotHoldUpdate {
+	if s != nil {
+		shu.SetToken(*s)
+	}
+	return shu
+}
+
+// SetStartTime sets the "start_time" field.
+func (shu *SlotHoldUpdate) SetStartTime(t time.Time) *SlotHoldUpdate {
+	shu.mutation.SetStartTime(t)
+	return shu
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableStartTime(t *time.Time) *SlotHoldUpdate {
+	if t != nil {
+		shu.SetStartTime(*t)
+	}
+	return shu
+}
+
+// SetEndTime sets the "end_time" field.
+func (shu *SlotHoldUpdate) SetEndTime(t time.Time) *SlotHoldUpdate {
+	shu.mutation.SetEndTime(t)
+	return shu
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableEndTime(t *time.Time) *SlotHoldUpdate {
+	if t != nil {
+		shu.SetEndTime(*t)
+	}
+	return shu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (shu *SlotHoldUpdate) SetExpiresAt(t time.Time) *SlotHoldUpdate {
+	shu.mutation.SetExpiresAt(t)
+	return shu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableExpiresAt(t *time.Time) *SlotHoldUpdate {
+	if t != nil {
+		shu.SetExpiresAt(*t)
+	}
+	return shu
+}
+
+// SetLocationID sets the "location_id" field.
+func (shu *SlotHoldUpdate) SetLocationID(i int) *SlotHoldUpdate {
+	shu.mutation.SetLocationID(i)
+	return shu
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableLocationID(i *int) *SlotHoldUpdate {
+	if i != nil {
+		shu.SetLocationID(*i)
+	}
+	return shu
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (shu *SlotHoldUpdate) ClearLocationID() *SlotHoldUpdate {
+	shu.mutation.ClearLocationID()
+	return shu
+}
+
+// SetStaffID sets the "staff_id" field.
+func (shu *SlotHoldUpdate) SetStaffID(i int) *SlotHoldUpdate {
+	shu.mutation.SetStaffID(i)
+	return shu
+}
+
+// SetNillableStaffID sets the "staff_id" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableStaffID(i *int) *SlotHoldUpdate {
+	if i != nil {
+		shu.SetStaffID(*i)
+	}
+	return shu
+}
+
+// ClearStaffID clears the value of the "staff_id" field.
+func (shu *SlotHoldUpdate) ClearStaffID() *SlotHoldUpdate {
+	shu.mutation.ClearStaffID()
+	return shu
+}
+
+// SetClient sets the "client" field.
+func (shu *SlotHoldUpdate) SetClient(s string) *SlotHoldUpdate {
+	shu.mutation.SetClient(s)
+	return shu
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableClient(s *string) *SlotHoldUpdate {
+	if s != nil {
+		shu.SetClient(*s)
+	}
+	return shu
+}
+
+// ClearClient clears the value of the "client" field.
+func (shu *SlotHoldUpdate) ClearClient() *SlotHoldUpdate {
+	shu.mutation.ClearClient()
+	return shu
+}
+
+// SetAddress sets the "address" field.
+func (shu *SlotHoldUpdate) SetAddress(s string) *SlotHoldUpdate {
+	shu.mutation.SetAddress(s)
+	return shu
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (shu *SlotHoldUpdate) SetNillableAddress(s *string) *SlotHoldUpdate {
+	if s != nil {
+		shu.SetAddress(*s)
+	}
+	return shu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (shu *SlotHoldUpdate) ClearAddress() *SlotHoldUpdate {
+	shu.mutation.ClearAddress()
+	return shu
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (shu *SlotHoldUpdate) SetLocation(l *Location) *SlotHoldUpdate {
+	return shu.SetLocationID(l.ID)
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (shu *SlotHoldUpdate) SetStaff(s *Staff) *SlotHoldUpdate {
+	return shu.SetStaffID(s.ID)
+}
+
+// Mutation returns the SlotHoldMutation object of the builder.
+func (shu *SlotHoldUpdate) Mutation() *SlotHoldMutation {
+	return shu.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (shu *SlotHoldUpdate) ClearLocation() *SlotHoldUpdate {
+	shu.mutation.ClearLocation()
+	return shu
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (shu *SlotHoldUpdate) ClearStaff() *SlotHoldUpdate {
+	shu.mutation.ClearStaff()
+	return shu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (shu *SlotHoldUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, shu.sqlSave, shu.mutation, shu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shu *SlotHoldUpdate) SaveX(ctx context.Context) int {
+	affected, err := shu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (shu *SlotHoldUpdate) Exec(ctx context.Context) error {
+	_, err := shu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shu *SlotHoldUpdate) ExecX(ctx context.Context) {
+	if err := shu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (shu *SlotHoldUpdate) check() error {
+	if v, ok := shu.mutation.Token(); ok {
+		if err := slothold.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SlotHold.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (shu *SlotHoldUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := shu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slothold.Table, slothold.Columns, sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt))
+	if ps := shu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := shu.mutation.Token(); ok {
+		_spec.SetField(slothold.FieldToken, field.TypeString, value)
+	}
+	if value, ok := shu.mutation.StartTime(); ok {
+		_spec.SetField(slothold.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := shu.mutation.EndTime(); ok {
+		_spec.SetField(slothold.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := shu.mutation.ExpiresAt(); ok {
+		_spec.SetField(slothold.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := shu.mutation.GetClient(); ok {
+		_spec.SetField(slothold.FieldClient, field.TypeString, value)
+	}
+	if shu.mutation.ClientCleared() {
+		_spec.ClearField(slothold.FieldClient, field.TypeString)
+	}
+	if value, ok := shu.mutation.Address(); ok {
+		_spec.SetField(slothold.FieldAddress, field.TypeString, value)
+	}
+	if shu.mutation.AddressCleared() {
+		_spec.ClearField(slothold.FieldAddress, field.TypeString)
+	}
+	if shu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.LocationTable,
+			Columns: []string{slothold.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := shu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.LocationTable,
+			Columns: []string{slothold.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if shu.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.StaffTable,
+			Columns: []string{slothold.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := shu.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.StaffTable,
+			Columns: []string{slothold.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, shu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slothold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	shu.mutation.done = true
+	return n, nil
+}
+
+// SlotHoldUpdateOne is the builder for updating a single SlotHold entity.
+type SlotHoldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SlotHoldMutation
+}
+
+// SetToken sets the "token" field.
+func (shuo *SlotHoldUpdateOne) SetToken(s string) *SlotHoldUpdateOne {
+	shuo.mutation.SetToken(s)
+	return shuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableToken(s *string) *SlotHoldUpdateOne {
+	if s != nil {
+		shuo.SetToken(*s)
+	}
+	return shuo
+}
+
+// SetStartTime sets the "start_time" field.
+func (shuo *SlotHoldUpdateOne) SetStartTime(t time.Time) *SlotHoldUpdateOne {
+	shuo.mutation.SetStartTime(t)
+	return shuo
+}
+
+// SetNillableStartTime sets the "start_time" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableStartTime(t *time.Time) *SlotHoldUpdateOne {
+	if t != nil {
+		shuo.SetStartTime(*t)
+	}
+	return shuo
+}
+
+// SetEndTime sets the "end_time" field.
+func (shuo *SlotHoldUpdateOne) SetEndTime(t time.Time) *SlotHoldUpdateOne {
+	shuo.mutation.SetEndTime(t)
+	return shuo
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableEndTime(t *time.Time) *SlotHoldUpdateOne {
+	if t != nil {
+		shuo.SetEndTime(*t)
+	}
+	return shuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (shuo *SlotHoldUpdateOne) SetExpiresAt(t time.Time) *SlotHoldUpdateOne {
+	shuo.mutation.SetExpiresAt(t)
+	return shuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableExpiresAt(t *time.Time) *SlotHoldUpdateOne {
+	if t != nil {
+		shuo.SetExpiresAt(*t)
+	}
+	return shuo
+}
+
+// SetLocationID sets the "location_id" field.
+func (shuo *SlotHoldUpdateOne) SetLocationID(i int) *SlotHoldUpdateOne {
+	shuo.mutation.SetLocationID(i)
+	return shuo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableLocationID(i *int) *SlotHoldUpdateOne {
+	if i != nil {
+		shuo.SetLocationID(*i)
+	}
+	return shuo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (shuo *SlotHoldUpdateOne) ClearLocationID() *SlotHoldUpdateOne {
+	shuo.mutation.ClearLocationID()
+	return shuo
+}
+
+// SetStaffID sets the "staff_id" field.
+func (shuo *SlotHoldUpdateOne) SetStaffID(i int) *SlotHoldUpdateOne {
+	shuo.mutation.SetStaffID(i)
+	return shuo
+}
+
+// SetNillableStaffID sets the "staff_id" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableStaffID(i *int) *SlotHoldUpdateOne {
+	if i != nil {
+		shuo.SetStaffID(*i)
+	}
+	return shuo
+}
+
+// ClearStaffID clears the value of the "staff_id" field.
+func (shuo *SlotHoldUpdateOne) ClearStaffID() *SlotHoldUpdateOne {
+	shuo.mutation.ClearStaffID()
+	return shuo
+}
+
+// SetClient sets the "client" field.
+func (shuo *SlotHoldUpdateOne) SetClient(s string) *SlotHoldUpdateOne {
+	shuo.mutation.SetClient(s)
+	return shuo
+}
+
+// SetNillableClient sets the "client" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableClient(s *string) *SlotHoldUpdateOne {
+	if s != nil {
+		shuo.SetClient(*s)
+	}
+	return shuo
+}
+
+// ClearClient clears the value of the "client" field.
+func (shuo *SlotHoldUpdateOne) ClearClient() *SlotHoldUpdateOne {
+	shuo.mutation.ClearClient()
+	return shuo
+}
+
+// SetAddress sets the "address" field.
+func (shuo *SlotHoldUpdateOne) SetAddress(s string) *SlotHoldUpdateOne {
+	shuo.mutation.SetAddress(s)
+	return shuo
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (shuo *SlotHoldUpdateOne) SetNillableAddress(s *string) *SlotHoldUpdateOne {
+	if s != nil {
+		shuo.SetAddress(*s)
+	}
+	return shuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (shuo *SlotHoldUpdateOne) ClearAddress() *SlotHoldUpdateOne {
+	shuo.mutation.ClearAddress()
+	return shuo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (shuo *SlotHoldUpdateOne) SetLocation(l *Location) *SlotHoldUpdateOne {
+	return shuo.SetLocationID(l.ID)
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (shuo *SlotHoldUpdateOne) SetStaff(s *Staff) *SlotHoldUpdateOne {
+	return shuo.SetStaffID(s.ID)
+}
+
+// Mutation returns the SlotHoldMutation object of the builder.
+func (shuo *SlotHoldUpdateOne) Mutation() *SlotHoldMutation {
+	return shuo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (shuo *SlotHoldUpdateOne) ClearLocation() *SlotHoldUpdateOne {
+	shuo.mutation.ClearLocation()
+	return shuo
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (shuo *SlotHoldUpdateOne) ClearStaff() *SlotHoldUpdateOne {
+	shuo.mutation.ClearStaff()
+	return shuo
+}
+
+// Where appends a list predicates to the SlotHoldUpdate builder.
+func (shuo *SlotHoldUpdateOne) Where(ps ...predicate.SlotHold) *SlotHoldUpdateOne {
+	shuo.mutation.Where(ps...)
+	return shuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (shuo *SlotHoldUpdateOne) Select(field string, fields ...string) *SlotHoldUpdateOne {
+	shuo.fields = append([]string{field}, fields...)
+	return shuo
+}
+
+// Save executes the query and returns the updated SlotHold entity.
+func (shuo *SlotHoldUpdateOne) Save(ctx context.Context) (*SlotHold, error) {
+	return withHooks(ctx, shuo.sqlSave, shuo.mutation, shuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (shuo *SlotHoldUpdateOne) SaveX(ctx context.Context) *SlotHold {
+	node, err := shuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (shuo *SlotHoldUpdateOne) Exec(ctx context.Context) error {
+	_, err := shuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (shuo *SlotHoldUpdateOne) ExecX(ctx context.Context) {
+	if err := shuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (shuo *SlotHoldUpdateOne) check() error {
+	if v, ok := shuo.mutation.Token(); ok {
+		if err := slothold.TokenValidator(v); err != nil {
+			return &ValidationError{Name: "token", err: fmt.Errorf(`ent: validator failed for field "SlotHold.token": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (shuo *SlotHoldUpdateOne) sqlSave(ctx context.Context) (_node *SlotHold, err error) {
+	if err := shuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(slothold.Table, slothold.Columns, sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt))
+	id, ok := shuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SlotHold.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := shuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slothold.FieldID)
+		for _, f := range fields {
+			if !slothold.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != slothold.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := shuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := shuo.mutation.Token(); ok {
+		_spec.SetField(slothold.FieldToken, field.TypeString, value)
+	}
+	if value, ok := shuo.mutation.StartTime(); ok {
+		_spec.SetField(slothold.FieldStartTime, field.TypeTime, value)
+	}
+	if value, ok := shuo.mutation.EndTime(); ok {
+		_spec.SetField(slothold.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := shuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(slothold.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := shuo.mutation.GetClient(); ok {
+		_spec.SetField(slothold.FieldClient, field.TypeString, value)
+	}
+	if shuo.mutation.ClientCleared() {
+		_spec.ClearField(slothold.FieldClient, field.TypeString)
+	}
+	if value, ok := shuo.mutation.Address(); ok {
+		_spec.SetField(slothold.FieldAddress, field.TypeString, value)
+	}
+	if shuo.mutation.AddressCleared() {
+		_spec.ClearField(slothold.FieldAddress, field.TypeString)
+	}
+	if shuo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.LocationTable,
+			Columns: []string{slothold.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := shuo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.LocationTable,
+			Columns: []string{slothold.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if shuo.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.StaffTable,
+			Columns: []string{slothold.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := shuo.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   slothold.StaffTable,
+			Columns: []string{slothold.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SlotHold{config: shuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, shuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slothold.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	shuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/staff"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Staff is the model entity for the Staff schema.
+type Staff struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Types holds the value of the "types" field.
+	Types []string `json:"types,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StaffQuery when eager-loading is set.
+	Edges        StaffEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// StaffEdges holds the relations/edges for other nodes in the graph.
+type StaffEdges struct {
+	// WorkingHours holds the value of the working_hours edge.
+	WorkingHours []*WorkingHours `json:"working_hours,omitempty"`
+	// Appointments holds the value of the appointments edge.
+	Appointments []*Appointment `json:"appointments,omitempty"`
+	// SlotHolds holds the value of the slot_holds edge.
+	SlotHolds []*SlotHold `json:"slot_holds,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// WorkingHoursOrErr returns the WorkingHours value or an error if the edge
+// was not loaded in eager-loading.
+func (e StaffEdges) WorkingHoursOrErr() ([]*WorkingHours, error) {
+	if e.loadedTypes[0] {
+		return e.WorkingHours, nil
+	}
+	return nil, &NotLoadedError{edge: "working_hours"}
+}
+
+// AppointmentsOrErr returns the Appointments value or an error if the edge
+// was not loaded in eager-loading.
+func (e StaffEdges) AppointmentsOrErr() ([]*Appointment, error) {
+	if e.loadedTypes[1] {
+		return e.Appointments, nil
+	}
+	return nil, &NotLoadedError{edge: "appointments"}
+}
+
+// SlotHoldsOrErr returns the SlotHolds value or an error if the edge
+// was not loaded in eager-loading.
+func (e StaffEdges) SlotHoldsOrErr() ([]*SlotHold, error) {
+	if e.loadedTypes[2] {
+		return e.SlotHolds, nil
+	}
+	return nil, &NotLoadedError{edge: "slot_holds"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StaffEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Staff) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case staff.FieldTypes:
+			values[i] = new([]byte)
+		case staff.FieldID, staff.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case staff.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Staff fields.
+func (s *Staff) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case staff.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case staff.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case staff.FieldTypes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field types", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.Types); err != nil {
+					return fmt.Errorf("unmarshal field types: %w", err)
+				}
+			}
+		case staff.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				s.LocationID = new(int)
+				*s.LocationID = int(value.Int64)
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Staff.
+// This includes values selected through modifiers, order, etc.
+func (s *Staff) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryWorkingHours queries the "working_hours" edge of the Staff entity.
+func (s *Staff) QueryWorkingHours() *WorkingHoursQuery {
+	return NewStaffClient(s.config).QueryWorkingHours(s)
+}
+
+// QueryAppointments queries the "appointments" edge of the Staff entity.
+func (s *Staff) QueryAppointments() *AppointmentQuery {
+	return NewStaffClient(s.config).QueryAppointments(s)
+}
+
+// QuerySlotHolds queries the "slot_holds" edge of the Staff entity.
+func (s *Staff) QuerySlotHolds() *SlotHoldQuery {
+	return NewStaffClient(s.config).QuerySlotHolds(s)
+}
+
+// QueryLocation queries the "location" edge of the Staff entity.
+func (s *Staff) QueryLocation() *LocationQuery {
+	return NewStaffClient(s.config).QueryLocation(s)
+}
+
+// Update returns a builder for updating this Staff.
+// Note that you need to call Staff.Unwrap() before calling this method if this Staff
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Staff) Update() *StaffUpdateOne {
+	return NewStaffClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Staff entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Staff) Unwrap() *Staff {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Staff is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Staff) String() string {
+	var builder strings.Builder
+	builder.WriteString("Staff(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("name=")
+	builder.WriteString(s.Name)
+	builder.WriteString(", ")
+	builder.WriteString("types=")
+	builder.WriteString(fmt.Sprintf("%v", s.Types))
+	builder.WriteString(", ")
+	if v := s.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Staffs is a parsable slice of Staff.
+type Staffs []*Staff
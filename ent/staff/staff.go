@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package staff
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the staff type in the database.
+	Label = "staff"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldTypes holds the string denoting the types field in the database.
+	FieldTypes = "types"
+	// FieldLocationID holds the string denoting the location_id field in the database.
+	FieldLocationID = "location_id"
+	// EdgeWorkingHours holds the string denoting the working_hours edge name in mutations.
+	EdgeWorkingHours = "working_hours"
+	// EdgeAppointments holds the string denoting the appointments edge name in mutations.
+	EdgeAppointments = "appointments"
+	// EdgeSlotHolds holds the string denoting the slot_holds edge name in mutations.
+	EdgeSlotHolds = "slot_holds"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// Table holds the table name of the staff in the database.
+	Table = "staffs"
+	// WorkingHoursTable is the table that holds the working_hours relation/edge.
+	WorkingHoursTable = "working_hours"
+	// WorkingHoursInverseTable is the table name for the WorkingHours entity.
+	// It exists in this package in order to avoid circular dependency with the "workinghours" package.
+	WorkingHoursInverseTable = "working_hours"
+	// WorkingHoursColumn is the table column denoting the working_hours relation/edge.
+	WorkingHoursColumn = "staff_working_hours"
+	// AppointmentsTable is the table that holds the appointments relation/edge.
+	AppointmentsTable = "appointments"
+	// AppointmentsInverseTable is the table name for the Appointment entity.
+	// It exists in this package in order to avoid circular dependency with the "appointment" package.
+	AppointmentsInverseTable = "appointments"
+	// AppointmentsColumn is the table column denoting the appointments relation/edge.
+	AppointmentsColumn = "staff_id"
+	// SlotHoldsTable is the table that holds the slot_holds relation/edge.
+	SlotHoldsTable = "slot_holds"
+	// SlotHoldsInverseTable is the table name for the SlotHold entity.
+	// It exists in this package in order to avoid circular dependency with the "slothold" package.
+	SlotHoldsInverseTable = "slot_holds"
+	// SlotHoldsColumn is the table column denoting the slot_holds relation/edge.
+	SlotHoldsColumn = "staff_id"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "staffs"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "location_id"
+)
+
+// Columns holds all SQL columns for staff fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldTypes,
+	FieldLocationID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the Staff queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByLocationID orders the results by the location_id field.
+func ByLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationID, opts...).ToFunc()
+}
+
+// ByWorkingHoursCount orders the results by working_hours count.
+func ByWorkingHoursCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkingHoursStep(), opts...)
+	}
+}
+
+// ByWorkingHours orders the results by working_hours terms.
+func ByWorkingHours(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkingHoursStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAppointmentsCount orders the results by appointments count.
+func ByAppointmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAppointmentsStep(), opts...)
+	}
+}
+
+// ByAppointments orders the results by appointments terms.
+func ByAppointments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAppointmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySlotHoldsCount orders the results by slot_holds count.
+func BySlotHoldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSlotHoldsStep(), opts...)
+	}
+}
+
+// BySlotHolds orders the results by slot_holds terms.
+func BySlotHolds(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSlotHoldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newWorkingHoursStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkingHoursInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WorkingHoursTable, WorkingHoursColumn),
+	)
+}
+func newAppointmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AppointmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AppointmentsTable, AppointmentsColumn),
+	)
+}
+func newSlotHoldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SlotHoldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SlotHoldsTable, SlotHoldsColumn),
+	)
+}
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+	)
+}
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// StaffCreate is the builder for creating a Staff entity.
+type StaffCreate struct {
+	config
+	mutation *StaffMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (sc *StaffCreate) SetName(s string) *StaffCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetTypes sets the "types" field.
+func (sc *StaffCreate) SetTypes(s []string) *StaffCreate {
+	sc.mutation.SetTypes(s)
+	return sc
+}
+
+// SetLocationID sets the "location_id" field.
+func (sc *StaffCreate) SetLocationID(i int) *StaffCreate {
+	sc.mutation.SetLocationID(i)
+	return sc
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (sc *StaffCreate) SetNillableLocationID(i *int) *StaffCreate {
+	if i != nil {
+		sc.SetLocationID(*i)
+	}
+	return sc
+}
+
+// AddWorkingHourIDs adds the "working_hours" edge to the WorkingHours entity by IDs.
+func (sc *StaffCreate) AddWorkingHourIDs(ids ...int) *StaffCreate {
+	sc.mutation.AddWorkingHourIDs(ids...)
+	return sc
+}
+
+// AddWorkingHours adds the "working_hours" edges to the WorkingHours entity.
+func (sc *StaffCreate) AddWorkingHours(w ...*WorkingHours) *StaffCreate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return sc.AddWorkingHourIDs(ids...)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (sc *StaffCreate) AddAppointmentIDs(ids ...int) *StaffCreate {
+	sc.mutation.AddAppointmentIDs(ids...)
+	return sc
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (sc *StaffCreate) AddAppointments(a ...*Appointment) *StaffCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return sc.AddAppointmentIDs(ids...)
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by IDs.
+func (sc *StaffCreate) AddSlotHoldIDs(ids ...int) *StaffCreate {
+	sc.mutation.AddSlotHoldIDs(ids...)
+	return sc
+}
+
+// AddSlotHolds adds the "slot_holds" edges to the SlotHold entity.
+func (sc *StaffCreate) AddSlotHolds(s ...*SlotHold) *StaffCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return sc.AddSlotHoldIDs(ids...)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (sc *StaffCreate) SetLocation(l *Location) *StaffCreate {
+	return sc.SetLocationID(l.ID)
+}
+
+// Mutation returns the StaffMutation object of the builder.
+func (sc *StaffCreate) Mutation() *StaffMutation {
+	return sc.mutation
+}
+
+// Save creates the Staff in the database.
+func (sc *StaffCreate) Save(ctx context.Context) (*Staff, error) {
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *StaffCreate) SaveX(ctx context.Context) *Staff {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *StaffCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *StaffCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *StaffCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Staff.name"`)}
+	}
+	if v, ok := sc.mutation.Name(); ok {
+		if err := staff.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Staff.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sc *StaffCreate) sqlSave(ctx context.Context) (*Staff, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *StaffCreate) createSpec() (*Staff, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Staff{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(staff.Table, sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.SetField(staff.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sc.mutation.Types(); ok {
+		_spec.SetField(staff.FieldTypes, field.TypeJSON, value)
+		_node.Types = value
+	}
+	if nodes := sc.mutation.WorkingHoursIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.SlotHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staff.LocationTable,
+			Columns: []string{staff.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StaffCreateBulk is the builder for creating many Staff entities in bulk.
+type StaffCreateBulk struct {
+	config
+	err      error
+	builders []*StaffCreate
+}
+
+// Save creates the Staff entities in the database.
+func (scb *StaffCreateBulk) Save(ctx context.Context) ([]*Staff, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Staff, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StaffMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *StaffCreateBulk) SaveX(ctx context.Context) []*Staff {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *StaffCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *StaffCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,904 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/appointment"
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/slothold"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// StaffUpdate is the builder for updating Staff entities.
+type StaffUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StaffMutation
+}
+
+// Where appends a list predicates to the StaffUpdate builder.
+func (su *StaffUpdate) Where(ps ...predicate.Staff) *StaffUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetName sets the "name" field.
+func (su *StaffUpdate) SetName(s string) *StaffUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (su *StaffUpdate) SetNillableName(s *string) *StaffUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// SetTypes sets the "types" field.
+func (su *StaffUpdate) SetTypes(s []string) *StaffUpdate {
+	su.mutation.SetTypes(s)
+	return su
+}
+
+// AppendTypes appends s to the "types" field.
+func (su *StaffUpdate) AppendTypes(s []string) *StaffUpdate {
+	su.mutation.AppendTypes(s)
+	return su
+}
+
+// ClearTypes clears the value of the "types" field.
+func (su *StaffUpdate) ClearTypes() *StaffUpdate {
+	su.mutation.ClearTypes()
+	return su
+}
+
+// SetLocationID sets the "location_id" field.
+func (su *StaffUpdate) SetLocationID(i int) *StaffUpdate {
+	su.mutation.SetLocationID(i)
+	return su
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (su *StaffUpdate) SetNillableLocationID(i *int) *StaffUpdate {
+	if i != nil {
+		su.SetLocationID(*i)
+	}
+	return su
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (su *StaffUpdate) ClearLocationID() *StaffUpdate {
+	su.mutation.ClearLocationID()
+	return su
+}
+
+// AddWorkingHourIDs adds the "working_hours" edge to the WorkingHours entity by IDs.
+func (su *StaffUpdate) AddWorkingHourIDs(ids ...int) *StaffUpdate {
+	su.mutation.AddWorkingHourIDs(ids...)
+	return su
+}
+
+// AddWorkingHours adds the "working_hours" edges to the WorkingHours entity.
+func (su *StaffUpdate) AddWorkingHours(w ...*WorkingHours) *StaffUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return su.AddWorkingHourIDs(ids...)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (su *StaffUpdate) AddAppointmentIDs(ids ...int) *StaffUpdate {
+	su.mutation.AddAppointmentIDs(ids...)
+	return su
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (su *StaffUpdate) AddAppointments(a ...*Appointment) *StaffUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return su.AddAppointmentIDs(ids...)
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by IDs.
+func (su *StaffUpdate) AddSlotHoldIDs(ids ...int) *StaffUpdate {
+	su.mutation.AddSlotHoldIDs(ids...)
+	return su
+}
+
+// AddSlotHolds adds the "slot_holds" edges to the SlotHold entity.
+func (su *StaffUpdate) AddSlotHolds(s ...*SlotHold) *StaffUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.AddSlotHoldIDs(ids...)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (su *StaffUpdate) SetLocation(l *Location) *StaffUpdate {
+	return su.SetLocationID(l.ID)
+}
+
+// Mutation returns the StaffMutation object of the builder.
+func (su *StaffUpdate) Mutation() *StaffMutation {
+	return su.mutation
+}
+
+// ClearWorkingHours clears all "working_hours" edges to the WorkingHours entity.
+func (su *StaffUpdate) ClearWorkingHours() *StaffUpdate {
+	su.mutation.ClearWorkingHours()
+	return su
+}
+
+// RemoveWorkingHourIDs removes the "working_hours" edge to WorkingHours entities by IDs.
+func (su *StaffUpdate) RemoveWorkingHourIDs(ids ...int) *StaffUpdate {
+	su.mutation.RemoveWorkingHourIDs(ids...)
+	return su
+}
+
+// RemoveWorkingHours removes "working_hours" edges to WorkingHours entities.
+func (su *StaffUpdate) RemoveWorkingHours(w ...*WorkingHours) *StaffUpdate {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return su.RemoveWorkingHourIDs(ids...)
+}
+
+// ClearAppointments clears all "appointments" edges to the Appointment entity.
+func (su *StaffUpdate) ClearAppointments() *StaffUpdate {
+	su.mutation.ClearAppointments()
+	return su
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to Appointment entities by IDs.
+func (su *StaffUpdate) RemoveAppointmentIDs(ids ...int) *StaffUpdate {
+	su.mutation.RemoveAppointmentIDs(ids...)
+	return su
+}
+
+// RemoveAppointments removes "appointments" edges to Appointment entities.
+func (su *StaffUpdate) RemoveAppointments(a ...*Appointment) *StaffUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return su.RemoveAppointmentIDs(ids...)
+}
+
+// ClearSlotHolds clears all "slot_holds" edges to the SlotHold entity.
+func (su *StaffUpdate) ClearSlotHolds() *StaffUpdate {
+	su.mutation.ClearSlotHolds()
+	return su
+}
+
+// RemoveSlotHoldIDs removes the "slot_holds" edge to SlotHold entities by IDs.
+func (su *StaffUpdate) RemoveSlotHoldIDs(ids ...int) *StaffUpdate {
+	su.mutation.RemoveSlotHoldIDs(ids...)
+	return su
+}
+
+// RemoveSlotHolds removes "slot_holds" edges to SlotHold entities.
+func (su *StaffUpdate) RemoveSlotHolds(s ...*SlotHold) *StaffUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return su.RemoveSlotHoldIDs(ids...)
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (su *StaffUpdate) ClearLocation() *StaffUpdate {
+	su.mutation.ClearLocation()
+	return su
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *StaffUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *StaffUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *StaffUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *StaffUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *StaffUpdate) check() error {
+	if v, ok := su.mutation.Name(); ok {
+		if err := staff.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Staff.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *StaffUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(staff.Table, staff.Columns, sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.SetField(staff.FieldName, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Types(); ok {
+		_spec.SetField(staff.FieldTypes, field.TypeJSON, value)
+	}
+	if value, ok := su.mutation.AppendedTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, staff.FieldTypes, value)
+		})
+	}
+	if su.mutation.TypesCleared() {
+		_spec.ClearField(staff.FieldTypes, field.TypeJSON)
+	}
+	if su.mutation.WorkingHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedWorkingHoursIDs(); len(nodes) > 0 && !su.mutation.WorkingHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.WorkingHoursIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedAppointmentsIDs(); len(nodes) > 0 && !su.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedSlotHoldsIDs(); len(nodes) > 0 && !su.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.SlotHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staff.LocationTable,
+			Columns: []string{staff.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staff.LocationTable,
+			Columns: []string{staff.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{staff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// StaffUpdateOne is the builder for updating a single Staff entity.
+type StaffUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StaffMutation
+}
+
+// SetName sets the "name" field.
+func (suo *StaffUpdateOne) SetName(s string) *StaffUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (suo *StaffUpdateOne) SetNillableName(s *string) *StaffUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// SetTypes sets the "types" field.
+func (suo *StaffUpdateOne) SetTypes(s []string) *StaffUpdateOne {
+	suo.mutation.SetTypes(s)
+	return suo
+}
+
+// AppendTypes appends s to the "types" field.
+func (suo *StaffUpdateOne) AppendTypes(s []string) *StaffUpdateOne {
+	suo.mutation.AppendTypes(s)
+	return suo
+}
+
+// ClearTypes clears the value of the "types" field.
+func (suo *StaffUpdateOne) ClearTypes() *StaffUpdateOne {
+	suo.mutation.ClearTypes()
+	return suo
+}
+
+// SetLocationID sets the "location_id" field.
+func (suo *StaffUpdateOne) SetLocationID(i int) *StaffUpdateOne {
+	suo.mutation.SetLocationID(i)
+	return suo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (suo *StaffUpdateOne) SetNillableLocationID(i *int) *StaffUpdateOne {
+	if i != nil {
+		suo.SetLocationID(*i)
+	}
+	return suo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (suo *StaffUpdateOne) ClearLocationID() *StaffUpdateOne {
+	suo.mutation.ClearLocationID()
+	return suo
+}
+
+// AddWorkingHourIDs adds the "working_hours" edge to the WorkingHours entity by IDs.
+func (suo *StaffUpdateOne) AddWorkingHourIDs(ids ...int) *StaffUpdateOne {
+	suo.mutation.AddWorkingHourIDs(ids...)
+	return suo
+}
+
+// AddWorkingHours adds the "working_hours" edges to the WorkingHours entity.
+func (suo *StaffUpdateOne) AddWorkingHours(w ...*WorkingHours) *StaffUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return suo.AddWorkingHourIDs(ids...)
+}
+
+// AddAppointmentIDs adds the "appointments" edge to the Appointment entity by IDs.
+func (suo *StaffUpdateOne) AddAppointmentIDs(ids ...int) *StaffUpdateOne {
+	suo.mutation.AddAppointmentIDs(ids...)
+	return suo
+}
+
+// AddAppointments adds the "appointments" edges to the Appointment entity.
+func (suo *StaffUpdateOne) AddAppointments(a ...*Appointment) *StaffUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return suo.AddAppointmentIDs(ids...)
+}
+
+// AddSlotHoldIDs adds the "slot_holds" edge to the SlotHold entity by IDs.
+func (suo *StaffUpdateOne) AddSlotHoldIDs(ids ...int) *StaffUpdateOne {
+	suo.mutation.AddSlotHoldIDs(ids...)
+	return suo
+}
+
+// AddSlotHolds adds the "slot_holds" edges to the SlotHold entity.
+func (suo *StaffUpdateOne) AddSlotHolds(s ...*SlotHold) *StaffUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.AddSlotHoldIDs(ids...)
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (suo *StaffUpdateOne) SetLocation(l *Location) *StaffUpdateOne {
+	return suo.SetLocationID(l.ID)
+}
+
+// Mutation returns the StaffMutation object of the builder.
+func (suo *StaffUpdateOne) Mutation() *StaffMutation {
+	return suo.mutation
+}
+
+// ClearWorkingHours clears all "working_hours" edges to the WorkingHours entity.
+func (suo *StaffUpdateOne) ClearWorkingHours() *StaffUpdateOne {
+	suo.mutation.ClearWorkingHours()
+	return suo
+}
+
+// RemoveWorkingHourIDs removes the "working_hours" edge to WorkingHours entities by IDs.
+func (suo *StaffUpdateOne) RemoveWorkingHourIDs(ids ...int) *StaffUpdateOne {
+	suo.mutation.RemoveWorkingHourIDs(ids...)
+	return suo
+}
+
+// RemoveWorkingHours removes "working_hours" edges to WorkingHours entities.
+func (suo *StaffUpdateOne) RemoveWorkingHours(w ...*WorkingHours) *StaffUpdateOne {
+	ids := make([]int, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return suo.RemoveWorkingHourIDs(ids...)
+}
+
+// ClearAppointments clears all "appointments" edges to the Appointment entity.
+func (suo *StaffUpdateOne) ClearAppointments() *StaffUpdateOne {
+	suo.mutation.ClearAppointments()
+	return suo
+}
+
+// RemoveAppointmentIDs removes the "appointments" edge to Appointment entities by IDs.
+func (suo *StaffUpdateOne) RemoveAppointmentIDs(ids ...int) *StaffUpdateOne {
+	suo.mutation.RemoveAppointmentIDs(ids...)
+	return suo
+}
+
+// RemoveAppointments removes "appointments" edges to Appointment entities.
+func (suo *StaffUpdateOne) RemoveAppointments(a ...*Appointment) *StaffUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return suo.RemoveAppointmentIDs(ids...)
+}
+
+// ClearSlotHolds clears all "slot_holds" edges to the SlotHold entity.
+func (suo *StaffUpdateOne) ClearSlotHolds() *StaffUpdateOne {
+	suo.mutation.ClearSlotHolds()
+	return suo
+}
+
+// RemoveSlotHoldIDs removes the "slot_holds" edge to SlotHold entities by IDs.
+func (suo *StaffUpdateOne) RemoveSlotHoldIDs(ids ...int) *StaffUpdateOne {
+	suo.mutation.RemoveSlotHoldIDs(ids...)
+	return suo
+}
+
+// RemoveSlotHolds removes "slot_holds" edges to SlotHold entities.
+func (suo *StaffUpdateOne) RemoveSlotHolds(s ...*SlotHold) *StaffUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return suo.RemoveSlotHoldIDs(ids...)
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (suo *StaffUpdateOne) ClearLocation() *StaffUpdateOne {
+	suo.mutation.ClearLocation()
+	return suo
+}
+
+// Where appends a list predicates to the StaffUpdate builder.
+func (suo *StaffUpdateOne) Where(ps ...predicate.Staff) *StaffUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *StaffUpdateOne) Select(field string, fields ...string) *StaffUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Staff entity.
+func (suo *StaffUpdateOne) Save(ctx context.Context) (*Staff, error) {
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *StaffUpdateOne) SaveX(ctx context.Context) *Staff {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *StaffUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *StaffUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *StaffUpdateOne) check() error {
+	if v, ok := suo.mutation.Name(); ok {
+		if err := staff.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Staff.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *StaffUpdateOne) sqlSave(ctx context.Context) (_node *Staff, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(staff.Table, staff.Columns, sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Staff.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, staff.FieldID)
+		for _, f := range fields {
+			if !staff.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != staff.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.SetField(staff.FieldName, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Types(); ok {
+		_spec.SetField(staff.FieldTypes, field.TypeJSON, value)
+	}
+	if value, ok := suo.mutation.AppendedTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, staff.FieldTypes, value)
+		})
+	}
+	if suo.mutation.TypesCleared() {
+		_spec.ClearField(staff.FieldTypes, field.TypeJSON)
+	}
+	if suo.mutation.WorkingHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedWorkingHoursIDs(); len(nodes) > 0 && !suo.mutation.WorkingHoursCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.WorkingHoursIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.WorkingHoursTable,
+			Columns: []string{staff.WorkingHoursColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedAppointmentsIDs(); len(nodes) > 0 && !suo.mutation.AppointmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.AppointmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.AppointmentsTable,
+			Columns: []string{staff.AppointmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(appointment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedSlotHoldsIDs(); len(nodes) > 0 && !suo.mutation.SlotHoldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.SlotHoldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   staff.SlotHoldsTable,
+			Columns: []string{staff.SlotHoldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(slothold.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staff.LocationTable,
+			Columns: []string{staff.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   staff.LocationTable,
+			Columns: []string{staff.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Staff{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{staff.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
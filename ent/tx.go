@@ -14,6 +14,26 @@ type Tx struct {
 	config
 	// Appointment is the client for interacting with the Appointment builders.
 	Appointment *AppointmentClient
+	// BlockedTime is the client for interacting with the BlockedTime builders.
+	BlockedTime *BlockedTimeClient
+	// ClosureDay is the client for interacting with the ClosureDay builders.
+	ClosureDay *ClosureDayClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// OpeningHours is the client for interacting with the OpeningHours builders.
+	OpeningHours *OpeningHoursClient
+	// OpeningHoursException is the client for interacting with the OpeningHoursException builders.
+	OpeningHoursException *OpeningHoursExceptionClient
+	// Reminder is the client for interacting with the Reminder builders.
+	Reminder *ReminderClient
+	// SlotHold is the client for interacting with the SlotHold builders.
+	SlotHold *SlotHoldClient
+	// Staff is the client for interacting with the Staff builders.
+	Staff *StaffClient
+	// WaitlistEntry is the client for interacting with the WaitlistEntry builders.
+	WaitlistEntry *WaitlistEntryClient
+	// WorkingHours is the client for interacting with the WorkingHours builders.
+	WorkingHours *WorkingHoursClient
 
 	// lazily loaded.
 	client     *Client
@@ -146,6 +166,16 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Appointment = NewAppointmentClient(tx.config)
+	tx.BlockedTime = NewBlockedTimeClient(tx.config)
+	tx.ClosureDay = NewClosureDayClient(tx.config)
+	tx.Location = NewLocationClient(tx.config)
+	tx.OpeningHours = NewOpeningHoursClient(tx.config)
+	tx.OpeningHoursException = NewOpeningHoursExceptionClient(tx.config)
+	tx.Reminder = NewReminderClient(tx.config)
+	tx.SlotHold = NewSlotHoldClient(tx.config)
+	tx.Staff = NewStaffClient(tx.config)
+	tx.WaitlistEntry = NewWaitlistEntryClient(tx.config)
+	tx.WorkingHours = NewWorkingHoursClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
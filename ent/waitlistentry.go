@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/waitlistentry"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WaitlistEntry is the model entity for the WaitlistEntry schema.
+type WaitlistEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Phone holds the value of the "phone" field.
+	Phone string `json:"phone,omitempty"`
+	// Date holds the value of the "date" field.
+	Date string `json:"date,omitempty"`
+	// WindowStart holds the value of the "window_start" field.
+	WindowStart string `json:"window_start,omitempty"`
+	// WindowEnd holds the value of the "window_end" field.
+	WindowEnd string `json:"window_end,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Status holds the value of the "status" field.
+	Status waitlistentry.Status `json:"status,omitempty"`
+	// Token holds the value of the "token" field.
+	Token *string `json:"token,omitempty"`
+	// OfferedStart holds the value of the "offered_start" field.
+	OfferedStart *time.Time `json:"offered_start,omitempty"`
+	// OfferedEnd holds the value of the "offered_end" field.
+	OfferedEnd *time.Time `json:"offered_end,omitempty"`
+	// OfferExpiresAt holds the value of the "offer_expires_at" field.
+	OfferExpiresAt *time.Time `json:"offer_expires_at,omitempty"`
+	// LocationID holds the value of the "location_id" field.
+	LocationID *int `json:"location_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WaitlistEntryQuery when eager-loading is set.
+	Edges        WaitlistEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WaitlistEntryEdges holds the relations/edges for other nodes in the graph.
+type WaitlistEntryEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaitlistEntryEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaitlistEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID, waitlistentry.FieldLocationID:
+			values[i] = new(sql.NullInt64)
+		case waitlistentry.FieldName, waitlistentry.FieldEmail, waitlistentry.FieldPhone, waitlistentry.FieldDate, waitlistentry.FieldWindowStart, waitlistentry.FieldWindowEnd, waitlistentry.FieldType, waitlistentry.FieldStatus, waitlistentry.FieldToken:
+			values[i] = new(sql.NullString)
+		case waitlistentry.FieldOfferedStart, waitlistentry.FieldOfferedEnd, waitlistentry.FieldOfferExpiresAt, waitlistentry.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaitlistEntry fields.
+func (we *WaitlistEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case waitlistentry.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			we.ID = int(value.Int64)
+		case waitlistentry.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				we.Name = value.String
+			}
+		case waitlistentry.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				we.Email = value.String
+			}
+		case waitlistentry.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				we.Phone = value.String
+			}
+		case waitlistentry.FieldDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field date", values[i])
+			} else if value.Valid {
+				we.Date = value.String
+			}
+		case waitlistentry.FieldWindowStart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field window_start", values[i])
+			} else if value.Valid {
+				we.WindowStart = value.String
+			}
+		case waitlistentry.FieldWindowEnd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field window_end", values[i])
+			} else if value.Valid {
+				we.WindowEnd = value.String
+			}
+		case waitlistentry.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				we.Type = value.String
+			}
+		case waitlistentry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = waitlistentry.Status(value.String)
+			}
+		case waitlistentry.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				we.Token = new(string)
+				*we.Token = value.String
+			}
+		case waitlistentry.FieldOfferedStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offered_start", values[i])
+			} else if value.Valid {
+				we.OfferedStart = new(time.Time)
+				*we.OfferedStart = value.Time
+			}
+		case waitlistentry.FieldOfferedEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offered_end", values[i])
+			} else if value.Valid {
+				we.OfferedEnd = new(time.Time)
+				*we.OfferedEnd = value.Time
+			}
+		case waitlistentry.FieldOfferExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field offer_expires_at", values[i])
+			} else if value.Valid {
+				we.OfferExpiresAt = new(time.Time)
+				*we.OfferExpiresAt = value.Time
+			}
+		case waitlistentry.FieldLocationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				we.LocationID = new(int)
+				*we.LocationID = int(value.Int64)
+			}
+		case waitlistentry.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaitlistEntry.
+// This includes values selected through modifiers, order, etc.
+func (we *WaitlistEntry) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// QueryLocation queries the "location" edge of the WaitlistEntry entity.
+func (we *WaitlistEntry) QueryLocation() *LocationQuery {
+	return NewWaitlistEntryClient(we.config).QueryLocation(we)
+}
+
+// Update returns a builder for updating this WaitlistEntry.
+// Note that you need to call WaitlistEntry.Unwrap() before calling this method if this WaitlistEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WaitlistEntry) Update() *WaitlistEntryUpdateOne {
+	return NewWaitlistEntryClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WaitlistEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WaitlistEntry) Unwrap() *WaitlistEntry {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaitlistEntry is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WaitlistEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaitlistEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("name=")
+	builder.WriteString(we.Name)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(we.Email)
+	builder.WriteString(", ")
+	builder.WriteString("phone=")
+	builder.WriteString(we.Phone)
+	builder.WriteString(", ")
+	builder.WriteString("date=")
+	builder.WriteString(we.Date)
+	builder.WriteString(", ")
+	builder.WriteString("window_start=")
+	builder.WriteString(we.WindowStart)
+	builder.WriteString(", ")
+	builder.WriteString("window_end=")
+	builder.WriteString(we.WindowEnd)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(we.Type)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", we.Status))
+	builder.WriteString(", ")
+	if v := we.Token; v != nil {
+		builder.WriteString("token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := we.OfferedStart; v != nil {
+		builder.WriteString("offered_start=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := we.OfferedEnd; v != nil {
+		builder.WriteString("offered_end=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := we.OfferExpiresAt; v != nil {
+		builder.WriteString("offer_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := we.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaitlistEntries is a parsable slice of WaitlistEntry.
+type WaitlistEntries []*WaitlistEntry
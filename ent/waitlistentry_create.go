@@ -0,0 +1,477 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryCreate is the builder for creating a WaitlistEntry entity.
+type WaitlistEntryCreate struct {
+	config
+	mutation *WaitlistEntryMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (wec *WaitlistEntryCreate) SetName(s string) *WaitlistEntryCreate {
+	wec.mutation.SetName(s)
+	return wec
+}
+
+// SetEmail sets the "email" field.
+func (wec *WaitlistEntryCreate) SetEmail(s string) *WaitlistEntryCreate {
+	wec.mutation.SetEmail(s)
+	return wec
+}
+
+// SetPhone sets the "phone" field.
+func (wec *WaitlistEntryCreate) SetPhone(s string) *WaitlistEntryCreate {
+	wec.mutation.SetPhone(s)
+	return wec
+}
+
+// SetDate sets the "date" field.
+func (wec *WaitlistEntryCreate) SetDate(s string) *WaitlistEntryCreate {
+	wec.mutation.SetDate(s)
+	return wec
+}
+
+// SetWindowStart sets the "window_start" field.
+func (wec *WaitlistEntryCreate) SetWindowStart(s string) *WaitlistEntryCreate {
+	wec.mutation.SetWindowStart(s)
+	return wec
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableWindowStart(s *string) *WaitlistEntryCreate {
+	if s != nil {
+		wec.SetWindowStart(*s)
+	}
+	return wec
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (wec *WaitlistEntryCreate) SetWindowEnd(s string) *WaitlistEntryCreate {
+	wec.mutation.SetWindowEnd(s)
+	return wec
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableWindowEnd(s *string) *WaitlistEntryCreate {
+	if s != nil {
+		wec.SetWindowEnd(*s)
+	}
+	return wec
+}
+
+// SetType sets the "type" field.
+func (wec *WaitlistEntryCreate) SetType(s string) *WaitlistEntryCreate {
+	wec.mutation.SetType(s)
+	return wec
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableType(s *string) *WaitlistEntryCreate {
+	if s != nil {
+		wec.SetType(*s)
+	}
+	return wec
+}
+
+// SetStatus sets the "status" field.
+func (wec *WaitlistEntryCreate) SetStatus(w waitlistentry.Status) *WaitlistEntryCreate {
+	wec.mutation.SetStatus(w)
+	return wec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableStatus(w *waitlistentry.Status) *WaitlistEntryCreate {
+	if w != nil {
+		wec.SetStatus(*w)
+	}
+	return wec
+}
+
+// SetToken sets the "token" field.
+func (wec *WaitlistEntryCreate) SetToken(s string) *WaitlistEntryCreate {
+	wec.mutation.SetToken(s)
+	return wec
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableToken(s *string) *WaitlistEntryCreate {
+	if s != nil {
+		wec.SetToken(*s)
+	}
+	return wec
+}
+
+// SetOfferedStart sets the "offered_start" field.
+func (wec *WaitlistEntryCreate) SetOfferedStart(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetOfferedStart(t)
+	return wec
+}
+
+// SetNillableOfferedStart sets the "offered_start" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableOfferedStart(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetOfferedStart(*t)
+	}
+	return wec
+}
+
+// SetOfferedEnd sets the "offered_end" field.
+func (wec *WaitlistEntryCreate) SetOfferedEnd(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetOfferedEnd(t)
+	return wec
+}
+
+// SetNillableOfferedEnd sets the "offered_end" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableOfferedEnd(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetOfferedEnd(*t)
+	}
+	return wec
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (wec *WaitlistEntryCreate) SetOfferExpiresAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetOfferExpiresAt(t)
+	return wec
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetOfferExpiresAt(*t)
+	}
+	return wec
+}
+
+// SetLocationID sets the "location_id" field.
+func (wec *WaitlistEntryCreate) SetLocationID(i int) *WaitlistEntryCreate {
+	wec.mutation.SetLocationID(i)
+	return wec
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableLocationID(i *int) *WaitlistEntryCreate {
+	if i != nil {
+		wec.SetLocationID(*i)
+	}
+	return wec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wec *WaitlistEntryCreate) SetCreatedAt(t time.Time) *WaitlistEntryCreate {
+	wec.mutation.SetCreatedAt(t)
+	return wec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wec *WaitlistEntryCreate) SetNillableCreatedAt(t *time.Time) *WaitlistEntryCreate {
+	if t != nil {
+		wec.SetCreatedAt(*t)
+	}
+	return wec
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (wec *WaitlistEntryCreate) SetLocation(l *Location) *WaitlistEntryCreate {
+	return wec.SetLocationID(l.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (wec *WaitlistEntryCreate) Mutation() *WaitlistEntryMutation {
+	return wec.mutation
+}
+
+// Save creates the WaitlistEntry in the database.
+func (wec *WaitlistEntryCreate) Save(ctx context.Context) (*WaitlistEntry, error) {
+	wec.defaults()
+	return withHooks(ctx, wec.sqlSave, wec.mutation, wec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WaitlistEntryCreate) SaveX(ctx context.Context) *WaitlistEntry {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WaitlistEntryCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WaitlistEntryCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WaitlistEntryCreate) defaults() {
+	if _, ok := wec.mutation.Status(); !ok {
+		v := waitlistentry.DefaultStatus
+		wec.mutation.SetStatus(v)
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		v := waitlistentry.DefaultCreatedAt()
+		wec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WaitlistEntryCreate) check() error {
+	if _, ok := wec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "WaitlistEntry.name"`)}
+	}
+	if v, ok := wec.mutation.Name(); ok {
+		if err := waitlistentry.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.name": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "WaitlistEntry.email"`)}
+	}
+	if v, ok := wec.mutation.Email(); ok {
+		if err := waitlistentry.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.email": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Phone(); !ok {
+		return &ValidationError{Name: "phone", err: errors.New(`ent: missing required field "WaitlistEntry.phone"`)}
+	}
+	if v, ok := wec.mutation.Phone(); ok {
+		if err := waitlistentry.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.phone": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Date(); !ok {
+		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "WaitlistEntry.date"`)}
+	}
+	if v, ok := wec.mutation.Date(); ok {
+		if err := waitlistentry.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.date": %w`, err)}
+		}
+	}
+	if v, ok := wec.mutation.WindowStart(); ok {
+		if err := waitlistentry.WindowStartValidator(v); err != nil {
+			return &ValidationError{Name: "window_start", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.window_start": %w`, err)}
+		}
+	}
+	if v, ok := wec.mutation.WindowEnd(); ok {
+		if err := waitlistentry.WindowEndValidator(v); err != nil {
+			return &ValidationError{Name: "window_end", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.window_end": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WaitlistEntry.status"`)}
+	}
+	if v, ok := wec.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WaitlistEntry.created_at"`)}
+	}
+	return nil
+}
+
+func (wec *WaitlistEntryCreate) sqlSave(ctx context.Context) (*WaitlistEntry, error) {
+	if err := wec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wec.mutation.id = &_node.ID
+	wec.mutation.done = true
+	return _node, nil
+}
+
+func (wec *WaitlistEntryCreate) createSpec() (*WaitlistEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaitlistEntry{config: wec.config}
+		_spec = sqlgraph.NewCreateSpec(waitlistentry.Table, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	)
+	if value, ok := wec.mutation.Name(); ok {
+		_spec.SetField(waitlistentry.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := wec.mutation.Email(); ok {
+		_spec.SetField(waitlistentry.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := wec.mutation.Phone(); ok {
+		_spec.SetField(waitlistentry.FieldPhone, field.TypeString, value)
+		_node.Phone = value
+	}
+	if value, ok := wec.mutation.Date(); ok {
+		_spec.SetField(waitlistentry.FieldDate, field.TypeString, value)
+		_node.Date = value
+	}
+	if value, ok := wec.mutation.WindowStart(); ok {
+		_spec.SetField(waitlistentry.FieldWindowStart, field.TypeString, value)
+		_node.WindowStart = value
+	}
+	if value, ok := wec.mutation.WindowEnd(); ok {
+		_spec.SetField(waitlistentry.FieldWindowEnd, field.TypeString, value)
+		_node.WindowEnd = value
+	}
+	if value, ok := wec.mutation.GetType(); ok {
+		_spec.SetField(waitlistentry.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := wec.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wec.mutation.Token(); ok {
+		_spec.SetField(waitlistentry.FieldToken, field.TypeString, value)
+		_node.Token = &value
+	}
+	if value, ok := wec.mutation.OfferedStart(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedStart, field.TypeTime, value)
+		_node.OfferedStart = &value
+	}
+	if value, ok := wec.mutation.OfferedEnd(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedEnd, field.TypeTime, value)
+		_node.OfferedEnd = &value
+	}
+	if value, ok := wec.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+		_node.OfferExpiresAt = &value
+	}
+	if value, ok := wec.mutation.CreatedAt(); ok {
+		_spec.SetField(waitlistentry.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := wec.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.LocationTable,
+			Columns: []string{waitlistentry.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WaitlistEntryCreateBulk is the builder for creating many WaitlistEntry entities in bulk.
+type WaitlistEntryCreateBulk struct {
+	config
+	err      error
+	builders []*WaitlistEntryCreate
+}
+
+// Save creates the WaitlistEntry entities in the database.
+func (wecb *WaitlistEntryCreateBulk) Save(ctx context.Context) ([]*WaitlistEntry, error) {
+	if wecb.err != nil {
+		return nil, wecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WaitlistEntry, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaitlistEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WaitlistEntryCreateBulk) SaveX(ctx context.Context) []*WaitlistEntry {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WaitlistEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WaitlistEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,917 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/location"
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/waitlistentry"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WaitlistEntryUpdate is the builder for updating WaitlistEntry entities.
+type WaitlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weu *WaitlistEntryUpdate) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetName sets the "name" field.
+func (weu *WaitlistEntryUpdate) SetName(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetName(s)
+	return weu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableName(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetName(*s)
+	}
+	return weu
+}
+
+// SetEmail sets the "email" field.
+func (weu *WaitlistEntryUpdate) SetEmail(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetEmail(s)
+	return weu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableEmail(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetEmail(*s)
+	}
+	return weu
+}
+
+// SetPhone sets the "phone" field.
+func (weu *WaitlistEntryUpdate) SetPhone(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetPhone(s)
+	return weu
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillablePhone(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetPhone(*s)
+	}
+	return weu
+}
+
+// SetDate sets the "date" field.
+func (weu *WaitlistEntryUpdate) SetDate(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetDate(s)
+	return weu
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableDate(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetDate(*s)
+	}
+	return weu
+}
+
+// SetWindowStart sets the "window_start" field.
+func (weu *WaitlistEntryUpdate) SetWindowStart(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetWindowStart(s)
+	return weu
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableWindowStart(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetWindowStart(*s)
+	}
+	return weu
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (weu *WaitlistEntryUpdate) ClearWindowStart() *WaitlistEntryUpdate {
+	weu.mutation.ClearWindowStart()
+	return weu
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (weu *WaitlistEntryUpdate) SetWindowEnd(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetWindowEnd(s)
+	return weu
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableWindowEnd(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetWindowEnd(*s)
+	}
+	return weu
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (weu *WaitlistEntryUpdate) ClearWindowEnd() *WaitlistEntryUpdate {
+	weu.mutation.ClearWindowEnd()
+	return weu
+}
+
+// SetType sets the "type" field.
+func (weu *WaitlistEntryUpdate) SetType(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetType(s)
+	return weu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableType(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetType(*s)
+	}
+	return weu
+}
+
+// ClearType clears the value of the "type" field.
+func (weu *WaitlistEntryUpdate) ClearType() *WaitlistEntryUpdate {
+	weu.mutation.ClearType()
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WaitlistEntryUpdate) SetStatus(w waitlistentry.Status) *WaitlistEntryUpdate {
+	weu.mutation.SetStatus(w)
+	return weu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableStatus(w *waitlistentry.Status) *WaitlistEntryUpdate {
+	if w != nil {
+		weu.SetStatus(*w)
+	}
+	return weu
+}
+
+// SetToken sets the "token" field.
+func (weu *WaitlistEntryUpdate) SetToken(s string) *WaitlistEntryUpdate {
+	weu.mutation.SetToken(s)
+	return weu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableToken(s *string) *WaitlistEntryUpdate {
+	if s != nil {
+		weu.SetToken(*s)
+	}
+	return weu
+}
+
+// ClearToken clears the value of the "token" field.
+func (weu *WaitlistEntryUpdate) ClearToken() *WaitlistEntryUpdate {
+	weu.mutation.ClearToken()
+	return weu
+}
+
+// SetOfferedStart sets the "offered_start" field.
+func (weu *WaitlistEntryUpdate) SetOfferedStart(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferedStart(t)
+	return weu
+}
+
+// SetNillableOfferedStart sets the "offered_start" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferedStart(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferedStart(*t)
+	}
+	return weu
+}
+
+// ClearOfferedStart clears the value of the "offered_start" field.
+func (weu *WaitlistEntryUpdate) ClearOfferedStart() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferedStart()
+	return weu
+}
+
+// SetOfferedEnd sets the "offered_end" field.
+func (weu *WaitlistEntryUpdate) SetOfferedEnd(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferedEnd(t)
+	return weu
+}
+
+// SetNillableOfferedEnd sets the "offered_end" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferedEnd(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferedEnd(*t)
+	}
+	return weu
+}
+
+// ClearOfferedEnd clears the value of the "offered_end" field.
+func (weu *WaitlistEntryUpdate) ClearOfferedEnd() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferedEnd()
+	return weu
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (weu *WaitlistEntryUpdate) SetOfferExpiresAt(t time.Time) *WaitlistEntryUpdate {
+	weu.mutation.SetOfferExpiresAt(t)
+	return weu
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryUpdate {
+	if t != nil {
+		weu.SetOfferExpiresAt(*t)
+	}
+	return weu
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (weu *WaitlistEntryUpdate) ClearOfferExpiresAt() *WaitlistEntryUpdate {
+	weu.mutation.ClearOfferExpiresAt()
+	return weu
+}
+
+// SetLocationID sets the "location_id" field.
+func (weu *WaitlistEntryUpdate) SetLocationID(i int) *WaitlistEntryUpdate {
+	weu.mutation.SetLocationID(i)
+	return weu
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (weu *WaitlistEntryUpdate) SetNillableLocationID(i *int) *WaitlistEntryUpdate {
+	if i != nil {
+		weu.SetLocationID(*i)
+	}
+	return weu
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (weu *WaitlistEntryUpdate) ClearLocationID() *WaitlistEntryUpdate {
+	weu.mutation.ClearLocationID()
+	return weu
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (weu *WaitlistEntryUpdate) SetLocation(l *Location) *WaitlistEntryUpdate {
+	return weu.SetLocationID(l.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weu *WaitlistEntryUpdate) Mutation() *WaitlistEntryMutation {
+	return weu.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (weu *WaitlistEntryUpdate) ClearLocation() *WaitlistEntryUpdate {
+	weu.mutation.ClearLocation()
+	return weu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WaitlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WaitlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WaitlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WaitlistEntryUpdate) check() error {
+	if v, ok := weu.mutation.Name(); ok {
+		if err := waitlistentry.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.name": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.Email(); ok {
+		if err := waitlistentry.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.email": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.Phone(); ok {
+		if err := waitlistentry.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.phone": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.Date(); ok {
+		if err := waitlistentry.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.date": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.WindowStart(); ok {
+		if err := waitlistentry.WindowStartValidator(v); err != nil {
+			return &ValidationError{Name: "window_start", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.window_start": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.WindowEnd(); ok {
+		if err := waitlistentry.WindowEndValidator(v); err != nil {
+			return &ValidationError{Name: "window_end", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.window_end": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weu *WaitlistEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.Name(); ok {
+		_spec.SetField(waitlistentry.FieldName, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.Email(); ok {
+		_spec.SetField(waitlistentry.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.Phone(); ok {
+		_spec.SetField(waitlistentry.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.Date(); ok {
+		_spec.SetField(waitlistentry.FieldDate, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.WindowStart(); ok {
+		_spec.SetField(waitlistentry.FieldWindowStart, field.TypeString, value)
+	}
+	if weu.mutation.WindowStartCleared() {
+		_spec.ClearField(waitlistentry.FieldWindowStart, field.TypeString)
+	}
+	if value, ok := weu.mutation.WindowEnd(); ok {
+		_spec.SetField(waitlistentry.FieldWindowEnd, field.TypeString, value)
+	}
+	if weu.mutation.WindowEndCleared() {
+		_spec.ClearField(waitlistentry.FieldWindowEnd, field.TypeString)
+	}
+	if value, ok := weu.mutation.GetType(); ok {
+		_spec.SetField(waitlistentry.FieldType, field.TypeString, value)
+	}
+	if weu.mutation.TypeCleared() {
+		_spec.ClearField(waitlistentry.FieldType, field.TypeString)
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weu.mutation.Token(); ok {
+		_spec.SetField(waitlistentry.FieldToken, field.TypeString, value)
+	}
+	if weu.mutation.TokenCleared() {
+		_spec.ClearField(waitlistentry.FieldToken, field.TypeString)
+	}
+	if value, ok := weu.mutation.OfferedStart(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedStart, field.TypeTime, value)
+	}
+	if weu.mutation.OfferedStartCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedStart, field.TypeTime)
+	}
+	if value, ok := weu.mutation.OfferedEnd(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedEnd, field.TypeTime, value)
+	}
+	if weu.mutation.OfferedEndCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedEnd, field.TypeTime)
+	}
+	if value, ok := weu.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if weu.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if weu.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.LocationTable,
+			Columns: []string{waitlistentry.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.LocationTable,
+			Columns: []string{waitlistentry.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WaitlistEntryUpdateOne is the builder for updating a single WaitlistEntry entity.
+type WaitlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaitlistEntryMutation
+}
+
+// SetName sets the "name" field.
+func (weuo *WaitlistEntryUpdateOne) SetName(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetName(s)
+	return weuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableName(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetName(*s)
+	}
+	return weuo
+}
+
+// SetEmail sets the "email" field.
+func (weuo *WaitlistEntryUpdateOne) SetEmail(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetEmail(s)
+	return weuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableEmail(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetEmail(*s)
+	}
+	return weuo
+}
+
+// SetPhone sets the "phone" field.
+func (weuo *WaitlistEntryUpdateOne) SetPhone(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetPhone(s)
+	return weuo
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillablePhone(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetPhone(*s)
+	}
+	return weuo
+}
+
+// SetDate sets the "date" field.
+func (weuo *WaitlistEntryUpdateOne) SetDate(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetDate(s)
+	return weuo
+}
+
+// SetNillableDate sets the "date" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableDate(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetDate(*s)
+	}
+	return weuo
+}
+
+// SetWindowStart sets the "window_start" field.
+func (weuo *WaitlistEntryUpdateOne) SetWindowStart(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetWindowStart(s)
+	return weuo
+}
+
+// SetNillableWindowStart sets the "window_start" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableWindowStart(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetWindowStart(*s)
+	}
+	return weuo
+}
+
+// ClearWindowStart clears the value of the "window_start" field.
+func (weuo *WaitlistEntryUpdateOne) ClearWindowStart() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearWindowStart()
+	return weuo
+}
+
+// SetWindowEnd sets the "window_end" field.
+func (weuo *WaitlistEntryUpdateOne) SetWindowEnd(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetWindowEnd(s)
+	return weuo
+}
+
+// SetNillableWindowEnd sets the "window_end" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableWindowEnd(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetWindowEnd(*s)
+	}
+	return weuo
+}
+
+// ClearWindowEnd clears the value of the "window_end" field.
+func (weuo *WaitlistEntryUpdateOne) ClearWindowEnd() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearWindowEnd()
+	return weuo
+}
+
+// SetType sets the "type" field.
+func (weuo *WaitlistEntryUpdateOne) SetType(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetType(s)
+	return weuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableType(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetType(*s)
+	}
+	return weuo
+}
+
+// ClearType clears the value of the "type" field.
+func (weuo *WaitlistEntryUpdateOne) ClearType() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearType()
+	return weuo
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WaitlistEntryUpdateOne) SetStatus(w waitlistentry.Status) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetStatus(w)
+	return weuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableStatus(w *waitlistentry.Status) *WaitlistEntryUpdateOne {
+	if w != nil {
+		weuo.SetStatus(*w)
+	}
+	return weuo
+}
+
+// SetToken sets the "token" field.
+func (weuo *WaitlistEntryUpdateOne) SetToken(s string) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetToken(s)
+	return weuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableToken(s *string) *WaitlistEntryUpdateOne {
+	if s != nil {
+		weuo.SetToken(*s)
+	}
+	return weuo
+}
+
+// ClearToken clears the value of the "token" field.
+func (weuo *WaitlistEntryUpdateOne) ClearToken() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearToken()
+	return weuo
+}
+
+// SetOfferedStart sets the "offered_start" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferedStart(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferedStart(t)
+	return weuo
+}
+
+// SetNillableOfferedStart sets the "offered_start" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferedStart(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferedStart(*t)
+	}
+	return weuo
+}
+
+// ClearOfferedStart clears the value of the "offered_start" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferedStart() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferedStart()
+	return weuo
+}
+
+// SetOfferedEnd sets the "offered_end" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferedEnd(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferedEnd(t)
+	return weuo
+}
+
+// SetNillableOfferedEnd sets the "offered_end" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferedEnd(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferedEnd(*t)
+	}
+	return weuo
+}
+
+// ClearOfferedEnd clears the value of the "offered_end" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferedEnd() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferedEnd()
+	return weuo
+}
+
+// SetOfferExpiresAt sets the "offer_expires_at" field.
+func (weuo *WaitlistEntryUpdateOne) SetOfferExpiresAt(t time.Time) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetOfferExpiresAt(t)
+	return weuo
+}
+
+// SetNillableOfferExpiresAt sets the "offer_expires_at" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableOfferExpiresAt(t *time.Time) *WaitlistEntryUpdateOne {
+	if t != nil {
+		weuo.SetOfferExpiresAt(*t)
+	}
+	return weuo
+}
+
+// ClearOfferExpiresAt clears the value of the "offer_expires_at" field.
+func (weuo *WaitlistEntryUpdateOne) ClearOfferExpiresAt() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearOfferExpiresAt()
+	return weuo
+}
+
+// SetLocationID sets the "location_id" field.
+func (weuo *WaitlistEntryUpdateOne) SetLocationID(i int) *WaitlistEntryUpdateOne {
+	weuo.mutation.SetLocationID(i)
+	return weuo
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (weuo *WaitlistEntryUpdateOne) SetNillableLocationID(i *int) *WaitlistEntryUpdateOne {
+	if i != nil {
+		weuo.SetLocationID(*i)
+	}
+	return weuo
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (weuo *WaitlistEntryUpdateOne) ClearLocationID() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearLocationID()
+	return weuo
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (weuo *WaitlistEntryUpdateOne) SetLocation(l *Location) *WaitlistEntryUpdateOne {
+	return weuo.SetLocationID(l.ID)
+}
+
+// Mutation returns the WaitlistEntryMutation object of the builder.
+func (weuo *WaitlistEntryUpdateOne) Mutation() *WaitlistEntryMutation {
+	return weuo.mutation
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (weuo *WaitlistEntryUpdateOne) ClearLocation() *WaitlistEntryUpdateOne {
+	weuo.mutation.ClearLocation()
+	return weuo
+}
+
+// Where appends a list predicates to the WaitlistEntryUpdate builder.
+func (weuo *WaitlistEntryUpdateOne) Where(ps ...predicate.WaitlistEntry) *WaitlistEntryUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WaitlistEntryUpdateOne) Select(field string, fields ...string) *WaitlistEntryUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WaitlistEntry entity.
+func (weuo *WaitlistEntryUpdateOne) Save(ctx context.Context) (*WaitlistEntry, error) {
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) SaveX(ctx context.Context) *WaitlistEntry {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WaitlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WaitlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WaitlistEntryUpdateOne) check() error {
+	if v, ok := weuo.mutation.Name(); ok {
+		if err := waitlistentry.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.name": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.Email(); ok {
+		if err := waitlistentry.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.email": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.Phone(); ok {
+		if err := waitlistentry.PhoneValidator(v); err != nil {
+			return &ValidationError{Name: "phone", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.phone": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.Date(); ok {
+		if err := waitlistentry.DateValidator(v); err != nil {
+			return &ValidationError{Name: "date", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.date": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.WindowStart(); ok {
+		if err := waitlistentry.WindowStartValidator(v); err != nil {
+			return &ValidationError{Name: "window_start", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.window_start": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.WindowEnd(); ok {
+		if err := waitlistentry.WindowEndValidator(v); err != nil {
+			return &ValidationError{Name: "window_end", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.window_end": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.Status(); ok {
+		if err := waitlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WaitlistEntry.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weuo *WaitlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *WaitlistEntry, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(waitlistentry.Table, waitlistentry.Columns, sqlgraph.NewFieldSpec(waitlistentry.FieldID, field.TypeInt))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaitlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, waitlistentry.FieldID)
+		for _, f := range fields {
+			if !waitlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != waitlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.Name(); ok {
+		_spec.SetField(waitlistentry.FieldName, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.Email(); ok {
+		_spec.SetField(waitlistentry.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.Phone(); ok {
+		_spec.SetField(waitlistentry.FieldPhone, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.Date(); ok {
+		_spec.SetField(waitlistentry.FieldDate, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.WindowStart(); ok {
+		_spec.SetField(waitlistentry.FieldWindowStart, field.TypeString, value)
+	}
+	if weuo.mutation.WindowStartCleared() {
+		_spec.ClearField(waitlistentry.FieldWindowStart, field.TypeString)
+	}
+	if value, ok := weuo.mutation.WindowEnd(); ok {
+		_spec.SetField(waitlistentry.FieldWindowEnd, field.TypeString, value)
+	}
+	if weuo.mutation.WindowEndCleared() {
+		_spec.ClearField(waitlistentry.FieldWindowEnd, field.TypeString)
+	}
+	if value, ok := weuo.mutation.GetType(); ok {
+		_spec.SetField(waitlistentry.FieldType, field.TypeString, value)
+	}
+	if weuo.mutation.TypeCleared() {
+		_spec.ClearField(waitlistentry.FieldType, field.TypeString)
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.SetField(waitlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weuo.mutation.Token(); ok {
+		_spec.SetField(waitlistentry.FieldToken, field.TypeString, value)
+	}
+	if weuo.mutation.TokenCleared() {
+		_spec.ClearField(waitlistentry.FieldToken, field.TypeString)
+	}
+	if value, ok := weuo.mutation.OfferedStart(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedStart, field.TypeTime, value)
+	}
+	if weuo.mutation.OfferedStartCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedStart, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.OfferedEnd(); ok {
+		_spec.SetField(waitlistentry.FieldOfferedEnd, field.TypeTime, value)
+	}
+	if weuo.mutation.OfferedEndCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferedEnd, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.OfferExpiresAt(); ok {
+		_spec.SetField(waitlistentry.FieldOfferExpiresAt, field.TypeTime, value)
+	}
+	if weuo.mutation.OfferExpiresAtCleared() {
+		_spec.ClearField(waitlistentry.FieldOfferExpiresAt, field.TypeTime)
+	}
+	if weuo.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.LocationTable,
+			Columns: []string{waitlistentry.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   waitlistentry.LocationTable,
+			Columns: []string{waitlistentry.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaitlistEntry{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{waitlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
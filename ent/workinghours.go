@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WorkingHours is the model entity for the WorkingHours schema.
+type WorkingHours struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Weekday holds the value of the "weekday" field.
+	Weekday int `json:"weekday,omitempty"`
+	// Start holds the value of the "start" field.
+	Start string `json:"start,omitempty"`
+	// End holds the value of the "end" field.
+	End string `json:"end,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkingHoursQuery when eager-loading is set.
+	Edges               WorkingHoursEdges `json:"edges"`
+	staff_working_hours *int
+	selectValues        sql.SelectValues
+}
+
+// WorkingHoursEdges holds the relations/edges for other nodes in the graph.
+type WorkingHoursEdges struct {
+	// Staff holds the value of the staff edge.
+	Staff *Staff `json:"staff,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StaffOrErr returns the Staff value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkingHoursEdges) StaffOrErr() (*Staff, error) {
+	if e.Staff != nil {
+		return e.Staff, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: staff.Label}
+	}
+	return nil, &NotLoadedError{edge: "staff"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkingHours) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workinghours.FieldID, workinghours.FieldWeekday:
+			values[i] = new(sql.NullInt64)
+		case workinghours.FieldStart, workinghours.FieldEnd:
+			values[i] = new(sql.NullString)
+		case workinghours.ForeignKeys[0]: // staff_working_hours
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkingHours fields.
+func (wh *WorkingHours) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workinghours.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wh.ID = int(value.Int64)
+		case workinghours.FieldWeekday:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weekday", values[i])
+			} else if value.Valid {
+				wh.Weekday = int(value.Int64)
+			}
+		case workinghours.FieldStart:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field start", values[i])
+			} else if value.Valid {
+				wh.Start = value.String
+			}
+		case workinghours.FieldEnd:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field end", values[i])
+			} else if value.Valid {
+				wh.End = value.String
+			}
+		case workinghours.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field staff_working_hours", value)
+			} else if value.Valid {
+				wh.staff_working_hours = new(int)
+				*wh.staff_working_hours = int(value.Int64)
+			}
+		default:
+			wh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkingHours.
+// This includes values selected through modifiers, order, etc.
+func (wh *WorkingHours) Value(name string) (ent.Value, error) {
+	return wh.selectValues.Get(name)
+}
+
+// QueryStaff queries the "staff" edge of the WorkingHours entity.
+func (wh *WorkingHours) QueryStaff() *StaffQuery {
+	return NewWorkingHoursClient(wh.config).QueryStaff(wh)
+}
+
+// Update returns a builder for updating this WorkingHours.
+// Note that you need to call WorkingHours.Unwrap() before calling this method if this WorkingHours
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wh *WorkingHours) Update() *WorkingHoursUpdateOne {
+	return NewWorkingHoursClient(wh.config).UpdateOne(wh)
+}
+
+// Unwrap unwraps the WorkingHours entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wh *WorkingHours) Unwrap() *WorkingHours {
+	_tx, ok := wh.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkingHours is not a transactional entity")
+	}
+	wh.config.driver = _tx.drv
+	return wh
+}
+
+// String implements the fmt.Stringer.
+func (wh *WorkingHours) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkingHours(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wh.ID))
+	builder.WriteString("weekday=")
+	builder.WriteString(fmt.Sprintf("%v", wh.Weekday))
+	builder.WriteString(", ")
+	builder.WriteString("start=")
+	builder.WriteString(wh.Start)
+	builder.WriteString(", ")
+	builder.WriteString("end=")
+	builder.WriteString(wh.End)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WorkingHoursSlice is a parsable slice of WorkingHours.
+type WorkingHoursSlice []*WorkingHours
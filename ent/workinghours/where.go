@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workinghours
+
+import (
+	"TerminSystem/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLTE(FieldID, id))
+}
+
+// Weekday applies equality check predicate on the "weekday" field. It's identical to WeekdayEQ.
+func Weekday(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldWeekday, v))
+}
+
+// Start applies equality check predicate on the "start" field. It's identical to StartEQ.
+func Start(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldStart, v))
+}
+
+// End applies equality check predicate on the "end" field. It's identical to EndEQ.
+func End(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldEnd, v))
+}
+
+// WeekdayEQ applies the EQ predicate on the "weekday" field.
+func WeekdayEQ(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldWeekday, v))
+}
+
+// WeekdayNEQ applies the NEQ predicate on the "weekday" field.
+func WeekdayNEQ(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNEQ(FieldWeekday, v))
+}
+
+// WeekdayIn applies the In predicate on the "weekday" field.
+func WeekdayIn(vs ...int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldIn(FieldWeekday, vs...))
+}
+
+// WeekdayNotIn applies the NotIn predicate on the "weekday" field.
+func WeekdayNotIn(vs ...int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNotIn(FieldWeekday, vs...))
+}
+
+// WeekdayGT applies the GT predicate on the "weekday" field.
+func WeekdayGT(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGT(FieldWeekday, v))
+}
+
+// WeekdayGTE applies the GTE predicate on the "weekday" field.
+func WeekdayGTE(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGTE(FieldWeekday, v))
+}
+
+// WeekdayLT applies the LT predicate on the "weekday" field.
+func WeekdayLT(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLT(FieldWeekday, v))
+}
+
+// WeekdayLTE applies the LTE predicate on the "weekday" field.
+func WeekdayLTE(v int) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLTE(FieldWeekday, v))
+}
+
+// StartEQ applies the EQ predicate on the "start" field.
+func StartEQ(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldStart, v))
+}
+
+// StartNEQ applies the NEQ predicate on the "start" field.
+func StartNEQ(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNEQ(FieldStart, v))
+}
+
+// StartIn applies the In predicate on the "start" field.
+func StartIn(vs ...string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldIn(FieldStart, vs...))
+}
+
+// StartNotIn applies the NotIn predicate on the "start" field.
+func StartNotIn(vs ...string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNotIn(FieldStart, vs...))
+}
+
+// StartGT applies the GT predicate on the "start" field.
+func StartGT(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGT(FieldStart, v))
+}
+
+// StartGTE applies the GTE predicate on the "start" field.
+func StartGTE(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGTE(FieldStart, v))
+}
+
+// StartLT applies the LT predicate on the "start" field.
+func StartLT(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLT(FieldStart, v))
+}
+
+// StartLTE applies the LTE predicate on the "start" field.
+func StartLTE(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLTE(FieldStart, v))
+}
+
+// StartContains applies the Contains predicate on the "start" field.
+func StartContains(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldContains(FieldStart, v))
+}
+
+// StartHasPrefix applies the HasPrefix predicate on the "start" field.
+func StartHasPrefix(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldHasPrefix(FieldStart, v))
+}
+
+// StartHasSuffix applies the HasSuffix predicate on the "start" field.
+func StartHasSuffix(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldHasSuffix(FieldStart, v))
+}
+
+// StartEqualFold applies the EqualFold predicate on the "start" field.
+func StartEqualFold(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEqualFold(FieldStart, v))
+}
+
+// StartContainsFold applies the ContainsFold predicate on the "start" field.
+func StartContainsFold(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldContainsFold(FieldStart, v))
+}
+
+// EndEQ applies the EQ predicate on the "end" field.
+func EndEQ(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEQ(FieldEnd, v))
+}
+
+// EndNEQ applies the NEQ predicate on the "end" field.
+func EndNEQ(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNEQ(FieldEnd, v))
+}
+
+// EndIn applies the In predicate on the "end" field.
+func EndIn(vs ...string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldIn(FieldEnd, vs...))
+}
+
+// EndNotIn applies the NotIn predicate on the "end" field.
+func EndNotIn(vs ...string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldNotIn(FieldEnd, vs...))
+}
+
+// EndGT applies the GT predicate on the "end" field.
+func EndGT(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGT(FieldEnd, v))
+}
+
+// EndGTE applies the GTE predicate on the "end" field.
+func EndGTE(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldGTE(FieldEnd, v))
+}
+
+// EndLT applies the LT predicate on the "end" field.
+func EndLT(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLT(FieldEnd, v))
+}
+
+// EndLTE applies the LTE predicate on the "end" field.
+func EndLTE(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldLTE(FieldEnd, v))
+}
+
+// EndContains applies the Contains predicate on the "end" field.
+func EndContains(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldContains(FieldEnd, v))
+}
+
+// EndHasPrefix applies the HasPrefix predicate on the "end" field.
+func EndHasPrefix(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldHasPrefix(FieldEnd, v))
+}
+
+// EndHasSuffix applies the HasSuffix predicate on the "end" field.
+func EndHasSuffix(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldHasSuffix(FieldEnd, v))
+}
+
+// EndEqualFold applies the EqualFold predicate on the "end" field.
+func EndEqualFold(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldEqualFold(FieldEnd, v))
+}
+
+// EndContainsFold applies the ContainsFold predicate on the "end" field.
+func EndContainsFold(v string) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.FieldContainsFold(FieldEnd, v))
+}
+
+// HasStaff applies the HasEdge predicate on the "staff" edge.
+func HasStaff() predicate.WorkingHours {
+	return predicate.WorkingHours(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StaffTable, StaffColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStaffWith applies the HasEdge predicate on the "staff" edge with a given conditions (other predicates).
+func HasStaffWith(preds ...predicate.Staff) predicate.WorkingHours {
+	return predicate.WorkingHours(func(s *sql.Selector) {
+		step := newStaffStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkingHours) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkingHours) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkingHours) predicate.WorkingHours {
+	return predicate.WorkingHours(sql.NotPredicates(p))
+}
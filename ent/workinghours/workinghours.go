@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workinghours
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the workinghours type in the database.
+	Label = "working_hours"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWeekday holds the string denoting the weekday field in the database.
+	FieldWeekday = "weekday"
+	// FieldStart holds the string denoting the start field in the database.
+	FieldStart = "start"
+	// FieldEnd holds the string denoting the end field in the database.
+	FieldEnd = "end"
+	// EdgeStaff holds the string denoting the staff edge name in mutations.
+	EdgeStaff = "staff"
+	// Table holds the table name of the workinghours in the database.
+	Table = "working_hours"
+	// StaffTable is the table that holds the staff relation/edge.
+	StaffTable = "working_hours"
+	// StaffInverseTable is the table name for the Staff entity.
+	// It exists in this package in order to avoid circular dependency with the "staff" package.
+	StaffInverseTable = "staffs"
+	// StaffColumn is the table column denoting the staff relation/edge.
+	StaffColumn = "staff_working_hours"
+)
+
+// Columns holds all SQL columns for workinghours fields.
+var Columns = []string{
+	FieldID,
+	FieldWeekday,
+	FieldStart,
+	FieldEnd,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "working_hours"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"staff_working_hours",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// WeekdayValidator is a validator for the "weekday" field. It is called by the builders before save.
+	WeekdayValidator func(int) error
+	// StartValidator is a validator for the "start" field. It is called by the builders before save.
+	StartValidator func(string) error
+	// EndValidator is a validator for the "end" field. It is called by the builders before save.
+	EndValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the WorkingHours queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWeekday orders the results by the weekday field.
+func ByWeekday(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeekday, opts...).ToFunc()
+}
+
+// ByStart orders the results by the start field.
+func ByStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStart, opts...).ToFunc()
+}
+
+// ByEnd orders the results by the end field.
+func ByEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnd, opts...).ToFunc()
+}
+
+// ByStaffField orders the results by staff field.
+func ByStaffField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStaffStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStaffStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StaffInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StaffTable, StaffColumn),
+	)
+}
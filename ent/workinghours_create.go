@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkingHoursCreate is the builder for creating a WorkingHours entity.
+type WorkingHoursCreate struct {
+	config
+	mutation *WorkingHoursMutation
+	hooks    []Hook
+}
+
+// SetWeekday sets the "weekday" field.
+func (whc *WorkingHoursCreate) SetWeekday(i int) *WorkingHoursCreate {
+	whc.mutation.SetWeekday(i)
+	return whc
+}
+
+// SetStart sets the "start" field.
+func (whc *WorkingHoursCreate) SetStart(s string) *WorkingHoursCreate {
+	whc.mutation.SetStart(s)
+	return whc
+}
+
+// SetEnd sets the "end" field.
+func (whc *WorkingHoursCreate) SetEnd(s string) *WorkingHoursCreate {
+	whc.mutation.SetEnd(s)
+	return whc
+}
+
+// SetStaffID sets the "staff" edge to the Staff entity by ID.
+func (whc *WorkingHoursCreate) SetStaffID(id int) *WorkingHoursCreate {
+	whc.mutation.SetStaffID(id)
+	return whc
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (whc *WorkingHoursCreate) SetStaff(s *Staff) *WorkingHoursCreate {
+	return whc.SetStaffID(s.ID)
+}
+
+// Mutation returns the WorkingHoursMutation object of the builder.
+func (whc *WorkingHoursCreate) Mutation() *WorkingHoursMutation {
+	return whc.mutation
+}
+
+// Save creates the WorkingHours in the database.
+func (whc *WorkingHoursCreate) Save(ctx context.Context) (*WorkingHours, error) {
+	return withHooks(ctx, whc.sqlSave, whc.mutation, whc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (whc *WorkingHoursCreate) SaveX(ctx context.Context) *WorkingHours {
+	v, err := whc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (whc *WorkingHoursCreate) Exec(ctx context.Context) error {
+	_, err := whc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (whc *WorkingHoursCreate) ExecX(ctx context.Context) {
+	if err := whc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (whc *WorkingHoursCreate) check() error {
+	if _, ok := whc.mutation.Weekday(); !ok {
+		return &ValidationError{Name: "weekday", err: errors.New(`ent: missing required field "WorkingHours.weekday"`)}
+	}
+	if v, ok := whc.mutation.Weekday(); ok {
+		if err := workinghours.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.weekday": %w`, err)}
+		}
+	}
+	if _, ok := whc.mutation.Start(); !ok {
+		return &ValidationError{Name: "start", err: errors.New(`ent: missing required field "WorkingHours.start"`)}
+	}
+	if v, ok := whc.mutation.Start(); ok {
+		if err := workinghours.StartValidator(v); err != nil {
+			return &ValidationError{Name: "start", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.start": %w`, err)}
+		}
+	}
+	if _, ok := whc.mutation.End(); !ok {
+		return &ValidationError{Name: "end", err: errors.New(`ent: missing required field "WorkingHours.end"`)}
+	}
+	if v, ok := whc.mutation.End(); ok {
+		if err := workinghours.EndValidator(v); err != nil {
+			return &ValidationError{Name: "end", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.end": %w`, err)}
+		}
+	}
+	if len(whc.mutation.StaffIDs()) == 0 {
+		return &ValidationError{Name: "staff", err: errors.New(`ent: missing required edge "WorkingHours.staff"`)}
+	}
+	return nil
+}
+
+func (whc *WorkingHoursCreate) sqlSave(ctx context.Context) (*WorkingHours, error) {
+	if err := whc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := whc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, whc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	whc.mutation.id = &_node.ID
+	whc.mutation.done = true
+	return _node, nil
+}
+
+func (whc *WorkingHoursCreate) createSpec() (*WorkingHours, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkingHours{config: whc.config}
+		_spec = sqlgraph.NewCreateSpec(workinghours.Table, sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt))
+	)
+	if value, ok := whc.mutation.Weekday(); ok {
+		_spec.SetField(workinghours.FieldWeekday, field.TypeInt, value)
+		_node.Weekday = value
+	}
+	if value, ok := whc.mutation.Start(); ok {
+		_spec.SetField(workinghours.FieldStart, field.TypeString, value)
+		_node.Start = value
+	}
+	if value, ok := whc.mutation.End(); ok {
+		_spec.SetField(workinghours.FieldEnd, field.TypeString, value)
+		_node.End = value
+	}
+	if nodes := whc.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workinghours.StaffTable,
+			Columns: []string{workinghours.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.staff_working_hours = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkingHoursCreateBulk is the builder for creating many WorkingHours entities in bulk.
+type WorkingHoursCreateBulk struct {
+	config
+	err      error
+	builders []*WorkingHoursCreate
+}
+
+// Save creates the WorkingHours entities in the database.
+func (whcb *WorkingHoursCreateBulk) Save(ctx context.Context) ([]*WorkingHours, error) {
+	if whcb.err != nil {
+		return nil, whcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(whcb.builders))
+	nodes := make([]*WorkingHours, len(whcb.builders))
+	mutators := make([]Mutator, len(whcb.builders))
+	for i := range whcb.builders {
+		func(i int, root context.Context) {
+			builder := whcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkingHoursMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, whcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, whcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, whcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (whcb *WorkingHoursCreateBulk) SaveX(ctx context.Context) []*WorkingHours {
+	v, err := whcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (whcb *WorkingHoursCreateBulk) Exec(ctx context.Context) error {
+	_, err := whcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (whcb *WorkingHoursCreateBulk) ExecX(ctx context.Context) {
+	if err := whcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/workinghours"
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkingHoursDelete is the builder for deleting a WorkingHours entity.
+type WorkingHoursDelete struct {
+	config
+	hooks    []Hook
+	mutation *WorkingHoursMutation
+}
+
+// Where appends a list predicates to the WorkingHoursDelete builder.
+func (whd *WorkingHoursDelete) Where(ps ...predicate.WorkingHours) *WorkingHoursDelete {
+	whd.mutation.Where(ps...)
+	return whd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (whd *WorkingHoursDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, whd.sqlExec, whd.mutation, whd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (whd *WorkingHoursDelete) ExecX(ctx context.Context) int {
+	n, err := whd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (whd *WorkingHoursDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(workinghours.Table, sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt))
+	if ps := whd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, whd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	whd.mutation.done = true
+	return affected, err
+}
+
+// WorkingHoursDeleteOne is the builder for deleting a single WorkingHours entity.
+type WorkingHoursDeleteOne struct {
+	whd *WorkingHoursDelete
+}
+
+// Where appends a list predicates to the WorkingHoursDelete builder.
+func (whdo *WorkingHoursDeleteOne) Where(ps ...predicate.WorkingHours) *WorkingHoursDeleteOne {
+	whdo.whd.mutation.Where(ps...)
+	return whdo
+}
+
+// Exec executes the deletion query.
+func (whdo *WorkingHoursDeleteOne) Exec(ctx context.Context) error {
+	n, err := whdo.whd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{workinghours.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (whdo *WorkingHoursDeleteOne) ExecX(ctx context.Context) {
+	if err := whdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
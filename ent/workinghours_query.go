@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkingHoursQuery is the builder for querying WorkingHours entities.
+type WorkingHoursQuery struct {
+	config
+	ctx        *QueryContext
+	order      []workinghours.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WorkingHours
+	withStaff  *StaffQuery
+	withFKs    bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkingHoursQuery builder.
+func (whq *WorkingHoursQuery) Where(ps ...predicate.WorkingHours) *WorkingHoursQuery {
+	whq.predicates = append(whq.predicates, ps...)
+	return whq
+}
+
+// Limit the number of records to be returned by this query.
+func (whq *WorkingHoursQuery) Limit(limit int) *WorkingHoursQuery {
+	whq.ctx.Limit = &limit
+	return whq
+}
+
+// Offset to start from.
+func (whq *WorkingHoursQuery) Offset(offset int) *WorkingHoursQuery {
+	whq.ctx.Offset = &offset
+	return whq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (whq *WorkingHoursQuery) Unique(unique bool) *WorkingHoursQuery {
+	whq.ctx.Unique = &unique
+	return whq
+}
+
+// Order specifies how the records should be ordered.
+func (whq *WorkingHoursQuery) Order(o ...workinghours.OrderOption) *WorkingHoursQuery {
+	whq.order = append(whq.order, o...)
+	return whq
+}
+
+// QueryStaff chains the current query on the "staff" edge.
+func (whq *WorkingHoursQuery) QueryStaff() *StaffQuery {
+	query := (&StaffClient{config: whq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := whq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := whq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workinghours.Table, workinghours.FieldID, selector),
+			sqlgraph.To(staff.Table, staff.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workinghours.StaffTable, workinghours.StaffColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(whq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkingHours entity from the query.
+// Returns a *NotFoundError when no WorkingHours was found.
+func (whq *WorkingHoursQuery) First(ctx context.Context) (*WorkingHours, error) {
+	nodes, err := whq.Limit(1).All(setContextOp(ctx, whq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workinghours.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (whq *WorkingHoursQuery) FirstX(ctx context.Context) *WorkingHours {
+	node, err := whq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkingHours ID from the query.
+// Returns a *NotFoundError when no WorkingHours ID was found.
+func (whq *WorkingHoursQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = whq.Limit(1).IDs(setContextOp(ctx, whq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workinghours.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (whq *WorkingHoursQuery) FirstIDX(ctx context.Context) int {
+	id, err := whq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkingHours entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkingHours entity is found.
+// Returns a *NotFoundError when no WorkingHours entities are found.
+func (whq *WorkingHoursQuery) Only(ctx context.Context) (*WorkingHours, error) {
+	nodes, err := whq.Limit(2).All(setContextOp(ctx, whq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workinghours.Label}
+	default:
+		return nil, &NotSingularError{workinghours.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (whq *WorkingHoursQuery) OnlyX(ctx context.Context) *WorkingHours {
+	node, err := whq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkingHours ID in the query.
+// Returns a *NotSingularError when more than one WorkingHours ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (whq *WorkingHoursQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = whq.Limit(2).IDs(setContextOp(ctx, whq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workinghours.Label}
+	default:
+		err = &NotSingularError{workinghours.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (whq *WorkingHoursQuery) OnlyIDX(ctx context.Context) int {
+	id, err := whq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkingHoursSlice.
+func (whq *WorkingHoursQuery) All(ctx context.Context) ([]*WorkingHours, error) {
+	ctx = setContextOp(ctx, whq.ctx, ent.OpQueryAll)
+	if err := whq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkingHours, *WorkingHoursQuery]()
+	return withInterceptors[[]*WorkingHours](ctx, whq, qr, whq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (whq *WorkingHoursQuery) AllX(ctx context.Context) []*WorkingHours {
+	nodes, err := whq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkingHours IDs.
+func (whq *WorkingHoursQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if whq.ctx.Unique == nil && whq.path != nil {
+		whq.Unique(true)
+	}
+	ctx = setContextOp(ctx, whq.ctx, ent.OpQueryIDs)
+	if err = whq.Select(workinghours.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (whq *WorkingHoursQuery) IDsX(ctx context.Context) []int {
+	ids, err := whq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (whq *WorkingHoursQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, whq.ctx, ent.OpQueryCount)
+	if err := whq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, whq, querierCount[*WorkingHoursQuery](), whq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (whq *WorkingHoursQuery) CountX(ctx context.Context) int {
+	count, err := whq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (whq *WorkingHoursQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, whq.ctx, ent.OpQueryExist)
+	switch _, err := whq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (whq *WorkingHoursQuery) ExistX(ctx context.Context) bool {
+	exist, err := whq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkingHoursQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (whq *WorkingHoursQuery) Clone() *WorkingHoursQuery {
+	if whq == nil {
+		return nil
+	}
+	return &WorkingHoursQuery{
+		config:     whq.config,
+		ctx:        whq.ctx.Clone(),
+		order:      append([]workinghours.OrderOption{}, whq.order...),
+		inters:     append([]Interceptor{}, whq.inters...),
+		predicates: append([]predicate.WorkingHours{}, whq.predicates...),
+		withStaff:  whq.withStaff.Clone(),
+		// clone intermediate query.
+		sql:  whq.sql.Clone(),
+		path: whq.path,
+	}
+}
+
+// WithStaff tells the query-builder to eager-load the nodes that are connected to
+// the "staff" edge. The optional arguments are used to configure the query builder of the edge.
+func (whq *WorkingHoursQuery) WithStaff(opts ...func(*StaffQuery)) *WorkingHoursQuery {
+	query := (&StaffClient{config: whq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	whq.withStaff = query
+	return whq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Weekday int `json:"weekday,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkingHours.Query().
+//		GroupBy(workinghours.FieldWeekday).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (whq *WorkingHoursQuery) GroupBy(field string, fields ...string) *WorkingHoursGroupBy {
+	whq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkingHoursGroupBy{build: whq}
+	grbuild.flds = &whq.ctx.Fields
+	grbuild.label = workinghours.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Weekday int `json:"weekday,omitempty"`
+//	}
+//
+//	client.WorkingHours.Query().
+//		Select(workinghours.FieldWeekday).
+//		Scan(ctx, &v)
+func (whq *WorkingHoursQuery) Select(fields ...string) *WorkingHoursSelect {
+	whq.ctx.Fields = append(whq.ctx.Fields, fields...)
+	sbuild := &WorkingHoursSelect{WorkingHoursQuery: whq}
+	sbuild.label = workinghours.Label
+	sbuild.flds, sbuild.scan = &whq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkingHoursSelect configured with the given aggregations.
+func (whq *WorkingHoursQuery) Aggregate(fns ...AggregateFunc) *WorkingHoursSelect {
+	return whq.Select().Aggregate(fns...)
+}
+
+func (whq *WorkingHoursQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range whq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, whq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range whq.ctx.Fields {
+		if !workinghours.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if whq.path != nil {
+		prev, err := whq.path(ctx)
+		if err != nil {
+			return err
+		}
+		whq.sql = prev
+	}
+	return nil
+}
+
+func (whq *WorkingHoursQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkingHours, error) {
+	var (
+		nodes       = []*WorkingHours{}
+		withFKs     = whq.withFKs
+		_spec       = whq.querySpec()
+		loadedTypes = [1]bool{
+			whq.withStaff != nil,
+		}
+	)
+	if whq.withStaff != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, workinghours.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkingHours).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkingHours{config: whq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, whq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := whq.withStaff; query != nil {
+		if err := whq.loadStaff(ctx, query, nodes, nil,
+			func(n *WorkingHours, e *Staff) { n.Edges.Staff = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (whq *WorkingHoursQuery) loadStaff(ctx context.Context, query *StaffQuery, nodes []*WorkingHours, init func(*WorkingHours), assign func(*WorkingHours, *Staff)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WorkingHours)
+	for i := range nodes {
+		if nodes[i].staff_working_hours == nil {
+			continue
+		}
+		fk := *nodes[i].staff_working_hours
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(staff.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "staff_working_hours" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (whq *WorkingHoursQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := whq.querySpec()
+	_spec.Node.Columns = whq.ctx.Fields
+	if len(whq.ctx.Fields) > 0 {
+		_spec.Unique = whq.ctx.Unique != nil && *whq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, whq.driver, _spec)
+}
+
+func (whq *WorkingHoursQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(workinghours.Table, workinghours.Columns, sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt))
+	_spec.From = whq.sql
+	if unique := whq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if whq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := whq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workinghours.FieldID)
+		for i := range fields {
+			if fields[i] != workinghours.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := whq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := whq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := whq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := whq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (whq *WorkingHoursQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(whq.driver.Dialect())
+	t1 := builder.Table(workinghours.Table)
+	columns := whq.ctx.Fields
+	if len(columns) == 0 {
+		columns = workinghours.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if whq.sql != nil {
+		selector = whq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if whq.ctx.Unique != nil && *whq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range whq.predicates {
+		p(selector)
+	}
+	for _, p := range whq.order {
+		p(selector)
+	}
+	if offset := whq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := whq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WorkingHoursGroupBy is the group-by builder for WorkingHours entities.
+type WorkingHoursGroupBy struct {
+	selector
+	build *WorkingHoursQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (whgb *WorkingHoursGroupBy) Aggregate(fns ...AggregateFunc) *WorkingHoursGroupBy {
+	whgb.fns = append(whgb.fns, fns...)
+	return whgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (whgb *WorkingHoursGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, whgb.build.ctx, ent.OpQueryGroupBy)
+	if err := whgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkingHoursQuery, *WorkingHoursGroupBy](ctx, whgb.build, whgb, whgb.build.inters, v)
+}
+
+func (whgb *WorkingHoursGroupBy) sqlScan(ctx context.Context, root *WorkingHoursQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(whgb.fns))
+	for _, fn := range whgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*whgb.flds)+len(whgb.fns))
+		for _, f := range *whgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*whgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := whgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkingHoursSelect is the builder for selecting fields of WorkingHours entities.
+type WorkingHoursSelect struct {
+	*WorkingHoursQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (whs *WorkingHoursSelect) Aggregate(fns ...AggregateFunc) *WorkingHoursSelect {
+	whs.fns = append(whs.fns, fns...)
+	return whs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (whs *WorkingHoursSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, whs.ctx, ent.OpQuerySelect)
+	if err := whs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkingHoursQuery, *WorkingHoursSelect](ctx, whs.WorkingHoursQuery, whs, whs.inters, v)
+}
+
+func (whs *WorkingHoursSelect) sqlScan(ctx context.Context, root *WorkingHoursQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(whs.fns))
+	for _, fn := range whs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*whs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := whs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
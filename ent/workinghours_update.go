@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"TerminSystem/ent/predicate"
+	"TerminSystem/ent/staff"
+	"TerminSystem/ent/workinghours"
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkingHoursUpdate is the builder for updating WorkingHours entities.
+type WorkingHoursUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkingHoursMutation
+}
+
+// Where appends a list predicates to the WorkingHoursUpdate builder.
+func (whu *WorkingHoursUpdate) Where(ps ...predicate.WorkingHours) *WorkingHoursUpdate {
+	whu.mutation.Where(ps...)
+	return whu
+}
+
+// SetWeekday sets the "weekday" field.
+func (whu *WorkingHoursUpdate) SetWeekday(i int) *WorkingHoursUpdate {
+	whu.mutation.ResetWeekday()
+	whu.mutation.SetWeekday(i)
+	return whu
+}
+
+// SetNillableWeekday sets the "weekday" field if the given value is not nil.
+func (whu *WorkingHoursUpdate) SetNillableWeekday(i *int) *WorkingHoursUpdate {
+	if i != nil {
+		whu.SetWeekday(*i)
+	}
+	return whu
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (whu *WorkingHoursUpdate) AddWeekday(i int) *WorkingHoursUpdate {
+	whu.mutation.AddWeekday(i)
+	return whu
+}
+
+// SetStart sets the "start" field.
+func (whu *WorkingHoursUpdate) SetStart(s string) *WorkingHoursUpdate {
+	whu.mutation.SetStart(s)
+	return whu
+}
+
+// SetNillableStart sets the "start" field if the given value is not nil.
+func (whu *WorkingHoursUpdate) SetNillableStart(s *string) *WorkingHoursUpdate {
+	if s != nil {
+		whu.SetStart(*s)
+	}
+	return whu
+}
+
+// SetEnd sets the "end" field.
+func (whu *WorkingHoursUpdate) SetEnd(s string) *WorkingHoursUpdate {
+	whu.mutation.SetEnd(s)
+	return whu
+}
+
+// SetNillableEnd sets the "end" field if the given value is not nil.
+func (whu *WorkingHoursUpdate) SetNillableEnd(s *string) *WorkingHoursUpdate {
+	if s != nil {
+		whu.SetEnd(*s)
+	}
+	return whu
+}
+
+// SetStaffID sets the "staff" edge to the Staff entity by ID.
+func (whu *WorkingHoursUpdate) SetStaffID(id int) *WorkingHoursUpdate {
+	whu.mutation.SetStaffID(id)
+	return whu
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (whu *WorkingHoursUpdate) SetStaff(s *Staff) *WorkingHoursUpdate {
+	return whu.SetStaffID(s.ID)
+}
+
+// Mutation returns the WorkingHoursMutation object of the builder.
+func (whu *WorkingHoursUpdate) Mutation() *WorkingHoursMutation {
+	return whu.mutation
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (whu *WorkingHoursUpdate) ClearStaff() *WorkingHoursUpdate {
+	whu.mutation.ClearStaff()
+	return whu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (whu *WorkingHoursUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, whu.sqlSave, whu.mutation, whu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (whu *WorkingHoursUpdate) SaveX(ctx context.Context) int {
+	affected, err := whu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (whu *WorkingHoursUpdate) Exec(ctx context.Context) error {
+	_, err := whu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (whu *WorkingHoursUpdate) ExecX(ctx context.Context) {
+	if err := whu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (whu *WorkingHoursUpdate) check() error {
+	if v, ok := whu.mutation.Weekday(); ok {
+		if err := workinghours.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.weekday": %w`, err)}
+		}
+	}
+	if v, ok := whu.mutation.Start(); ok {
+		if err := workinghours.StartValidator(v); err != nil {
+			return &ValidationError{Name: "start", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.start": %w`, err)}
+		}
+	}
+	if v, ok := whu.mutation.End(); ok {
+		if err := workinghours.EndValidator(v); err != nil {
+			return &ValidationError{Name: "end", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.end": %w`, err)}
+		}
+	}
+	if whu.mutation.StaffCleared() && len(whu.mutation.StaffIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkingHours.staff"`)
+	}
+	return nil
+}
+
+func (whu *WorkingHoursUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := whu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workinghours.Table, workinghours.Columns, sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt))
+	if ps := whu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := whu.mutation.Weekday(); ok {
+		_spec.SetField(workinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := whu.mutation.AddedWeekday(); ok {
+		_spec.AddField(workinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := whu.mutation.Start(); ok {
+		_spec.SetField(workinghours.FieldStart, field.TypeString, value)
+	}
+	if value, ok := whu.mutation.End(); ok {
+		_spec.SetField(workinghours.FieldEnd, field.TypeString, value)
+	}
+	if whu.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workinghours.StaffTable,
+			Columns: []string{workinghours.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := whu.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workinghours.StaffTable,
+			Columns: []string{workinghours.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, whu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workinghours.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	whu.mutation.done = true
+	return n, nil
+}
+
+// WorkingHoursUpdateOne is the builder for updating a single WorkingHours entity.
+type WorkingHoursUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkingHoursMutation
+}
+
+// SetWeekday sets the "weekday" field.
+func (whuo *WorkingHoursUpdateOne) SetWeekday(i int) *WorkingHoursUpdateOne {
+	whuo.mutation.ResetWeekday()
+	whuo.mutation.SetWeekday(i)
+	return whuo
+}
+
+// SetNillableWeekday sets the "weekday" field if the given value is not nil.
+func (whuo *WorkingHoursUpdateOne) SetNillableWeekday(i *int) *WorkingHoursUpdateOne {
+	if i != nil {
+		whuo.SetWeekday(*i)
+	}
+	return whuo
+}
+
+// AddWeekday adds i to the "weekday" field.
+func (whuo *WorkingHoursUpdateOne) AddWeekday(i int) *WorkingHoursUpdateOne {
+	whuo.mutation.AddWeekday(i)
+	return whuo
+}
+
+// SetStart sets the "start" field.
+func (whuo *WorkingHoursUpdateOne) SetStart(s string) *WorkingHoursUpdateOne {
+	whuo.mutation.SetStart(s)
+	return whuo
+}
+
+// SetNillableStart sets the "start" field if the given value is not nil.
+func (whuo *WorkingHoursUpdateOne) SetNillableStart(s *string) *WorkingHoursUpdateOne {
+	if s != nil {
+		whuo.SetStart(*s)
+	}
+	return whuo
+}
+
+// SetEnd sets the "end" field.
+func (whuo *WorkingHoursUpdateOne) SetEnd(s string) *WorkingHoursUpdateOne {
+	whuo.mutation.SetEnd(s)
+	return whuo
+}
+
+// SetNillableEnd sets the "end" field if the given value is not nil.
+func (whuo *WorkingHoursUpdateOne) SetNillableEnd(s *string) *WorkingHoursUpdateOne {
+	if s != nil {
+		whuo.SetEnd(*s)
+	}
+	return whuo
+}
+
+// SetStaffID sets the "staff" edge to the Staff entity by ID.
+func (whuo *WorkingHoursUpdateOne) SetStaffID(id int) *WorkingHoursUpdateOne {
+	whuo.mutation.SetStaffID(id)
+	return whuo
+}
+
+// SetStaff sets the "staff" edge to the Staff entity.
+func (whuo *WorkingHoursUpdateOne) SetStaff(s *Staff) *WorkingHoursUpdateOne {
+	return whuo.SetStaffID(s.ID)
+}
+
+// Mutation returns the WorkingHoursMutation object of the builder.
+func (whuo *WorkingHoursUpdateOne) Mutation() *WorkingHoursMutation {
+	return whuo.mutation
+}
+
+// ClearStaff clears the "staff" edge to the Staff entity.
+func (whuo *WorkingHoursUpdateOne) ClearStaff() *WorkingHoursUpdateOne {
+	whuo.mutation.ClearStaff()
+	return whuo
+}
+
+// Where appends a list predicates to the WorkingHoursUpdate builder.
+func (whuo *WorkingHoursUpdateOne) Where(ps ...predicate.WorkingHours) *WorkingHoursUpdateOne {
+	whuo.mutation.Where(ps...)
+	return whuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (whuo *WorkingHoursUpdateOne) Select(field string, fields ...string) *WorkingHoursUpdateOne {
+	whuo.fields = append([]string{field}, fields...)
+	return whuo
+}
+
+// Save executes the query and returns the updated WorkingHours entity.
+func (whuo *WorkingHoursUpdateOne) Save(ctx context.Context) (*WorkingHours, error) {
+	return withHooks(ctx, whuo.sqlSave, whuo.mutation, whuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (whuo *WorkingHoursUpdateOne) SaveX(ctx context.Context) *WorkingHours {
+	node, err := whuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (whuo *WorkingHoursUpdateOne) Exec(ctx context.Context) error {
+	_, err := whuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (whuo *WorkingHoursUpdateOne) ExecX(ctx context.Context) {
+	if err := whuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (whuo *WorkingHoursUpdateOne) check() error {
+	if v, ok := whuo.mutation.Weekday(); ok {
+		if err := workinghours.WeekdayValidator(v); err != nil {
+			return &ValidationError{Name: "weekday", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.weekday": %w`, err)}
+		}
+	}
+	if v, ok := whuo.mutation.Start(); ok {
+		if err := workinghours.StartValidator(v); err != nil {
+			return &ValidationError{Name: "start", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.start": %w`, err)}
+		}
+	}
+	if v, ok := whuo.mutation.End(); ok {
+		if err := workinghours.EndValidator(v); err != nil {
+			return &ValidationError{Name: "end", err: fmt.Errorf(`ent: validator failed for field "WorkingHours.end": %w`, err)}
+		}
+	}
+	if whuo.mutation.StaffCleared() && len(whuo.mutation.StaffIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WorkingHours.staff"`)
+	}
+	return nil
+}
+
+func (whuo *WorkingHoursUpdateOne) sqlSave(ctx context.Context) (_node *WorkingHours, err error) {
+	if err := whuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workinghours.Table, workinghours.Columns, sqlgraph.NewFieldSpec(workinghours.FieldID, field.TypeInt))
+	id, ok := whuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkingHours.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := whuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workinghours.FieldID)
+		for _, f := range fields {
+			if !workinghours.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workinghours.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := whuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := whuo.mutation.Weekday(); ok {
+		_spec.SetField(workinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := whuo.mutation.AddedWeekday(); ok {
+		_spec.AddField(workinghours.FieldWeekday, field.TypeInt, value)
+	}
+	if value, ok := whuo.mutation.Start(); ok {
+		_spec.SetField(workinghours.FieldStart, field.TypeString, value)
+	}
+	if value, ok := whuo.mutation.End(); ok {
+		_spec.SetField(workinghours.FieldEnd, field.TypeString, value)
+	}
+	if whuo.mutation.StaffCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workinghours.StaffTable,
+			Columns: []string{workinghours.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := whuo.mutation.StaffIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workinghours.StaffTable,
+			Columns: []string{workinghours.StaffColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(staff.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkingHours{config: whuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, whuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workinghours.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	whuo.mutation.done = true
+	return _node, nil
+}
@@ -1,41 +1,213 @@
 package main
 
 import (
+	adminHandler "TerminSystem/Handlers/Admin"
+	staffHandler "TerminSystem/Handlers/Staff"
+	holidays "TerminSystem/Holidays"
+	notify "TerminSystem/Notify"
 	terminHandler "TerminSystem/Handlers/Termin"
+	staffService "TerminSystem/Repositories/Staff"
 	terminService "TerminSystem/Repositories/Termin"
 	"TerminSystem/ent"
+	"TerminSystem/ent/migrate"
 	"TerminSystem/templates"
 	"context"
+	"errors"
 	"log"
 	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 	"github.com/gin-gonic/gin"
 	_ "github.com/mattn/go-sqlite3"
 )
 
 
 func main() {
-    ctx := context.Background()
-    client, err := ent.Open("sqlite3", "file:appointment.db?mode=rwc&_fk=1")
+    ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+    defer stop()
+    client, err := ent.Open("sqlite3", "file:appointment.db?mode=rwc&_fk=1&_busy_timeout=5000")
     if err != nil {
         log.Fatalf("Failed to connect to database: %v", err)
     }
     defer client.Close()
 
-    if err := client.Schema.Create(ctx); err != nil {
+    if err := client.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
         log.Fatalf("Failed to create schema: %v", err)
     }
 
-    TerminService := terminService.NewAppointmentService(client)
+    config := terminService.DefaultConfig()
+    config.State = holidays.State(os.Getenv("SHOP_STATE"))
+    if !config.State.Valid() {
+        log.Fatalf("Invalid SHOP_STATE %q, expected a two-letter code like BY or none for federal holidays only", config.State)
+    }
+    if timezone := os.Getenv("SHOP_TIMEZONE"); timezone != "" {
+        config.Location, err = time.LoadLocation(timezone)
+        if err != nil {
+            log.Fatalf("Failed to load shop timezone: %v", err)
+        }
+    }
+
+    if secret := os.Getenv("CONFIRM_SECRET"); secret != "" {
+        config.ConfirmSecret = []byte(secret)
+    } else {
+        log.Println("CONFIRM_SECRET is not set, confirmation links expire on restart")
+    }
+
+    config.OnOffer = func(entry *ent.WaitlistEntry) {
+        log.Printf("Waitlist entry %d was offered a slot", entry.ID)
+    }
+
+    if baseURL := os.Getenv("BASE_URL"); baseURL != "" {
+        config.BaseURL = baseURL
+    }
+    from := os.Getenv("MAIL_FROM")
+    if from == "" {
+        from = "termine@localhost"
+    }
+    config.ShopName = os.Getenv("SHOP_NAME")
+    config.ShopAddress = os.Getenv("SHOP_ADDRESS")
+    config.ShopEmail = from
+    if addr := os.Getenv("SMTP_ADDR"); addr != "" {
+        config.Notifier = notify.NewSMTPNotifier(addr, from, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+    } else {
+        dir := os.Getenv("MAIL_DIR")
+        if dir == "" {
+            dir = "mails"
+        }
+        log.Printf("SMTP_ADDR is not set, writing emails to %s", dir)
+        config.Notifier = &notify.FileNotifier{Dir: dir, From: from}
+    }
+    if offsets := os.Getenv("REMINDER_OFFSETS"); offsets != "" {
+        config.ReminderOffsets = []time.Duration{}
+        for _, offset := range strings.Split(offsets, ",") {
+            parsed, err := time.ParseDuration(strings.TrimSpace(offset))
+            if err != nil || parsed <= 0 {
+                log.Fatalf("Invalid reminder offset %q", offset)
+            }
+            config.ReminderOffsets = append(config.ReminderOffsets, parsed)
+        }
+    }
+    if smsURL := os.Getenv("SMS_URL"); smsURL != "" {
+        config.SMS = &notify.HTTPSMSSender{URL: smsURL, Token: os.Getenv("SMS_TOKEN"), From: os.Getenv("SMS_FROM")}
+    } else {
+        log.Println("SMS_URL is not set, no text messages are sent")
+    }
+
+    TerminService := terminService.NewAppointmentService(client, config)
+    if err := TerminService.SeedOpeningHours(ctx, terminService.DefaultOpeningHours()); err != nil {
+        log.Fatalf("Failed to seed opening hours: %v", err)
+    }
+
+    var background sync.WaitGroup
+    background.Add(2)
+    go func() {
+        defer background.Done()
+        TerminService.RunMaintenance(ctx, time.Minute)
+    }()
+    go func() {
+        defer background.Done()
+        TerminService.RunReminders(ctx, time.Minute)
+    }()
+
     TerminHandler := terminHandler.NewTerminHandle(TerminService)
+    StaffService := staffService.NewStaffService(client)
+    StaffHandler := staffHandler.NewStaffHandle(StaffService)
 
     r := gin.Default()
     gin.SetMode(gin.DebugMode)
+    // Client IPs cap the slot holds, so they are only taken from
+    // X-Forwarded-For behind a known proxy.
+    var trustedProxies []string
+    if proxies := os.Getenv("TRUSTED_PROXIES"); proxies != "" {
+        trustedProxies = strings.Split(proxies, ",")
+    }
+    if err := r.SetTrustedProxies(trustedProxies); err != nil {
+        log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+    }
 
     api := r.Group("/api")
 
     api.GET("/termins",TerminHandler.GetAppointmentTimes)
     api.POST("/termins",TerminHandler.BookAppoinment)
+    api.POST("/termins/hold",TerminHandler.HoldSlot)
+    api.PATCH("/termins",TerminHandler.RescheduleAppoinment)
+    api.GET("/termins/confirm",TerminHandler.ConfirmPage)
+    api.POST("/termins/confirm",TerminHandler.ConfirmAppoinment)
+    api.GET("/termins/ics",TerminHandler.DownloadICS)
     api.DELETE("/termins",TerminHandler.DeleteAppoinment)
+    api.GET("/staff",StaffHandler.ListStaff)
+    api.GET("/dates",TerminHandler.GetAvailableDates)
+    api.POST("/waitlist",TerminHandler.JoinWaitlist)
+    api.GET("/waitlist/claim",TerminHandler.ClaimPage)
+    api.POST("/waitlist/claim",TerminHandler.ClaimWaitlistOffer)
+    api.GET("/locations",TerminHandler.ListLocations)
+    api.POST("/locations/:id/waitlist",TerminHandler.JoinWaitlist)
+    api.GET("/locations/:id/termins",TerminHandler.GetAppointmentTimes)
+    api.POST("/locations/:id/termins",TerminHandler.BookAppoinment)
+    api.POST("/locations/:id/termins/hold",TerminHandler.HoldSlot)
+    api.GET("/locations/:id/dates",TerminHandler.GetAvailableDates)
+    api.GET("/locations/:id/staff",StaffHandler.ListStaff)
+
+    if feedToken := os.Getenv("FEED_TOKEN"); feedToken != "" {
+        api.GET("/feed.ics",adminHandler.RequireQueryToken(feedToken),TerminHandler.StaffFeed)
+    } else {
+        log.Println("FEED_TOKEN is not set, staff calendar feed is disabled")
+    }
+
+    if caldavPassword := os.Getenv("CALDAV_PASSWORD"); caldavPassword != "" {
+        caldavUser := os.Getenv("CALDAV_USER")
+        if caldavUser == "" {
+            caldavUser = "owner"
+        }
+        calDAV := gin.WrapH(TerminHandler.CalDAV("/caldav"))
+        caldavAuth := adminHandler.RequireBasicAuth(caldavUser, caldavPassword)
+        for _, method := range terminHandler.CalDAVMethods {
+            r.Handle(method, "/caldav/*path", caldavAuth, calDAV)
+        }
+        r.Handle(http.MethodGet, "/.well-known/caldav", calDAV)
+        r.Handle("PROPFIND", "/.well-known/caldav", calDAV)
+    } else {
+        log.Println("CALDAV_PASSWORD is not set, CalDAV server is disabled")
+    }
+
+    if adminToken := os.Getenv("ADMIN_TOKEN"); adminToken != "" {
+        admin := api.Group("/admin", adminHandler.RequireToken(adminToken))
+
+        admin.GET("/opening-hours",TerminHandler.ListOpeningHours)
+        admin.PUT("/opening-hours/:weekday",TerminHandler.SetOpeningHours)
+        admin.DELETE("/opening-hours/:weekday",TerminHandler.CloseWeekday)
+        admin.GET("/special-hours",TerminHandler.ListOpeningHoursExceptions)
+        admin.PUT("/special-hours/:date",TerminHandler.SetOpeningHoursException)
+        admin.DELETE("/special-hours/:date",TerminHandler.RemoveOpeningHoursException)
+        admin.GET("/closures",TerminHandler.ListClosedDays)
+        admin.POST("/closures",TerminHandler.AddClosureDay)
+        admin.DELETE("/closures/:date",TerminHandler.RemoveClosureDay)
+        admin.POST("/locations",TerminHandler.CreateLocation)
+        admin.POST("/staff",StaffHandler.CreateStaff)
+        admin.PUT("/staff/:id",StaffHandler.UpdateStaff)
+        admin.DELETE("/staff/:id",StaffHandler.DeleteStaff)
+        admin.PUT("/staff/:id/working-hours",StaffHandler.SetWorkingHours)
+        admin.GET("/termins",TerminHandler.ListAppointments)
+        admin.GET("/termins/:id",TerminHandler.GetAppointment)
+        admin.PATCH("/termins/:id",TerminHandler.UpdateAppointment)
+        admin.DELETE("/termins/:id",TerminHandler.CancelAppointment)
+        admin.PUT("/termins/:id/status",TerminHandler.SetStatus)
+        admin.GET("/locations/:id/opening-hours",TerminHandler.ListOpeningHours)
+        admin.PUT("/locations/:id/opening-hours/:weekday",TerminHandler.SetOpeningHours)
+        admin.DELETE("/locations/:id/opening-hours/:weekday",TerminHandler.CloseWeekday)
+        admin.GET("/locations/:id/special-hours",TerminHandler.ListOpeningHoursExceptions)
+        admin.PUT("/locations/:id/special-hours/:date",TerminHandler.SetOpeningHoursException)
+        admin.DELETE("/locations/:id/special-hours/:date",TerminHandler.RemoveOpeningHoursException)
+        admin.GET("/locations/:id/closures",TerminHandler.ListClosedDays)
+        admin.POST("/locations/:id/closures",TerminHandler.AddClosureDay)
+        admin.DELETE("/locations/:id/closures/:date",TerminHandler.RemoveClosureDay)
+    } else {
+        log.Println("ADMIN_TOKEN is not set, admin API is disabled")
+    }
 
     r.GET("/", func(c *gin.Context) {
         c.Status(http.StatusOK)
@@ -43,5 +215,20 @@ func main() {
         templates.Root().Render(c.Request.Context(),c.Writer)
     }) 
 
-    r.Run(":8080")
+    server := &http.Server{Addr: ":8080", Handler: r}
+    go func() {
+        if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+            log.Fatalf("Failed to serve: %v", err)
+        }
+    }()
+
+    <-ctx.Done()
+    log.Println("Shutting down")
+
+    shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+    if err := server.Shutdown(shutdownCtx); err != nil {
+        log.Printf("Failed to shut down server: %v", err)
+    }
+    background.Wait()
 }
\ No newline at end of file